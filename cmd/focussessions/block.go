@@ -0,0 +1,64 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/adibhanna/focussessions/internal/blocker"
+	"github.com/adibhanna/focussessions/internal/storage"
+)
+
+const blockUsage = "usage: focussessions block on <domain>... | off | status"
+
+// runBlock edits the blocked sites section of the hosts file. The
+// dashboard runs it through `sudo -n` when it can't write the file itself.
+func runBlock(store *storage.Storage, args []string) error {
+	if len(args) == 0 {
+		return errors.New(blockUsage)
+	}
+
+	switch args[0] {
+	case "on":
+		if len(args) == 1 {
+			return errors.New(blockUsage)
+		}
+		// Often run as root through sudoers, so take nothing but hostnames
+		for _, domain := range args[1:] {
+			if err := blocker.CheckDomain(domain); err != nil {
+				return err
+			}
+		}
+		if err := blocker.Apply(args[1:]); err != nil {
+			return err
+		}
+		fmt.Printf("[OK] Blocked %s\n", strings.Join(args[1:], ", "))
+		return nil
+
+	case "off":
+		if len(args) != 1 {
+			return errors.New(blockUsage)
+		}
+		if err := blocker.Apply(nil); err != nil {
+			return err
+		}
+		fmt.Println("[OK] Unblocked all sites")
+		return nil
+
+	case "status":
+		if len(args) != 1 {
+			return errors.New(blockUsage)
+		}
+		domains, err := blocker.Blocked()
+		if err != nil {
+			return err
+		}
+		if len(domains) == 0 {
+			fmt.Println("No sites are blocked")
+			return nil
+		}
+		fmt.Printf("Blocked in %s: %s\n", blocker.HostsPath(), strings.Join(domains, ", "))
+		return nil
+	}
+	return errors.New(blockUsage)
+}
@@ -0,0 +1,84 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/adibhanna/focussessions/internal/storage"
+)
+
+const bundleUsage = "usage: focussessions bundle [--with-secrets] [file]"
+
+func runBundle(store *storage.Storage, args []string) error {
+	path := fmt.Sprintf("focussessions-bundle-%s.tar.gz", time.Now().Format("2006-01-02"))
+	secrets := false
+	var rest []string
+	for _, arg := range args {
+		if arg == "--with-secrets" {
+			secrets = true
+			continue
+		}
+		rest = append(rest, arg)
+	}
+	if len(rest) > 1 {
+		return errors.New(bundleUsage)
+	}
+	if len(rest) == 1 {
+		path = rest[0]
+	}
+
+	// A bundle holding passwords and tokens is as private as the config
+	mode := os.FileMode(0644)
+	if secrets {
+		mode = 0600
+	}
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, mode)
+	if err != nil {
+		return err
+	}
+
+	files, err := store.WriteBundle(f, secrets)
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		os.Remove(path)
+		return err
+	}
+
+	fmt.Printf("[OK] Bundled %d files into %s\n", len(files), path)
+	if !secrets {
+		fmt.Println("Passwords and tokens were left out; add --with-secrets to include them.")
+	}
+	return nil
+}
+
+func runRestoreBundle(store *storage.Storage, args []string) error {
+	if len(args) != 1 {
+		return errors.New("usage: focussessions restore-bundle <file>")
+	}
+
+	f, err := os.Open(args[0])
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	lock, err := lockData(store)
+	if err != nil {
+		return err
+	}
+	defer lock.Release()
+
+	files, backupDir, err := store.RestoreBundle(f)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("[OK] Restored %d files from %s\n", len(files), args[0])
+	if backupDir != "" {
+		fmt.Printf("Previous data was moved to %s\n", backupDir)
+	}
+	return nil
+}
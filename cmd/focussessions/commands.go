@@ -0,0 +1,145 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/adibhanna/focussessions/internal/storage"
+)
+
+// command is a non-interactive subcommand such as `focussessions bundle`.
+type command struct {
+	usage   string // arguments shown in --help, including the command name
+	summary string
+	run     func(store *storage.Storage, args []string) error
+}
+
+var commands = map[string]command{
+	"block": {
+		usage:   "block on <domain>...|off|status",
+		summary: "Block sites in the hosts file, lift the block, or list blocked sites",
+		run:     runBlock,
+	},
+	"bundle": {
+		usage:   "bundle [--with-secrets] [file]",
+		summary: "Archive all data into one portable .tar.gz",
+		run:     runBundle,
+	},
+	"daemon": {
+		usage:   "daemon [interval]",
+		summary: "Run background checks: finish sessions and send reminders",
+		run:     runDaemon,
+	},
+	"doctor": {
+		usage:   "doctor [--fix]",
+		summary: "Check the data files for problems and offer to repair them",
+		run:     runDoctor,
+	},
+	"export": {
+		usage:   "export [--format text|csv|json|html|markdown] [--period today|week|last-week|month|year|all] [--completed] [file]",
+		summary: "Export a report of your sessions, including an HTML page with charts",
+		run:     runExport,
+	},
+	"import": {
+		usage:   "import --format toggl|pomofocus|csv <file> [--dry-run]",
+		summary: "Import sessions exported from another time tracker",
+		run:     runImport,
+	},
+	"import-journal": {
+		usage:   "import-journal <file> [--dry-run]",
+		summary: "Import sessions from plain-text or Markdown notes",
+		run:     runImportJournal,
+	},
+	"merge": {
+		usage:   "merge <sessions.json>",
+		summary: "Merge the session history from another machine",
+		run:     runMerge,
+	},
+	"off": {
+		usage:   "off [clear] <date> [<last date>] [reason]",
+		summary: "List days off, or mark a day or a vacation as off so streaks and averages skip it",
+		run:     runOff,
+	},
+	"outbox": {
+		usage:   "outbox [flush|clear]",
+		summary: "List queued integration events, deliver due ones now, or drop them",
+		run:     runOutbox,
+	},
+	"plugins": {
+		usage:   "plugins [test [<event>]]",
+		summary: "List plugins, or send them a sample event and show what they answer",
+		run:     runPlugins,
+	},
+	"push": {
+		usage:   "push [test]",
+		summary: "Show where push notifications go, or send a test one",
+		run:     runPush,
+	},
+	"report": {
+		usage:   "report [--send]",
+		summary: "Show last week's report, or email it now",
+		run:     runReport,
+	},
+	"restore-bundle": {
+		usage:   "restore-bundle <file>",
+		summary: "Restore all data from a bundle",
+		run:     runRestoreBundle,
+	},
+	"scripts": {
+		usage:   "scripts [test [<event>] | report <script>]",
+		summary: "List Lua scripts, try them on a sample event, or print a script's report",
+		run:     runScripts,
+	},
+	"service": {
+		usage:   "service install|uninstall|status",
+		summary: "Run the daemon as a systemd or launchd user service",
+		run:     runService,
+	},
+	"speech": {
+		usage:   "speech [<event> on|off | test]",
+		summary: "Choose which announcements are read aloud",
+		run:     runSpeech,
+	},
+	"stats": {
+		usage:   "stats [--from <date>] [--to <date>]",
+		summary: "Show totals for any range of days, such as a sprint (defaults to this month)",
+		run:     runStats,
+	},
+	"tags": {
+		usage:   "tags [message|sound <tag> [value]]",
+		summary: "List tags, or set the completion message or sound for one",
+		run:     runTags,
+	},
+	"target": {
+		usage:   "target [<project> <duration>]",
+		summary: "List weekly project targets, or set one (0 removes it)",
+		run:     runTarget,
+	},
+	"verify": {
+		usage:   "verify [--fix]",
+		summary: "Check the session history for impossible states and repair them",
+		run:     runVerify,
+	},
+}
+
+// lockData claims the data directory for a command about to change it, as
+// the dashboard does while it runs, so neither overwrites what the other
+// saved. It fails while a dashboard is open on the profile.
+func lockData(store *storage.Storage) (*storage.InstanceLock, error) {
+	lock, err := store.Lock()
+	var locked *storage.LockedError
+	if errors.As(err, &locked) {
+		return nil, fmt.Errorf("focussessions is running (pid %d); quit it first, then try again", locked.PID)
+	}
+	return lock, err
+}
+
+func commandNames() []string {
+	names := make([]string, 0, len(commands))
+	for name := range commands {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
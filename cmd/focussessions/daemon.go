@@ -0,0 +1,36 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"log"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/adibhanna/focussessions/internal/daemon"
+	"github.com/adibhanna/focussessions/internal/storage"
+)
+
+func runDaemon(store *storage.Storage, args []string) error {
+	interval := daemon.DefaultInterval
+	switch len(args) {
+	case 0:
+	case 1:
+		d, err := time.ParseDuration(args[0])
+		if err != nil || d < time.Second {
+			return errors.New("usage: focussessions daemon [interval, e.g. 30s]")
+		}
+		interval = d
+	default:
+		return errors.New("usage: focussessions daemon [interval, e.g. 30s]")
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	logger := log.New(os.Stderr, "focussessions daemon: ", log.LstdFlags)
+	logger.Printf("checking every %s", interval)
+	return daemon.New(store, logger.Printf).Run(ctx, interval)
+}
@@ -0,0 +1,80 @@
+package main
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/adibhanna/focussessions/internal/storage"
+)
+
+const doctorUsage = "usage: focussessions doctor [--fix]"
+
+func runDoctor(store *storage.Storage, args []string) error {
+	fix := false
+	for _, arg := range args {
+		if arg != "--fix" {
+			return errors.New(doctorUsage)
+		}
+		fix = true
+	}
+
+	diagnosis, err := store.Diagnose(time.Now())
+	if err != nil {
+		return err
+	}
+	return repairProblems(store, diagnosis, fix, "doctor")
+}
+
+// repairProblems lists what a check found and, when fix is set or the user
+// agrees, repairs what it can. name is the command to suggest re-running.
+func repairProblems(store *storage.Storage, diagnosis *storage.Diagnosis, fix bool, name string) error {
+	if len(diagnosis.Problems) == 0 {
+		fmt.Println("[OK] No problems found")
+		return nil
+	}
+
+	for _, p := range diagnosis.Problems {
+		mark := " "
+		if p.Fixable {
+			mark = "*"
+		}
+		fmt.Printf("%s %-14s %s\n", mark, p.File, p.Detail)
+	}
+
+	fixable := diagnosis.Fixable()
+	fmt.Printf("Found %d problems, %d can be fixed automatically (*)\n", len(diagnosis.Problems), fixable)
+	if fixable == 0 {
+		return nil
+	}
+	if !fix && !confirm("Fix them now?") {
+		fmt.Printf("Nothing changed. Run `focussessions %s --fix` to repair them.\n", name)
+		return nil
+	}
+
+	lock, err := lockData(store)
+	if err != nil {
+		return err
+	}
+	defer lock.Release()
+	if err := store.Repair(diagnosis); err != nil {
+		return err
+	}
+	fmt.Printf("[OK] Fixed %d problems\n", fixable)
+	return nil
+}
+
+// confirm asks a yes/no question on stdin and defaults to no.
+func confirm(question string) bool {
+	fmt.Printf("%s [y/N] ", question)
+	answer, err := bufio.NewReader(os.Stdin).ReadString('\n')
+	if err != nil {
+		fmt.Println()
+		return false
+	}
+	answer = strings.ToLower(strings.TrimSpace(answer))
+	return answer == "y" || answer == "yes"
+}
@@ -0,0 +1,82 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/adibhanna/focussessions/internal/export"
+	"github.com/adibhanna/focussessions/internal/storage"
+)
+
+const exportUsage = "usage: focussessions export [--format text|csv|json|html|markdown] [--period today|week|last-week|month|year|all] [--completed] [file]"
+
+var exportFormats = map[string]export.Format{
+	"text":     export.Text,
+	"csv":      export.CSV,
+	"json":     export.JSON,
+	"html":     export.HTML,
+	"markdown": export.Markdown,
+}
+
+var exportPeriods = map[string]export.Period{
+	"today":     export.Today,
+	"week":      export.ThisWeek,
+	"last-week": export.LastWeek,
+	"month":     export.ThisMonth,
+	"year":      export.ThisYear,
+	"all":       export.AllTime,
+}
+
+func runExport(store *storage.Storage, args []string) error {
+	opts := export.Options{Period: export.ThisMonth, Format: export.Text}
+	var path string
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+		var name, value string
+		switch {
+		case arg == "--completed":
+			opts.CompletedOnly = true
+			continue
+		case (arg == "--format" || arg == "--period") && i+1 < len(args):
+			i++
+			name, value = arg, args[i]
+		case strings.HasPrefix(arg, "--format=") || strings.HasPrefix(arg, "--period="):
+			name, value, _ = strings.Cut(arg, "=")
+		case path == "" && !strings.HasPrefix(arg, "-"):
+			path = arg
+			continue
+		default:
+			return errors.New(exportUsage)
+		}
+
+		var ok bool
+		if name == "--format" {
+			opts.Format, ok = exportFormats[value]
+		} else {
+			opts.Period, ok = exportPeriods[value]
+		}
+		if !ok {
+			return errors.New(exportUsage)
+		}
+	}
+
+	now := time.Now()
+	data, err := export.Render(store, opts, now)
+	if err != nil {
+		return err
+	}
+
+	if path == "" {
+		path, err = export.Write(data, export.WorkingDir, "", opts.Format, now)
+	} else {
+		err = os.WriteFile(path, data, 0644)
+	}
+	if err != nil {
+		return err
+	}
+	fmt.Printf("[OK] Exported %s to %s\n", strings.ToLower(opts.Period.String()), path)
+	return nil
+}
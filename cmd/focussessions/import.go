@@ -0,0 +1,86 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/adibhanna/focussessions/internal/storage"
+	"github.com/adibhanna/focussessions/internal/trackerimport"
+)
+
+const importUsage = "usage: focussessions import --format toggl|pomofocus|csv <file> [--dry-run]"
+
+func runImport(store *storage.Storage, args []string) error {
+	var format, path string
+	dryRun := false
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+		switch {
+		case arg == "--dry-run" || arg == "-n":
+			dryRun = true
+		case arg == "--format" && i+1 < len(args):
+			i++
+			format = args[i]
+		case strings.HasPrefix(arg, "--format="):
+			format = strings.TrimPrefix(arg, "--format=")
+		case path == "" && !strings.HasPrefix(arg, "-"):
+			path = arg
+		default:
+			return errors.New(importUsage)
+		}
+	}
+	if format == "" || path == "" {
+		return errors.New(importUsage)
+	}
+
+	config, err := store.GetConfig()
+	if err != nil {
+		return err
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	result, err := trackerimport.Parse(format, f, trackerimport.Options{
+		Location:     time.Local,
+		DayStartHour: config.WorkStartHour,
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, skipped := range result.Skipped {
+		fmt.Printf("Skipped row %d: %s\n", skipped.Row, skipped.Reason)
+	}
+
+	if dryRun {
+		for _, session := range result.Sessions {
+			fmt.Printf("%s  %3d min  %s\n", session.StartTime.Format("2006-01-02 15:04"), session.Duration, session.Label())
+		}
+		fmt.Printf("Found %d sessions (dry run, nothing imported)\n", len(result.Sessions))
+		return nil
+	}
+
+	lock, err := lockData(store)
+	if err != nil {
+		return err
+	}
+	defer lock.Release()
+	added, err := store.ImportNonOverlapping(result.Sessions)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("[OK] Imported %d sessions from %s", len(added), path)
+	if overlaps := len(result.Sessions) - len(added); overlaps > 0 {
+		fmt.Printf(" (%d skipped as overlapping)", overlaps)
+	}
+	fmt.Println()
+	return nil
+}
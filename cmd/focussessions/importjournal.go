@@ -0,0 +1,69 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/adibhanna/focussessions/internal/storage"
+	"github.com/adibhanna/focussessions/internal/textimport"
+)
+
+func runImportJournal(store *storage.Storage, args []string) error {
+	dryRun := false
+	var path string
+	for _, arg := range args {
+		switch {
+		case arg == "--dry-run" || arg == "-n":
+			dryRun = true
+		case path == "":
+			path = arg
+		default:
+			return errors.New("usage: focussessions import-journal <file> [--dry-run]")
+		}
+	}
+	if path == "" {
+		return errors.New("usage: focussessions import-journal <file> [--dry-run]")
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	result, err := textimport.Parse(f, time.Local)
+	if err != nil {
+		return err
+	}
+
+	for _, skipped := range result.Skipped {
+		fmt.Printf("Skipped line %d (%s): %s\n", skipped.Line, skipped.Reason, skipped.Text)
+	}
+
+	if dryRun {
+		for _, session := range result.Sessions {
+			fmt.Printf("%s  %3d min  %s\n", session.StartTime.Format("2006-01-02 15:04"), session.Duration, session.Label())
+		}
+		fmt.Printf("Found %d sessions (dry run, nothing imported)\n", len(result.Sessions))
+		return nil
+	}
+
+	lock, err := lockData(store)
+	if err != nil {
+		return err
+	}
+	defer lock.Release()
+	added, err := store.ImportSessions(result.Sessions)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("[OK] Imported %d sessions from %s", len(added), path)
+	if dupes := len(result.Sessions) - len(added); dupes > 0 {
+		fmt.Printf(" (%d already present)", dupes)
+	}
+	fmt.Println()
+	return nil
+}
@@ -4,20 +4,36 @@ import (
 	"fmt"
 	"log"
 	"os"
+	"slices"
+	"strings"
 
 	tea "github.com/charmbracelet/bubbletea"
 
+	"github.com/adibhanna/focussessions/internal/clock"
 	"github.com/adibhanna/focussessions/internal/storage"
 	"github.com/adibhanna/focussessions/internal/ui/dashboard"
 	"github.com/adibhanna/focussessions/internal/ui/settings"
+	"github.com/adibhanna/focussessions/internal/ui/theme"
 )
 
 const version = "1.0.3"
 
 func main() {
+	profile, args, err := profileFlag(os.Args[1:])
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "focussessions:", err)
+		os.Exit(2)
+	}
+
+	// Drop colors for --no-color and NO_COLOR, leaving plain text
+	noColor, args := noColorFlag(args)
+	if noColor || theme.NoColor() {
+		theme.Plain()
+	}
+
 	// Check for version flag
-	if len(os.Args) > 1 {
-		switch os.Args[1] {
+	if len(args) > 0 {
+		switch args[0] {
 		case "--version", "-v":
 			fmt.Printf("Focus Sessions v%s\n", version)
 			fmt.Println("A beautiful CLI tool for managing focus sessions and tracking productivity")
@@ -29,45 +45,74 @@ func main() {
 		}
 	}
 
-	storage, err := storage.New()
+	storage, err := storage.NewProfile(profile)
 	if err != nil {
 		log.Fatal("Failed to initialize storage:", err)
 	}
 
-	if err := runApp(storage); err != nil {
-		log.Fatal(err)
-	}
-}
-
-func runApp(store *storage.Storage) error {
-	// Check if this is first time setup
-	if store.IsFirstTime() {
-		fmt.Println("*** Welcome to Focus Sessions! ***")
-		fmt.Println("Let's set up your preferences...")
-
-		// Run first-time settings
-		settingsModel, err := settings.New(store)
+	var start *startOptions
+	if len(args) > 0 && args[0] == "--start" {
+		opts, err := parseStart(args[1:])
 		if err != nil {
-			return err
+			fmt.Fprintln(os.Stderr, "focussessions:", err)
+			os.Exit(2)
+		}
+		if opts.headless {
+			if err := startHeadless(storage, opts); err != nil {
+				fmt.Fprintln(os.Stderr, "focussessions:", err)
+				os.Exit(1)
+			}
+			return
 		}
+		start, args = &opts, nil
+	}
 
-		p := tea.NewProgram(settingsModel, tea.WithAltScreen())
-		finalModel, err := p.Run()
-		if err != nil {
-			return err
+	if len(args) > 0 {
+		if command, ok := commands[args[0]]; ok {
+			if err := command.run(storage, args[1:]); err != nil {
+				fmt.Fprintf(os.Stderr, "focussessions %s: %v\n", args[0], err)
+				os.Exit(1)
+			}
+			return
 		}
+	}
+
+	if err := runApp(storage, start); err != nil {
+		fmt.Fprintln(os.Stderr, "focussessions:", err)
+		os.Exit(1)
+	}
+}
 
-		settingsModel = finalModel.(settings.Model)
-		fmt.Println("[OK] Setup complete! Let's start focusing!")
+// runApp runs the dashboard until the user quits, starting the session in
+// start first when it is non-nil.
+func runApp(store *storage.Storage, start *startOptions) error {
+	// One dashboard per data directory, so two don't both count the session
+	lock, err := store.Lock()
+	if err != nil {
+		return err
 	}
+	defer func() { lock.Release() }()
+
+	// Pick colors readable on the terminal's background, asking it before
+	// the dashboard starts reading keys
+	theme.Detect()
+
+	// New users get a short tour instead of an empty dashboard
+	tour := store.IsFirstTime()
 
 	// Main app loop
 	for {
 		// Create the main dashboard
-		dashboardModel, err := dashboard.New(store)
+		dashboardModel, err := dashboard.New(store, clock.System)
 		if err != nil {
 			return err
 		}
+		if start != nil {
+			dashboardModel, start = dashboardModel.StartOnLaunch(start.minutes, start.tag), nil
+		} else if tour {
+			dashboardModel = dashboardModel.StartTour()
+		}
+		tour = false
 
 		// Run the main dashboard
 		p := tea.NewProgram(dashboardModel, tea.WithAltScreen())
@@ -83,6 +128,19 @@ func runApp(store *storage.Storage) error {
 			return nil
 		}
 
+		// Reopen the dashboard on another profile
+		if profile := dashboardModel.SwitchProfile(); profile != "" {
+			if store, err = storage.NewProfile(profile); err != nil {
+				return err
+			}
+			tour = store.IsFirstTime()
+			lock.Release()
+			if lock, err = store.Lock(); err != nil {
+				return err
+			}
+			continue
+		}
+
 		// Check if user wants to open settings
 		if dashboardModel.ShouldOpenSettings() {
 			settingsModel, err := settings.New(store)
@@ -98,6 +156,42 @@ func runApp(store *storage.Storage) error {
 	}
 }
 
+// profileFlag removes a leading --profile <name> or --profile=<name> from
+// args and returns the profile name.
+func profileFlag(args []string) (string, []string, error) {
+	if len(args) == 0 {
+		return "", args, nil
+	}
+
+	var profile string
+	switch {
+	case args[0] == "--profile":
+		if len(args) < 2 {
+			return "", nil, fmt.Errorf("--profile needs a name")
+		}
+		profile, args = args[1], args[2:]
+	case strings.HasPrefix(args[0], "--profile="):
+		profile, args = strings.TrimPrefix(args[0], "--profile="), args[1:]
+	default:
+		return "", args, nil
+	}
+
+	if err := storage.ValidateProfile(profile); err != nil {
+		return "", nil, err
+	}
+	return profile, args, nil
+}
+
+// noColorFlag removes --no-color from args and reports whether it was
+// there.
+func noColorFlag(args []string) (bool, []string) {
+	i := slices.Index(args, "--no-color")
+	if i < 0 {
+		return false, args
+	}
+	return true, slices.Delete(slices.Clone(args), i, i+1)
+}
+
 func printHelp() {
 	fmt.Printf("Focus Sessions v%s\n", version)
 	fmt.Println("A beautiful CLI tool for managing focus sessions and tracking productivity")
@@ -106,6 +200,21 @@ func printHelp() {
 	fmt.Println("  focussessions           Start the interactive focus session manager")
 	fmt.Println("  focussessions --version Show version information")
 	fmt.Println("  focussessions --help    Show this help message")
+	fmt.Println("  focussessions --start [--duration <minutes>] [--tag <tag>] [--no-ui]")
+	fmt.Println("                          Start a session right away, without the dashboard with --no-ui")
+	fmt.Println()
+	fmt.Println("Options:")
+	fmt.Println("  --profile <name>        Use a separate config and session history, e.g. work or personal")
+	fmt.Println("  --no-color              Plain text without colors (also when NO_COLOR is set)")
+	fmt.Println()
+	fmt.Println("Commands:")
+	width := 0
+	for _, name := range commandNames() {
+		width = max(width, len(commands[name].usage))
+	}
+	for _, name := range commandNames() {
+		fmt.Printf("  focussessions %-*s %s\n", width, commands[name].usage, commands[name].summary)
+	}
 	fmt.Println()
 	fmt.Println("Features:")
 	fmt.Println("  • Customizable timer sessions")
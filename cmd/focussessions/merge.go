@@ -0,0 +1,41 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/adibhanna/focussessions/internal/models"
+	"github.com/adibhanna/focussessions/internal/storage"
+)
+
+const mergeUsage = "usage: focussessions merge <sessions.json>"
+
+func runMerge(store *storage.Storage, args []string) error {
+	if len(args) != 1 {
+		return errors.New(mergeUsage)
+	}
+	path := args[0]
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	var sessions []models.Session
+	if err := json.Unmarshal(data, &sessions); err != nil {
+		return fmt.Errorf("%s: %w", path, err)
+	}
+
+	lock, err := lockData(store)
+	if err != nil {
+		return err
+	}
+	defer lock.Release()
+	added, updated, err := store.MergeSessions(sessions)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("[OK] Merged %s: %d added, %d updated, %d unchanged\n", path, added, updated, len(sessions)-added-updated)
+	return nil
+}
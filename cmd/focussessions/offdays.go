@@ -0,0 +1,116 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/adibhanna/focussessions/internal/storage"
+)
+
+const offUsage = "usage: focussessions off [clear] <date> [<last date>] [reason]"
+
+// runOff lists the days marked off, or marks (or with clear, unmarks) a day
+// or a range of days given as YYYY-MM-DD, "today" or "tomorrow".
+func runOff(store *storage.Storage, args []string) error {
+	if len(args) == 0 {
+		return printOffDays(store)
+	}
+
+	unmark := args[0] == "clear"
+	if unmark {
+		args = args[1:]
+	}
+	if len(args) == 0 {
+		return errors.New(offUsage)
+	}
+
+	first, err := parseDay(args[0])
+	if err != nil {
+		return err
+	}
+	last := first
+	args = args[1:]
+	if len(args) > 0 {
+		if day, err := parseDay(args[0]); err == nil {
+			last = day
+			args = args[1:]
+		}
+	}
+	if last.Before(first) {
+		return fmt.Errorf("%s is before %s", last.Format("2006-01-02"), first.Format("2006-01-02"))
+	}
+
+	lock, err := lockData(store)
+	if err != nil {
+		return err
+	}
+	defer lock.Release()
+
+	days := int(last.Sub(first).Hours()/24+0.5) + 1
+	span := first.Format("Mon Jan 2, 2006")
+	if days > 1 {
+		span = fmt.Sprintf("%s – %s (%d days)", first.Format("Mon Jan 2"), last.Format("Mon Jan 2, 2006"), days)
+	}
+
+	if unmark {
+		if len(args) > 0 {
+			return errors.New(offUsage)
+		}
+		if err := store.ClearOffDays(first, last); err != nil {
+			return err
+		}
+		fmt.Printf("[OK] No longer off: %s\n", span)
+		return nil
+	}
+
+	if err := store.SetOffDays(first, last, strings.Join(args, " ")); err != nil {
+		return err
+	}
+	fmt.Printf("[OK] Marked off: %s\n", span)
+	return nil
+}
+
+// parseDay reads a day given as YYYY-MM-DD, "today" or "tomorrow".
+func parseDay(value string) (time.Time, error) {
+	now := time.Now()
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.Local)
+	switch value {
+	case "today":
+		return today, nil
+	case "tomorrow":
+		return today.AddDate(0, 0, 1), nil
+	}
+	day, err := time.ParseInLocation("2006-01-02", value, time.Local)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid date %q (use YYYY-MM-DD, today or tomorrow)", value)
+	}
+	return day, nil
+}
+
+func printOffDays(store *storage.Storage) error {
+	days, err := store.GetOffDays()
+	if err != nil {
+		return err
+	}
+	if len(days) == 0 {
+		fmt.Println("No days marked off. Mark a vacation with: focussessions off <date> [<last date>] [reason]")
+		return nil
+	}
+
+	dates := make([]string, 0, len(days))
+	for date := range days {
+		dates = append(dates, date)
+	}
+	sort.Strings(dates)
+	for _, date := range dates {
+		line := date
+		if reason := days[date]; reason != "" {
+			line += "  " + reason
+		}
+		fmt.Println(line)
+	}
+	return nil
+}
@@ -0,0 +1,57 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/adibhanna/focussessions/internal/outbox"
+	"github.com/adibhanna/focussessions/internal/storage"
+)
+
+const outboxUsage = "usage: focussessions outbox [flush|clear]"
+
+func runOutbox(store *storage.Storage, args []string) error {
+	if len(args) == 0 {
+		return printOutbox(store)
+	}
+	if len(args) != 1 {
+		return errors.New(outboxUsage)
+	}
+
+	switch args[0] {
+	case "flush":
+		delivered, failed, err := outbox.Flush(store, time.Now(), outbox.DefaultLimit)
+		if err != nil {
+			return err
+		}
+		fmt.Printf("[OK] %d delivered, %d failed\n", delivered, failed)
+		return nil
+	case "clear":
+		if err := store.ClearOutbox(); err != nil {
+			return err
+		}
+		fmt.Println("[OK] Outbox cleared")
+		return nil
+	}
+	return errors.New(outboxUsage)
+}
+
+func printOutbox(store *storage.Storage) error {
+	entries, err := store.GetOutbox()
+	if err != nil {
+		return err
+	}
+	if len(entries) == 0 {
+		fmt.Println("Outbox is empty.")
+		return nil
+	}
+
+	for _, e := range entries {
+		fmt.Printf("%s  %-18s %s %s\n", e.CreatedAt.Format("2006-01-02 15:04"), e.Event, e.Kind, e.Target)
+		if e.Attempts > 0 {
+			fmt.Printf("  %d attempts, next %s: %s\n", e.Attempts, e.NextAttempt.Format("15:04:05"), e.LastError)
+		}
+	}
+	return nil
+}
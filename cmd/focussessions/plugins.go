@@ -0,0 +1,118 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"slices"
+	"time"
+
+	"github.com/adibhanna/focussessions/internal/hooks"
+	"github.com/adibhanna/focussessions/internal/models"
+	"github.com/adibhanna/focussessions/internal/plugins"
+	"github.com/adibhanna/focussessions/internal/storage"
+)
+
+const pluginsUsage = "usage: focussessions plugins [test [<event>]]"
+
+// pluginEvents are the events plugins receive, the same as the hooks.
+var pluginEvents = []string{hooks.OnStart, hooks.OnPause, hooks.OnComplete, hooks.OnCancel, hooks.OnBreakStart}
+
+func runPlugins(store *storage.Storage, args []string) error {
+	switch {
+	case len(args) == 0:
+		return printPlugins(store)
+	case args[0] != "test" || len(args) > 2:
+		return errors.New(pluginsUsage)
+	}
+
+	event := hooks.OnComplete
+	if len(args) == 2 {
+		event = args[1]
+	}
+	if !slices.Contains(pluginEvents, event) {
+		return fmt.Errorf("unknown event %q (one of %v)", event, pluginEvents)
+	}
+	return testPlugins(store, event)
+}
+
+func printPlugins(store *storage.Storage) error {
+	dir := store.PluginsDir()
+	names, err := plugins.List(dir)
+	if err != nil {
+		return err
+	}
+	if len(names) == 0 {
+		fmt.Printf("No plugins in %s.\n", dir)
+		return nil
+	}
+
+	fmt.Printf("Plugins in %s:\n", dir)
+	for _, name := range names {
+		fmt.Println("  " + name)
+	}
+	return nil
+}
+
+// testPlugins sends every plugin a sample event and prints what each one
+// answered, without carrying any of it out.
+func testPlugins(store *storage.Storage, name string) error {
+	config, err := store.GetConfig()
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	event, err := plugins.NewEvent(config, samplePayload(config, name, now), now)
+	if err != nil {
+		return err
+	}
+
+	dir := store.PluginsDir()
+	names, err := plugins.List(dir)
+	if err != nil {
+		return err
+	}
+	if len(names) == 0 {
+		fmt.Printf("No plugins in %s.\n", dir)
+		return nil
+	}
+	for _, plugin := range names {
+		commands, err := plugins.Run(filepath.Join(dir, plugin), event)
+		fmt.Printf("%s:\n", plugin)
+		for _, command := range commands {
+			data, _ := json.Marshal(command)
+			fmt.Printf("  %s\n", data)
+		}
+		switch {
+		case err != nil:
+			fmt.Printf("  [ERROR] %v\n", err)
+		case len(commands) == 0:
+			fmt.Println("  (no commands)")
+		}
+	}
+	return nil
+}
+
+// samplePayload returns a made-up payload for the event name as of now, to
+// try plugins and scripts with.
+func samplePayload(config models.Config, name string, now time.Time) hooks.Payload {
+	payload := hooks.Payload{Event: name, Remaining: max(config.DailySessionGoal-1, 0)}
+	if name == hooks.OnBreakStart {
+		payload.BreakMinutes = config.BreakDuration
+		return payload
+	}
+	payload.Session = &models.Session{
+		ID:             "test",
+		StartTime:      now.Add(-time.Duration(config.SessionDuration) * time.Minute),
+		EndTime:        now,
+		Duration:       config.SessionDuration,
+		Completed:      name == hooks.OnComplete,
+		Active:         name == hooks.OnStart || name == hooks.OnPause,
+		Paused:         name == hooks.OnPause,
+		ElapsedSeconds: config.SessionDuration * 60,
+		Tag:            "test",
+	}
+	return payload
+}
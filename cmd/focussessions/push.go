@@ -0,0 +1,51 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/adibhanna/focussessions/internal/models"
+	"github.com/adibhanna/focussessions/internal/outbox"
+	"github.com/adibhanna/focussessions/internal/storage"
+)
+
+const pushUsage = "usage: focussessions push [test]"
+
+func runPush(store *storage.Storage, args []string) error {
+	if len(args) > 1 || len(args) == 1 && args[0] != "test" {
+		return errors.New(pushUsage)
+	}
+
+	config, err := store.GetConfig()
+	if err != nil {
+		return err
+	}
+	push := config.Push
+	if push == nil || !push.Ready() {
+		fmt.Println("Push notifications are off. Add a push section to config.json, e.g.")
+		fmt.Println(`  "push": {"service": "ntfy", "topic": "<a topic only you know>"}`)
+		return nil
+	}
+
+	if len(args) == 0 {
+		fmt.Printf("Push notifications go through %s%s when a session or a break ends.\n", push.Via(), pushDestination(*push))
+		return nil
+	}
+	if err := outbox.SendPush(push, outbox.PushMessage{Title: "Focus Sessions", Text: "Push notifications work"}); err != nil {
+		return err
+	}
+	fmt.Printf("[OK] Sent a test notification through %s\n", push.Via())
+	return nil
+}
+
+// pushDestination describes where the service delivers, without the
+// credentials.
+func pushDestination(push models.PushSettings) string {
+	switch push.Via() {
+	case models.PushNtfy:
+		return fmt.Sprintf(" to the topic %q", push.Topic)
+	case models.PushTelegram:
+		return " to the chat " + push.ChatID
+	}
+	return ""
+}
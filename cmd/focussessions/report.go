@@ -0,0 +1,47 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/adibhanna/focussessions/internal/outbox"
+	"github.com/adibhanna/focussessions/internal/storage"
+)
+
+const reportUsage = "usage: focussessions report [--send]"
+
+func runReport(store *storage.Storage, args []string) error {
+	if len(args) > 1 || len(args) == 1 && args[0] != "--send" {
+		return errors.New(reportUsage)
+	}
+
+	now := time.Now()
+	message, err := outbox.WeeklyReport(store, now)
+	if err != nil {
+		return err
+	}
+	if len(args) == 0 {
+		fmt.Println(message.Subject)
+		fmt.Println()
+		fmt.Print(message.Text)
+		return nil
+	}
+
+	config, err := store.GetConfig()
+	if err != nil {
+		return err
+	}
+	email := config.Email
+	if email == nil || !email.Ready() {
+		fmt.Println("Email is off. Add an email section to config.json, e.g.")
+		fmt.Println(`  "email": {"host": "smtp.example.com", "username": "me@example.com", "password": "...", "to": ["me@example.com"], "weekly": true}`)
+		return nil
+	}
+	if err := outbox.SendEmail(email, message, now); err != nil {
+		return err
+	}
+	fmt.Printf("[OK] Sent last week's report to %s\n", strings.Join(email.To, ", "))
+	return nil
+}
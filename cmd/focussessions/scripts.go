@@ -0,0 +1,109 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/adibhanna/focussessions/internal/hooks"
+	"github.com/adibhanna/focussessions/internal/scripts"
+	"github.com/adibhanna/focussessions/internal/storage"
+)
+
+const scriptsUsage = "usage: focussessions scripts [test [<event>] | report <script>]"
+
+func runScripts(store *storage.Storage, args []string) error {
+	switch {
+	case len(args) == 0:
+		return printScripts(store)
+	case args[0] == "report" && len(args) == 2:
+		return reportScript(store, args[1])
+	case args[0] != "test" || len(args) > 2:
+		return errors.New(scriptsUsage)
+	}
+
+	event := hooks.OnComplete
+	if len(args) == 2 {
+		event = args[1]
+	}
+	if !slices.Contains(pluginEvents, event) {
+		return fmt.Errorf("unknown event %q (one of %v)", event, pluginEvents)
+	}
+	return testScripts(store, event)
+}
+
+func printScripts(store *storage.Storage) error {
+	dir := store.ScriptsDir()
+	names, err := scripts.List(dir)
+	if err != nil {
+		return err
+	}
+	if len(names) == 0 {
+		fmt.Printf("No scripts in %s.\n", dir)
+		return nil
+	}
+
+	fmt.Printf("Scripts in %s:\n", dir)
+	for _, name := range names {
+		fmt.Println("  " + name)
+	}
+	return nil
+}
+
+// testScripts sends every script a sample event and prints what each one
+// printed and sent, without carrying any of it out.
+func testScripts(store *storage.Storage, name string) error {
+	config, err := store.GetConfig()
+	if err != nil {
+		return err
+	}
+
+	dir := store.ScriptsDir()
+	names, err := scripts.List(dir)
+	if err != nil {
+		return err
+	}
+	if len(names) == 0 {
+		fmt.Printf("No scripts in %s.\n", dir)
+		return nil
+	}
+
+	now := time.Now()
+	payload := samplePayload(config, name, now)
+	for _, script := range names {
+		fmt.Printf("%s:\n", script)
+		commands, err := scripts.Run(filepath.Join(dir, script), store, payload, now, os.Stdout)
+		for _, command := range commands {
+			data, _ := json.Marshal(command)
+			fmt.Printf("  %s\n", data)
+		}
+		switch {
+		case err != nil:
+			fmt.Printf("  [ERROR] %v\n", err)
+		case len(commands) == 0:
+			fmt.Println("  (no commands)")
+		}
+	}
+	return nil
+}
+
+// reportScript prints what the report function of a script returns. The
+// script may be named with or without its extension.
+func reportScript(store *storage.Storage, name string) error {
+	if !strings.HasSuffix(name, scripts.Ext) {
+		name += scripts.Ext
+	}
+	report, err := scripts.Report(filepath.Join(store.ScriptsDir(), filepath.Base(name)), store, time.Now(), os.Stdout)
+	if err != nil {
+		return fmt.Errorf("script %s: %w", name, err)
+	}
+	if report != "" {
+		fmt.Println(report)
+	}
+	return nil
+}
@@ -0,0 +1,73 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/adibhanna/focussessions/internal/service"
+	"github.com/adibhanna/focussessions/internal/storage"
+)
+
+const serviceUsage = "usage: focussessions service install|uninstall|status"
+
+// runService installs, removes or checks the background service running
+// the daemon for store's profile.
+func runService(store *storage.Storage, args []string) error {
+	if len(args) != 1 {
+		return errors.New(serviceUsage)
+	}
+	// The default profile's service runs the daemon without --profile
+	profile := store.Profile()
+	if profile == storage.DefaultProfile {
+		profile = ""
+	}
+
+	switch args[0] {
+	case "install":
+		exe, err := os.Executable()
+		if err != nil {
+			return err
+		}
+		if exe, err = filepath.EvalSymlinks(exe); err != nil {
+			return err
+		}
+		path, err := service.Install(exe, profile, store.DaemonLogPath())
+		if err != nil {
+			return err
+		}
+		fmt.Printf("[OK] Installed %s\n", path)
+		fmt.Println("The daemon now starts at login and finishes sessions and sends reminders while the app is closed.")
+		return nil
+
+	case "uninstall":
+		path, err := service.Uninstall(profile)
+		if err != nil {
+			return err
+		}
+		fmt.Printf("[OK] Removed %s\n", path)
+		return nil
+
+	case "status":
+		path, err := service.Path(profile)
+		if err != nil {
+			return err
+		}
+		if _, err := os.Stat(path); err != nil {
+			fmt.Printf("Not installed. Run `%s`.\n", serviceCommand(profile, "install"))
+			return nil
+		}
+		fmt.Printf("Installed: %s\n", path)
+		return nil
+	}
+	return errors.New(serviceUsage)
+}
+
+// serviceCommand returns the service subcommand sub for profile as typed.
+func serviceCommand(profile, sub string) string {
+	if profile == "" {
+		return "focussessions service " + sub
+	}
+	return fmt.Sprintf("focussessions --profile %s service %s", profile, sub)
+}
@@ -0,0 +1,77 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/adibhanna/focussessions/internal/models"
+	"github.com/adibhanna/focussessions/internal/speech"
+	"github.com/adibhanna/focussessions/internal/storage"
+)
+
+const speechUsage = "usage: focussessions speech [<event> on|off | test]"
+
+func runSpeech(store *storage.Storage, args []string) error {
+	switch len(args) {
+	case 0:
+		return printSpeech(store)
+	case 1:
+		if args[0] != "test" {
+			return errors.New(speechUsage)
+		}
+		return speech.Say("Session complete")
+	case 2:
+	default:
+		return errors.New(speechUsage)
+	}
+
+	event := args[0]
+	if !slices.Contains(models.SpeechEvents, event) {
+		return fmt.Errorf("unknown event %q (one of %v)", event, models.SpeechEvents)
+	}
+	var on bool
+	switch args[1] {
+	case "on":
+		on = true
+	case "off":
+	default:
+		return errors.New(speechUsage)
+	}
+
+	lock, err := lockData(store)
+	if err != nil {
+		return err
+	}
+	defer lock.Release()
+
+	config, err := store.GetConfig()
+	if err != nil {
+		return err
+	}
+	if config.Speech == nil {
+		config.Speech = make(map[string]bool)
+	}
+	config.Speech[event] = on
+	if err := store.SaveConfig(config); err != nil {
+		return err
+	}
+
+	fmt.Printf("[OK] Reading %s aloud: %s\n", event, args[1])
+	return nil
+}
+
+func printSpeech(store *storage.Storage) error {
+	config, err := store.GetConfig()
+	if err != nil {
+		return err
+	}
+	for _, event := range models.SpeechEvents {
+		state := "off"
+		if config.Speech[event] {
+			state = "on"
+		}
+		fmt.Printf("%-20s %s\n", event, state)
+	}
+	return nil
+}
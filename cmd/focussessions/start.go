@@ -0,0 +1,124 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/adibhanna/focussessions/internal/blocker"
+	"github.com/adibhanna/focussessions/internal/hooks"
+	"github.com/adibhanna/focussessions/internal/models"
+	"github.com/adibhanna/focussessions/internal/storage"
+)
+
+const startUsage = "usage: focussessions --start [--duration <minutes>] [--tag <tag>] [--no-ui]"
+
+// startOptions is a session requested with --start.
+type startOptions struct {
+	minutes  int // 0 for the configured session length
+	tag      string
+	headless bool
+}
+
+func parseStart(args []string) (startOptions, error) {
+	var opts startOptions
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+		var value string
+		switch {
+		case arg == "--no-ui":
+			opts.headless = true
+			continue
+		case (arg == "--duration" || arg == "--tag") && i+1 < len(args):
+			i++
+			value = args[i]
+		case strings.HasPrefix(arg, "--duration=") || strings.HasPrefix(arg, "--tag="):
+			arg, value, _ = strings.Cut(arg, "=")
+		default:
+			return startOptions{}, errors.New(startUsage)
+		}
+
+		if arg == "--tag" {
+			opts.tag = strings.TrimSpace(value)
+			continue
+		}
+		minutes, err := parseTargetMinutes(value)
+		if err != nil {
+			return startOptions{}, err
+		}
+		if minutes == 0 {
+			return startOptions{}, errors.New("a session needs at least one minute")
+		}
+		opts.minutes = minutes
+	}
+	return opts, nil
+}
+
+// startHeadless starts a session without the dashboard. It runs on its own
+// clock: the daemon completes it when its time is up, and the dashboard
+// picks it up when opened.
+func startHeadless(store *storage.Storage, opts startOptions) error {
+	// A running dashboard would not see the session
+	lock, err := lockData(store)
+	if err != nil {
+		return err
+	}
+	defer lock.Release()
+
+	if active, err := store.GetActiveSession(); err != nil {
+		return err
+	} else if active != nil {
+		return errors.New("a session is already running")
+	}
+
+	config, err := store.GetConfig()
+	if err != nil {
+		return err
+	}
+	if opts.minutes == 0 {
+		opts.minutes = config.SessionDuration
+	}
+
+	now := time.Now()
+	session := models.Session{
+		ID:        uuid.New().String(),
+		StartTime: now,
+		Duration:  opts.minutes,
+		Date:      now.Format("2006-01-02"),
+		Month:     now.Format("2006-01"),
+		Year:      now.Year(),
+		Active:    true,
+		Method:    config.Method,
+		Strict:    config.Strict,
+		Tag:       opts.tag,
+		Ledger:    true,
+	}
+	_, session.Week = now.ISOWeek()
+	if err := store.SaveSession(session); err != nil {
+		return err
+	}
+
+	// The daemon lifts the block when it completes the session
+	if err := blocker.New(config, store.BlockStatePath()).Block(); err != nil {
+		fmt.Fprintf(os.Stderr, "focussessions: sites not blocked: %v\n", err)
+	}
+
+	payload := hooks.Payload{Event: hooks.OnStart, Session: &session}
+	if today, err := store.GetDayStats(now.Format("2006-01-02")); err == nil {
+		payload.Remaining = max(config.DailySessionGoal-today.SessionsCount, 0)
+	}
+	hooks.Run(store.HooksDir(), payload)
+	hooks.RunCommand(config.OnSessionStartCmd, payload)
+
+	end := now.Add(time.Duration(opts.minutes) * time.Minute)
+	fmt.Printf("[OK] Started a %s session", models.FormatMinutes(opts.minutes))
+	if opts.tag != "" {
+		fmt.Printf(" tagged %s", opts.tag)
+	}
+	fmt.Printf(", ending at %s\n", end.Format("15:04"))
+	return nil
+}
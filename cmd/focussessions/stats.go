@@ -0,0 +1,78 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/adibhanna/focussessions/internal/models"
+	"github.com/adibhanna/focussessions/internal/storage"
+)
+
+const statsUsage = "usage: focussessions stats [--from <date>] [--to <date>]"
+
+// runStats prints the totals for a custom range of days, from the first of
+// this month through today unless --from or --to say otherwise.
+func runStats(store *storage.Storage, args []string) error {
+	now := time.Now()
+	last := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.Local)
+	first := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.Local)
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+		var name, value string
+		switch {
+		case (arg == "--from" || arg == "--to") && i+1 < len(args):
+			i++
+			name, value = arg, args[i]
+		case strings.HasPrefix(arg, "--from=") || strings.HasPrefix(arg, "--to="):
+			name, value, _ = strings.Cut(arg, "=")
+		default:
+			return errors.New(statsUsage)
+		}
+
+		day, err := parseDay(value)
+		if err != nil {
+			return err
+		}
+		if name == "--from" {
+			first = day
+		} else {
+			last = day
+		}
+	}
+	if last.Before(first) {
+		return fmt.Errorf("%s is before %s", last.Format("2006-01-02"), first.Format("2006-01-02"))
+	}
+
+	stats, err := store.GetRangeStats(first, last)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("%s – %s (%d days)\n\n", first.Format("Mon Jan 2, 2006"), last.Format("Mon Jan 2, 2006"), stats.Days)
+	fmt.Printf("%-16s %d\n", "Sessions:", stats.SessionsCount)
+	fmt.Printf("%-16s %s\n", "Focus time:", models.FormatMinutes(stats.TotalMinutes))
+	fmt.Printf("%-16s %d of %d\n", "Active days:", stats.ActiveDays, stats.Days)
+	if stats.ActiveDays > 0 {
+		fmt.Printf("%-16s %s\n", "Per active day:", models.FormatMinutes(stats.TotalMinutes/stats.ActiveDays))
+	}
+	if stats.AverageFocus > 0 {
+		fmt.Printf("%-16s %.1f/5\n", "Avg focus:", stats.AverageFocus)
+	}
+	if stats.Distractions > 0 {
+		fmt.Printf("%-16s %d\n", "Distractions:", stats.Distractions)
+	}
+	if stats.FalseStarts > 0 {
+		fmt.Printf("%-16s %d\n", "False starts:", stats.FalseStarts)
+	}
+
+	if len(stats.DailyStats) > 0 {
+		fmt.Println()
+	}
+	for _, day := range stats.DailyStats {
+		date, _ := time.ParseInLocation("2006-01-02", day.Date, time.Local)
+		fmt.Printf("%s  %2d sessions  %s\n", date.Format("Mon Jan 02"), day.SessionsCount, models.FormatMinutes(day.TotalMinutes))
+	}
+	return nil
+}
@@ -0,0 +1,93 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/adibhanna/focussessions/internal/storage"
+)
+
+const tagsUsage = "usage: focussessions tags [message <tag> [text] | sound <tag> [file]]"
+
+func runTags(store *storage.Storage, args []string) error {
+	if len(args) == 0 {
+		return printTags(store)
+	}
+	if len(args) < 2 {
+		return errors.New(tagsUsage)
+	}
+
+	field, tag, value := args[0], args[1], strings.Join(args[2:], " ")
+
+	tags, err := store.GetTags()
+	if err != nil {
+		return err
+	}
+	var current storage.TagUsage
+	for _, t := range tags {
+		if t.Tag == tag {
+			current = t
+		}
+	}
+	settings := current.Settings
+
+	switch field {
+	case "message":
+		settings.Message = value
+	case "sound":
+		if value != "" {
+			path, err := filepath.Abs(value)
+			if err != nil {
+				return err
+			}
+			if _, err := os.Stat(path); err != nil {
+				return err
+			}
+			value = path
+		}
+		settings.Sound = value
+	default:
+		return errors.New(tagsUsage)
+	}
+
+	lock, err := lockData(store)
+	if err != nil {
+		return err
+	}
+	defer lock.Release()
+	if err := store.SetTagSettings(tag, settings); err != nil {
+		return err
+	}
+
+	if value == "" {
+		fmt.Printf("[OK] Cleared %s for #%s\n", field, tag)
+	} else {
+		fmt.Printf("[OK] Set %s for #%s\n", field, tag)
+	}
+	return nil
+}
+
+func printTags(store *storage.Storage) error {
+	tags, err := store.GetTags()
+	if err != nil {
+		return err
+	}
+	if len(tags) == 0 {
+		fmt.Println("No tags yet. Press 'n' during a session to tag it.")
+		return nil
+	}
+
+	for _, t := range tags {
+		fmt.Printf("#%-19s %4d sessions\n", t.Tag, t.Sessions)
+		if t.Settings.Message != "" {
+			fmt.Printf("  message: %s\n", t.Settings.Message)
+		}
+		if t.Settings.Sound != "" {
+			fmt.Printf("  sound:   %s\n", t.Settings.Sound)
+		}
+	}
+	return nil
+}
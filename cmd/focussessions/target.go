@@ -0,0 +1,70 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/adibhanna/focussessions/internal/models"
+	"github.com/adibhanna/focussessions/internal/storage"
+)
+
+func runTarget(store *storage.Storage, args []string) error {
+	switch len(args) {
+	case 0:
+		return printTargets(store)
+	case 2:
+	default:
+		return errors.New("usage: focussessions target [<project> <duration>]")
+	}
+
+	minutes, err := parseTargetMinutes(args[1])
+	if err != nil {
+		return err
+	}
+	lock, err := lockData(store)
+	if err != nil {
+		return err
+	}
+	defer lock.Release()
+	if err := store.SetProjectTarget(args[0], minutes); err != nil {
+		return err
+	}
+
+	if minutes == 0 {
+		fmt.Printf("[OK] Removed weekly target for %s\n", args[0])
+	} else {
+		fmt.Printf("[OK] Weekly target for %s set to %s\n", args[0], models.FormatMinutes(minutes))
+	}
+	return nil
+}
+
+// parseTargetMinutes accepts a plain number of minutes or a Go duration
+// such as "10h" or "1h30m".
+func parseTargetMinutes(value string) (int, error) {
+	if minutes, err := strconv.Atoi(value); err == nil && minutes >= 0 {
+		return minutes, nil
+	}
+	d, err := time.ParseDuration(value)
+	if err != nil || d < 0 {
+		return 0, fmt.Errorf("invalid duration %q (use minutes or e.g. 10h, 1h30m)", value)
+	}
+	return int(d.Minutes()), nil
+}
+
+func printTargets(store *storage.Storage) error {
+	burndown, err := store.GetProjectBurndown(time.Now())
+	if err != nil {
+		return err
+	}
+	if len(burndown) == 0 {
+		fmt.Println("No weekly targets set. Add one with: focussessions target <project> <duration>")
+		return nil
+	}
+
+	for _, b := range burndown {
+		fmt.Printf("%-20s %s of %s\n", b.Project, models.FormatMinutes(b.Minutes), models.FormatMinutes(b.Target))
+	}
+	return nil
+}
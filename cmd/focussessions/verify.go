@@ -0,0 +1,26 @@
+package main
+
+import (
+	"errors"
+	"time"
+
+	"github.com/adibhanna/focussessions/internal/storage"
+)
+
+const verifyUsage = "usage: focussessions verify [--fix]"
+
+func runVerify(store *storage.Storage, args []string) error {
+	fix := false
+	for _, arg := range args {
+		if arg != "--fix" {
+			return errors.New(verifyUsage)
+		}
+		fix = true
+	}
+
+	diagnosis, err := store.Verify(time.Now())
+	if err != nil {
+		return err
+	}
+	return repairProblems(store, diagnosis, fix, "verify")
+}
@@ -0,0 +1,169 @@
+// Package achievements awards badges for milestones in the session
+// history. Badges are earned from completed sessions and, once unlocked,
+// stay unlocked even if the sessions behind them are deleted.
+package achievements
+
+import (
+	"time"
+
+	"github.com/adibhanna/focussessions/internal/models"
+)
+
+// Badge is an achievement that can be unlocked.
+type Badge struct {
+	ID          string
+	Icon        string
+	Name        string
+	Description string
+
+	earned func(h history) bool
+}
+
+// history is what badges are earned from: the completed sessions and the
+// days marked off.
+type history struct {
+	sessions []models.Session
+	offDays  map[string]string
+}
+
+// Badges lists every achievement in display order.
+var Badges = []Badge{
+	{
+		ID:          "first-session",
+		Icon:        "🌱",
+		Name:        "First Step",
+		Description: "Complete your first session",
+		earned:      func(h history) bool { return len(h.sessions) > 0 },
+	},
+	{
+		ID:          "streak-7",
+		Icon:        "🔥",
+		Name:        "On Fire",
+		Description: "Complete a session 7 days in a row",
+		earned:      func(h history) bool { return longestStreak(h) >= 7 },
+	},
+	{
+		ID:          "hours-100",
+		Icon:        "💯",
+		Name:        "Centurion",
+		Description: "Focus for 100 hours in total",
+		earned:      func(h history) bool { return totalMinutes(h.sessions) >= 100*60 },
+	},
+	{
+		ID:          "early-bird",
+		Icon:        "🐦",
+		Name:        "Early Bird",
+		Description: "Complete a session started before 7am",
+		earned: func(h history) bool {
+			return anyStartedIn(h.sessions, func(hour int) bool { return hour >= 4 && hour < 7 })
+		},
+	},
+	{
+		ID:          "night-owl",
+		Icon:        "🦉",
+		Name:        "Night Owl",
+		Description: "Complete a session started after 10pm",
+		earned: func(h history) bool {
+			return anyStartedIn(h.sessions, func(hour int) bool { return hour >= 22 || hour < 4 })
+		},
+	},
+}
+
+// Store is the storage badges are checked against, satisfied by
+// *storage.Storage.
+type Store interface {
+	GetAllSessions() ([]models.Session, error)
+	GetAchievements() (map[string]time.Time, error)
+	GetOffDays() (map[string]string, error)
+	UnlockAchievements(at time.Time, ids ...string) error
+}
+
+// Check unlocks the badges earned by the completed sessions in store that
+// weren't unlocked yet, and returns them.
+func Check(store Store, now time.Time) ([]Badge, error) {
+	sessions, err := store.GetAllSessions()
+	if err != nil {
+		return nil, err
+	}
+	unlocked, err := store.GetAchievements()
+	if err != nil {
+		return nil, err
+	}
+	offDays, err := store.GetOffDays()
+	if err != nil {
+		return nil, err
+	}
+
+	h := history{offDays: offDays}
+	for _, s := range sessions {
+		if s.Completed {
+			h.sessions = append(h.sessions, s)
+		}
+	}
+
+	var fresh []Badge
+	var ids []string
+	for _, badge := range Badges {
+		if _, ok := unlocked[badge.ID]; ok || !badge.earned(h) {
+			continue
+		}
+		fresh = append(fresh, badge)
+		ids = append(ids, badge.ID)
+	}
+	if len(fresh) == 0 {
+		return nil, nil
+	}
+	return fresh, store.UnlockAchievements(now, ids...)
+}
+
+func totalMinutes(sessions []models.Session) int {
+	total := 0
+	for _, s := range sessions {
+		total += s.ActualMinutes()
+	}
+	return total
+}
+
+func anyStartedIn(sessions []models.Session, match func(hour int) bool) bool {
+	for _, s := range sessions {
+		if match(s.StartTime.Local().Hour()) {
+			return true
+		}
+	}
+	return false
+}
+
+// longestStreak returns the most consecutive days with a session. Days
+// marked off are skipped rather than breaking a streak.
+func longestStreak(h history) int {
+	days := make(map[string]bool)
+	var first, last time.Time
+	for _, s := range h.sessions {
+		day, err := time.ParseInLocation("2006-01-02", s.Date, time.Local)
+		if err != nil {
+			continue
+		}
+		days[s.Date] = true
+		if first.IsZero() || day.Before(first) {
+			first = day
+		}
+		if day.After(last) {
+			last = day
+		}
+	}
+	if len(days) == 0 {
+		return 0
+	}
+
+	longest, run := 0, 0
+	for day := first; !day.After(last); day = day.AddDate(0, 0, 1) {
+		date := day.Format("2006-01-02")
+		if days[date] {
+			run++
+			longest = max(longest, run)
+		} else if _, off := h.offDays[date]; !off {
+			run = 0
+		}
+	}
+	return longest
+}
@@ -0,0 +1,273 @@
+// Package blocker keeps distracting websites out of reach while a session
+// runs. It either adds the blocked domains to the hosts file, in a marked
+// section it removes again afterwards, or runs the user's own commands.
+//
+// Editing the hosts file needs root. When the current user can't write it,
+// the change is retried through `sudo -n focussessions block`, which works
+// without a prompt once sudo credentials are cached or sudoers allows it.
+package blocker
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"slices"
+	"strings"
+
+	"github.com/adibhanna/focussessions/internal/models"
+)
+
+// Markers around the section of the hosts file managed here.
+const (
+	beginMarker = "# >>> focussessions block >>>"
+	endMarker   = "# <<< focussessions block <<<"
+)
+
+// ErrNeedsSudo is returned when the hosts file can't be changed without a
+// password.
+var ErrNeedsSudo = errors.New("blocking sites needs root: run `sudo -v` before starting a session, or allow `focussessions block` in sudoers")
+
+// Blocker blocks and unblocks the configured sites.
+type Blocker struct {
+	Domains        []string // Sites blocked through the hosts file
+	BlockCommand   string   // Run through the shell instead of editing the hosts file
+	UnblockCommand string   // Undoes BlockCommand
+	StateFile      string   // Exists while BlockCommand is in effect
+}
+
+// New returns the blocker for the sites in config, marking a block command
+// in effect with stateFile.
+func New(config models.Config, stateFile string) Blocker {
+	return Blocker{
+		Domains:        config.Blocklist,
+		BlockCommand:   config.BlockCommand,
+		UnblockCommand: config.UnblockCommand,
+		StateFile:      stateFile,
+	}
+}
+
+// Enabled reports whether there is anything to block.
+func (b Blocker) Enabled() bool {
+	return len(b.Domains) > 0 || b.BlockCommand != ""
+}
+
+// Block blocks the sites. Blocking what is already blocked does nothing.
+func (b Blocker) Block() error {
+	if b.BlockCommand != "" {
+		if err := shell(b.BlockCommand); err != nil {
+			return err
+		}
+		return os.WriteFile(b.StateFile, nil, 0644)
+	}
+	if len(b.Domains) == 0 {
+		return nil
+	}
+	return setHosts(b.Domains)
+}
+
+// Unblock lifts the block, and does nothing when nothing is blocked. It
+// also cleans up after a session that ended without unblocking, such as
+// when the app crashed. The hosts file is only touched when it holds the
+// managed section.
+func (b Blocker) Unblock() error {
+	if _, err := os.Stat(b.StateFile); err == nil {
+		if b.UnblockCommand != "" {
+			if err := shell(b.UnblockCommand); err != nil {
+				return err
+			}
+		}
+		if err := os.Remove(b.StateFile); err != nil {
+			return err
+		}
+	}
+	data, err := os.ReadFile(HostsPath())
+	if err != nil || !hasSection(string(data)) {
+		// An unreadable hosts file has no block of ours to lift
+		return nil
+	}
+	return setHosts(nil)
+}
+
+// setHosts makes the managed section of the hosts file block domains, or
+// removes it when there are none, through sudo when it takes root.
+func setHosts(domains []string) error {
+	err := Apply(domains)
+	if errors.Is(err, os.ErrPermission) {
+		return viaSudo(domains)
+	}
+	return err
+}
+
+// viaSudo applies the change as root without prompting for a password.
+func viaSudo(domains []string) error {
+	if runtime.GOOS == "windows" {
+		return fmt.Errorf("blocking sites needs a terminal run as administrator: %w", os.ErrPermission)
+	}
+	self, err := os.Executable()
+	if err != nil {
+		return err
+	}
+	args := []string{"-n", self, "block", "off"}
+	if len(domains) > 0 {
+		args = append([]string{"-n", self, "block", "on"}, domains...)
+	}
+	if err := exec.Command("sudo", args...).Run(); err != nil {
+		return ErrNeedsSudo
+	}
+	return nil
+}
+
+// Apply makes the managed section of the hosts file block domains, or
+// removes it when there are none. It is what `focussessions block` runs,
+// usually as root, so it refuses anything that isn't a plain hostname
+// rather than write it into the hosts file.
+func Apply(domains []string) error {
+	for _, domain := range domains {
+		if err := CheckDomain(domain); err != nil {
+			return err
+		}
+	}
+	data, err := os.ReadFile(HostsPath())
+	if err != nil {
+		return err
+	}
+	updated := Section(string(data), domains)
+	if updated == string(data) {
+		return nil
+	}
+	return os.WriteFile(HostsPath(), []byte(updated), 0644)
+}
+
+// Blocked returns the domains the hosts file currently blocks.
+func Blocked() ([]string, error) {
+	data, err := os.ReadFile(HostsPath())
+	if err != nil {
+		return nil, err
+	}
+	var domains []string
+	inside := false
+	for _, line := range strings.Split(string(data), "\n") {
+		line = strings.TrimSpace(line)
+		switch {
+		case line == beginMarker:
+			inside = true
+		case line == endMarker:
+			inside = false
+		case inside:
+			if fields := strings.Fields(line); len(fields) == 2 && fields[0] == "0.0.0.0" && !slices.Contains(domains, fields[1]) {
+				domains = append(domains, fields[1])
+			}
+		}
+	}
+	return domains, nil
+}
+
+// Section returns hosts with its managed section blocking domains, or
+// without one when there are none. The rest of the file is kept as is,
+// down to its trailing newlines, and hosts comes back unchanged when there
+// is no section to add or remove.
+func Section(hosts string, domains []string) string {
+	if len(domains) == 0 && !hasSection(hosts) {
+		return hosts
+	}
+
+	body := strings.TrimRight(hosts, "\r\n")
+	trailing := hosts[len(body):]
+	var kept []string
+	inside := false
+	for _, line := range strings.Split(body, "\n") {
+		switch strings.TrimSpace(line) {
+		case beginMarker:
+			inside = true
+			continue
+		case endMarker:
+			inside = false
+			continue
+		}
+		if !inside {
+			kept = append(kept, line)
+		}
+	}
+	// The section leaves no empty line behind in a file it was alone in
+	if len(kept) == 1 && kept[0] == "" {
+		kept = nil
+	}
+
+	if len(domains) > 0 {
+		kept = append(kept, beginMarker)
+		for _, domain := range domains {
+			for _, host := range variants(domain) {
+				kept = append(kept, "0.0.0.0 "+host, "::1 "+host)
+			}
+		}
+		kept = append(kept, endMarker)
+	}
+	if len(kept) == 0 {
+		return ""
+	}
+	if trailing == "" {
+		trailing = "\n"
+	}
+	return strings.Join(kept, "\n") + trailing
+}
+
+// hasSection reports whether hosts holds the managed section.
+func hasSection(hosts string) bool {
+	for _, line := range strings.Split(hosts, "\n") {
+		if strings.TrimSpace(line) == beginMarker {
+			return true
+		}
+	}
+	return false
+}
+
+// CheckDomain returns an error unless domain is a hostname: dot-separated
+// labels of letters, digits and hyphens, at most 63 characters each and 253
+// in all, such as example.com.
+func CheckDomain(domain string) error {
+	name := strings.TrimSuffix(strings.ToLower(strings.TrimSpace(domain)), ".")
+	if name == "" || len(name) > 253 {
+		return fmt.Errorf("%q is not a domain", domain)
+	}
+	for _, label := range strings.Split(name, ".") {
+		if label == "" || len(label) > 63 || label[0] == '-' || label[len(label)-1] == '-' {
+			return fmt.Errorf("%q is not a domain", domain)
+		}
+		for _, r := range label {
+			if (r < 'a' || r > 'z') && (r < '0' || r > '9') && r != '-' {
+				return fmt.Errorf("%q is not a domain", domain)
+			}
+		}
+	}
+	return nil
+}
+
+// variants returns domain with and without the www. prefix.
+func variants(domain string) []string {
+	domain = strings.TrimPrefix(strings.TrimSuffix(strings.ToLower(strings.TrimSpace(domain)), "."), "www.")
+	return []string{domain, "www." + domain}
+}
+
+// HostsPath returns where the system keeps its hosts file.
+func HostsPath() string {
+	if runtime.GOOS == "windows" {
+		return filepath.Join(os.Getenv("SystemRoot"), "System32", "drivers", "etc", "hosts")
+	}
+	return "/etc/hosts"
+}
+
+func shell(command string) error {
+	var cmd *exec.Cmd
+	if runtime.GOOS == "windows" {
+		cmd = exec.Command("cmd", "/C", command)
+	} else {
+		cmd = exec.Command("sh", "-c", command)
+	}
+	if out, err := cmd.CombinedOutput(); err != nil {
+		return fmt.Errorf("%s: %w: %s", command, err, strings.TrimSpace(string(out)))
+	}
+	return nil
+}
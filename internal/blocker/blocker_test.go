@@ -0,0 +1,62 @@
+package blocker
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestSection(t *testing.T) {
+	section := beginMarker + "\n0.0.0.0 x.com\n::1 x.com\n0.0.0.0 www.x.com\n::1 www.x.com\n" + endMarker
+	tests := []struct {
+		name, hosts string
+		domains     []string
+		want        string
+	}{
+		{"nothing to remove", "127.0.0.1 localhost", nil, "127.0.0.1 localhost"},
+		{"blank lines kept", "127.0.0.1 localhost\n\n\n", nil, "127.0.0.1 localhost\n\n\n"},
+		{"add", "127.0.0.1 localhost\n", []string{"x.com"}, "127.0.0.1 localhost\n" + section + "\n"},
+		{"add keeps trailing newlines", "127.0.0.1 localhost\n\n", []string{"www.X.com"}, "127.0.0.1 localhost\n" + section + "\n\n"},
+		{"add to a file without a final newline", "127.0.0.1 localhost", []string{"x.com"}, "127.0.0.1 localhost\n" + section + "\n"},
+		{"remove", "127.0.0.1 localhost\n" + section + "\n", nil, "127.0.0.1 localhost\n"},
+		{"remove from the middle", "a\n" + section + "\nb\n", nil, "a\nb\n"},
+		{"replace", "a\n" + beginMarker + "\n0.0.0.0 y.com\n" + endMarker + "\n", []string{"x.com"}, "a\n" + section + "\n"},
+		{"remove the only content", section + "\n", nil, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Section(tt.hosts, tt.domains); got != tt.want {
+				t.Errorf("Section(%q, %q) = %q, want %q", tt.hosts, tt.domains, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCheckDomain(t *testing.T) {
+	tests := []struct {
+		domain string
+		ok     bool
+	}{
+		{"example.com", true},
+		{"www.news.ycombinator.com", true},
+		{"Reddit.COM", true},
+		{"localhost", true},
+		{"example.com.", true},
+		{"xn--bcher-kva.example", true},
+		{"", false},
+		{"x\n1.2.3.4 bank.com", false},
+		{"x.com bank.com", false},
+		{"x.com\t#", false},
+		{"-x.com", false},
+		{"x-.com", false},
+		{"x..com", false},
+		{"x_y.com", false},
+		{"bäcker.de", false},
+		{strings.Repeat("a", 64) + ".com", false},
+		{strings.Repeat("a.", 127) + "com", false},
+	}
+	for _, tt := range tests {
+		if err := CheckDomain(tt.domain); (err == nil) != tt.ok {
+			t.Errorf("CheckDomain(%q) = %v, want ok %v", tt.domain, err, tt.ok)
+		}
+	}
+}
@@ -0,0 +1,82 @@
+// Package buddy works out what a co-working buddy is doing, for body
+// doubling: either a simulated buddy keeping a fixed schedule or a friend
+// whose data directory is shared with this machine.
+package buddy
+
+import (
+	"os"
+	"time"
+
+	"github.com/adibhanna/focussessions/internal/models"
+	"github.com/adibhanna/focussessions/internal/storage"
+)
+
+// Status is a buddy's timer at one moment.
+type Status struct {
+	Name      string
+	Online    bool // Working right now, on a session or a break
+	Focusing  bool // In a session rather than on a break
+	Remaining int  // Seconds left in the current session or break
+}
+
+// Virtual returns the simulated buddy. It starts its first session at the
+// configured work start hour and alternates sessions and breaks of the
+// configured lengths until the work end hour.
+func Virtual(config models.Config, now time.Time) Status {
+	status := Status{Name: "Buddy"}
+
+	focus := time.Duration(config.SessionDuration) * time.Minute
+	rest := time.Duration(config.BreakDuration) * time.Minute
+	if focus <= 0 || config.WorkEndHour <= config.WorkStartHour {
+		return status
+	}
+
+	start := time.Date(now.Year(), now.Month(), now.Day(), config.WorkStartHour, 0, 0, 0, now.Location())
+	end := time.Date(now.Year(), now.Month(), now.Day(), config.WorkEndHour, 0, 0, 0, now.Location())
+	if now.Before(start) || !now.Before(end) {
+		return status
+	}
+
+	into := now.Sub(start) % (focus + rest)
+	status.Online = true
+	status.Focusing = into < focus
+	if status.Focusing {
+		status.Remaining = int((focus - into).Seconds())
+	} else {
+		status.Remaining = int((focus + rest - into).Seconds())
+	}
+	return status
+}
+
+// Friend returns the timer of the friend whose data directory is dir. The
+// friend is online while a session there is running and sending
+// heartbeats; paused sessions count as offline.
+func Friend(dir string, now time.Time) (Status, error) {
+	if _, err := os.Stat(dir); err != nil {
+		return Status{}, err
+	}
+	store, err := storage.Open(dir)
+	if err != nil {
+		return Status{}, err
+	}
+	session, err := store.GetActiveSession()
+	if err != nil {
+		return Status{}, err
+	}
+
+	status := Status{Name: "Friend"}
+	if session == nil {
+		return status, nil
+	}
+	if session.Host != "" {
+		status.Name = session.Host
+	}
+	if session.Paused || now.Sub(session.HeartbeatAt) > storage.HandoffTimeout {
+		return status, nil
+	}
+
+	status.Online = true
+	status.Focusing = true
+	status.Remaining = max(session.Duration*60-session.ElapsedAt(now), 0)
+	return status, nil
+}
@@ -0,0 +1,59 @@
+// Package clipboard copies text to the system clipboard using whatever tool
+// the system provides, falling back to the OSC 52 terminal escape sequence
+// when there is none. Over SSH, OSC 52 comes first so the text lands on the
+// machine in front of you rather than the remote one.
+package clipboard
+
+import (
+	"encoding/base64"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"runtime"
+	"strings"
+)
+
+// tools lists candidate clipboard commands per OS, in order of preference.
+// Each reads the text to copy from stdin.
+var tools = map[string][][]string{
+	"darwin":  {{"pbcopy"}},
+	"linux":   {{"wl-copy"}, {"xclip", "-selection", "clipboard"}, {"xsel", "--clipboard", "--input"}, {"clip.exe"}},
+	"windows": {{"clip"}},
+}
+
+// Copy puts text on the clipboard.
+func Copy(text string) error {
+	if remote() {
+		return copyOSC52(text)
+	}
+	for _, tool := range tools[runtime.GOOS] {
+		bin, err := exec.LookPath(tool[0])
+		if err != nil {
+			continue
+		}
+		cmd := exec.Command(bin, tool[1:]...)
+		cmd.Stdin = strings.NewReader(text)
+		if err := cmd.Run(); err == nil {
+			return nil
+		}
+	}
+	return copyOSC52(text)
+}
+
+// copyOSC52 asks the terminal to set the clipboard. Most modern terminals
+// support it, including over SSH.
+func copyOSC52(text string) error {
+	var w io.Writer = os.Stderr
+	if tty, err := os.OpenFile("/dev/tty", os.O_WRONLY, 0); err == nil {
+		defer tty.Close()
+		w = tty
+	}
+	_, err := fmt.Fprintf(w, "\x1b]52;c;%s\a", base64.StdEncoding.EncodeToString([]byte(text)))
+	return err
+}
+
+// remote reports whether the program runs in an SSH session.
+func remote() bool {
+	return os.Getenv("SSH_TTY") != "" || os.Getenv("SSH_CONNECTION") != ""
+}
@@ -0,0 +1,79 @@
+// Package clock tells the time through an interface, so code that depends
+// on it can be run at a fixed date and moved on without waiting, e.g. to
+// render views deterministically or drive a session to its end in tests.
+package clock
+
+import (
+	"sync"
+	"time"
+)
+
+// Clock tells the wall-clock time, for dates and timestamps, and reads a
+// monotonic clock, for measuring how long something runs. The monotonic
+// clock only moves forward and isn't changed by wall-clock corrections
+// such as NTP steps or the user setting the time; like the system's, it
+// stops while the machine sleeps.
+type Clock interface {
+	Now() time.Time
+	Monotonic() time.Duration
+}
+
+// System is the real clock.
+var System Clock = systemClock{}
+
+// started anchors the system's monotonic readings.
+var started = time.Now()
+
+type systemClock struct{}
+
+func (systemClock) Now() time.Time { return time.Now() }
+
+// Monotonic returns the time since the process started, measured on Go's
+// monotonic clock.
+func (systemClock) Monotonic() time.Duration { return time.Since(started) }
+
+// Manual is a clock that only moves when told to. It is safe for
+// concurrent use.
+type Manual struct {
+	mu   sync.Mutex
+	now  time.Time
+	mono time.Duration
+}
+
+// NewManual returns a clock stopped at now.
+func NewManual(now time.Time) *Manual {
+	return &Manual{now: now}
+}
+
+// Now returns the wall-clock time the clock is at.
+func (c *Manual) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+// Monotonic returns how far the clock has advanced.
+func (c *Manual) Monotonic() time.Duration {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.mono
+}
+
+// Advance lets d pass and returns the new time.
+func (c *Manual) Advance(d time.Duration) time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.now = c.now.Add(d)
+	c.mono += max(d, 0)
+	return c.now
+}
+
+// Step moves the wall clock by d, which may be negative, leaving the
+// monotonic clock where it is: a wall-clock correction, or the machine
+// sleeping for d.
+func (c *Manual) Step(d time.Duration) time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.now = c.now.Add(d)
+	return c.now
+}
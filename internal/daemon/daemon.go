@@ -0,0 +1,275 @@
+// Package daemon runs the background checks that keep working while the
+// dashboard is closed: finishing sessions that ran out, reminding you to
+// start focusing at the beginning of the work day, at scheduled sessions
+// and after a long gap without one, abandoning sessions paused for too long,
+// emailing the weekly report and delivering queued integration events.
+package daemon
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/adibhanna/focussessions/internal/blocker"
+	"github.com/adibhanna/focussessions/internal/models"
+	"github.com/adibhanna/focussessions/internal/notify"
+	"github.com/adibhanna/focussessions/internal/outbox"
+	"github.com/adibhanna/focussessions/internal/storage"
+)
+
+// DefaultInterval is how often the daemon checks the stored sessions.
+const DefaultInterval = 30 * time.Second
+
+// Daemon holds the state kept between checks.
+type Daemon struct {
+	store *storage.Storage
+	logf  func(format string, args ...any)
+
+	remindedOn     string    // date of the last work day reminder
+	scheduledUntil time.Time // scheduled sessions up to this time have been reminded of
+	nudgedAt       time.Time // time of the last idle reminder
+}
+
+// New returns a daemon over store that logs with logf.
+func New(store *storage.Storage, logf func(format string, args ...any)) *Daemon {
+	return &Daemon{store: store, logf: logf}
+}
+
+// Run checks every interval until ctx is cancelled.
+func (d *Daemon) Run(ctx context.Context, interval time.Duration) error {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		d.Check(time.Now())
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// Check runs every background check once.
+func (d *Daemon) Check(now time.Time) {
+	config, err := d.store.GetConfig()
+	if err != nil {
+		d.logf("reading config: %v", err)
+		return
+	}
+	if session, reason, err := d.store.ExpirePaused(config, now); err != nil {
+		d.logf("expiring paused sessions: %v", err)
+	} else if session != nil {
+		d.logf("abandoned session %s: it %s", session.ID, reason)
+		d.unblock(config)
+	}
+	if err := d.finishExpired(config, now); err != nil {
+		d.logf("finishing sessions: %v", err)
+	}
+	if err := d.remindWorkDay(config, now); err != nil {
+		d.logf("work day reminder: %v", err)
+	}
+	if err := d.remindScheduled(config, now); err != nil {
+		d.logf("scheduled session reminder: %v", err)
+	}
+	if err := d.remindIdle(config, now); err != nil {
+		d.logf("idle reminder: %v", err)
+	}
+	if err := d.sendWeeklyReport(config, now); err != nil {
+		d.logf("weekly report: %v", err)
+	}
+	delivered, failed, err := outbox.Flush(d.store, now, outbox.DefaultLimit)
+	if err != nil {
+		d.logf("outbox: %v", err)
+	}
+	if delivered > 0 || failed > 0 {
+		d.logf("outbox: %d delivered, %d failed", delivered, failed)
+	}
+}
+
+// notify shows a desktop notification unless notifications are off or it
+// is the quiet hours.
+func (d *Daemon) notify(config models.Config, now time.Time, title, body string) error {
+	if !config.NotifyAt(now) {
+		return nil
+	}
+	return notify.Send(title, body)
+}
+
+// unblock lifts the sites blocked for a session the daemon ended, which
+// would otherwise stay blocked until the dashboard is next opened.
+func (d *Daemon) unblock(config models.Config) {
+	if err := blocker.New(config, d.store.BlockStatePath()).Unblock(); err != nil {
+		d.logf("unblocking sites: %v", err)
+	}
+}
+
+// finishExpired completes a session that ran to the end while no dashboard
+// was running it. Sessions a dashboard still sends heartbeats for are left
+// to it.
+func (d *Daemon) finishExpired(config models.Config, now time.Time) error {
+	session, err := d.store.GetActiveSession()
+	if err != nil || session == nil || session.Paused {
+		return err
+	}
+	if !session.HeartbeatAt.IsZero() && now.Sub(session.HeartbeatAt) <= storage.HandoffTimeout {
+		return nil
+	}
+
+	duration := session.Duration * 60
+	elapsed := session.ElapsedAt(now)
+	if elapsed < duration {
+		return nil
+	}
+
+	session.Completed = true
+	session.Active = false
+	session.ElapsedSeconds = duration
+	session.EndTime = now.Add(-time.Duration(elapsed-duration) * time.Second)
+	if err := d.store.SaveSession(*session); err != nil {
+		return err
+	}
+	d.logf("completed session %s", session.ID)
+	d.unblock(config)
+	if err := outbox.Publish(d.store, config, outbox.EventSessionCompleted, *session, now); err != nil {
+		d.logf("outbox: %v", err)
+	}
+
+	body := "Great job!"
+	if label := session.Label(); label != "" {
+		body = label
+	}
+	return d.notify(config, now, "Session complete", body)
+}
+
+// offDay reports whether now falls on a day marked off, which the
+// reminders leave alone.
+func (d *Daemon) offDay(now time.Time) (bool, error) {
+	days, err := d.store.GetOffDays()
+	if err != nil {
+		return false, err
+	}
+	_, off := days[now.Format("2006-01-02")]
+	return off, nil
+}
+
+// remindWorkDay nudges once per work day, when the work day starts and no
+// session has been started yet.
+func (d *Daemon) remindWorkDay(config models.Config, now time.Time) error {
+	today := now.Format("2006-01-02")
+	if d.remindedOn == today || now.Hour() != config.WorkStartHour {
+		return nil
+	}
+	if now.Weekday() == time.Saturday || now.Weekday() == time.Sunday {
+		return nil
+	}
+	if off, err := d.offDay(now); err != nil || off {
+		return err
+	}
+
+	sessions, err := d.store.GetSessionsByDate(today)
+	if err != nil {
+		return err
+	}
+	d.remindedOn = today
+	if len(sessions) > 0 {
+		return nil
+	}
+	return d.notify(config, now, "Time to focus", fmt.Sprintf("Your work day has started. Goal: %d sessions.", config.DailySessionGoal))
+}
+
+// remindScheduled nudges when a scheduled session comes due and no session
+// is running. Sessions due before the daemon started are not reminded of.
+func (d *Daemon) remindScheduled(config models.Config, now time.Time) error {
+	since := d.scheduledUntil
+	d.scheduledUntil = now
+	if since.IsZero() {
+		return nil
+	}
+
+	var due []models.ScheduledStart
+	for _, start := range config.ScheduledStarts(now) {
+		if start.At.After(since) && !start.At.After(now) {
+			due = append(due, start)
+		}
+	}
+	if len(due) == 0 {
+		return nil
+	}
+	if off, err := d.offDay(now); err != nil || off {
+		return err
+	}
+	if active, err := d.store.GetActiveSession(); err != nil || active != nil {
+		return err
+	}
+	return d.notify(config, now, "Scheduled focus session", due[len(due)-1].Label())
+}
+
+// remindIdle nudges during work hours on weekdays not marked off when no
+// session has run for the configured gap, and again after each further gap.
+func (d *Daemon) remindIdle(config models.Config, now time.Time) error {
+	if config.IdleReminder <= 0 || now.Hour() < config.WorkStartHour || now.Hour() >= config.WorkEndHour {
+		return nil
+	}
+	if now.Weekday() == time.Saturday || now.Weekday() == time.Sunday {
+		return nil
+	}
+	if off, err := d.offDay(now); err != nil || off {
+		return err
+	}
+
+	if active, err := d.store.GetActiveSession(); err != nil || active != nil {
+		return err
+	}
+	sessions, err := d.store.GetSessionsByDate(now.Format("2006-01-02"))
+	if err != nil {
+		return err
+	}
+	// Idle since the work day started or the last session ended
+	idleSince := time.Date(now.Year(), now.Month(), now.Day(), config.WorkStartHour, 0, 0, 0, now.Location())
+	focused := false
+	for _, session := range sessions {
+		end := session.EndTime
+		if end.IsZero() {
+			end = session.StartTime.Add(time.Duration(session.ActualMinutes()) * time.Minute)
+		}
+		if end.After(idleSince) {
+			idleSince, focused = end, true
+		}
+	}
+
+	gap := time.Duration(config.IdleReminder) * time.Minute
+	if now.Sub(idleSince) < gap || now.Sub(d.nudgedAt) < gap {
+		return nil
+	}
+	d.nudgedAt = now
+
+	idle := models.FormatMinutes(int(now.Sub(idleSince).Minutes()))
+	body := fmt.Sprintf("It's been %s since your last focus block", idle)
+	if !focused {
+		body = fmt.Sprintf("No focus block yet, %s into the work day", idle)
+	}
+	return d.notify(config, now, "Time to focus", body)
+}
+
+// sendWeeklyReport queues last week's report on Monday morning, once a
+// week. When it was last queued is kept in the data directory, so a
+// restarted daemon doesn't send it again.
+func (d *Daemon) sendWeeklyReport(config models.Config, now time.Time) error {
+	if config.Email == nil || !config.Email.Ready() {
+		return nil
+	}
+	sentAt, err := d.store.GetReportSent()
+	if err != nil {
+		return err
+	}
+	if !config.Email.ReportDue(now, sentAt) {
+		return nil
+	}
+	if err := outbox.PublishWeeklyReport(d.store, config, now); err != nil {
+		return err
+	}
+	d.logf("queued the weekly report for %s", strings.Join(config.Email.To, ", "))
+	return d.store.SetReportSent(now)
+}
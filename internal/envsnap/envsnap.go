@@ -0,0 +1,106 @@
+// Package envsnap captures a lightweight snapshot of the environment a
+// session was started in. Every probe is best effort: anything that can't
+// be determined quickly is simply left out.
+package envsnap
+
+import (
+	"context"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"time"
+)
+
+// Metadata keys written by Capture.
+const (
+	KeyHost    = "host"
+	KeyTTY     = "tty"
+	KeyTmux    = "tmux"
+	KeyBattery = "battery"
+	KeyGitRepo = "git_repo"
+)
+
+const commandTimeout = 300 * time.Millisecond
+
+// Capture returns the current environment snapshot.
+func Capture() map[string]string {
+	meta := make(map[string]string)
+
+	if host, err := os.Hostname(); err == nil && host != "" {
+		meta[KeyHost] = host
+	}
+
+	if tty := ttyName(); tty != "" {
+		meta[KeyTTY] = tty
+	}
+
+	if os.Getenv("TMUX") != "" {
+		if name := run("tmux", "display-message", "-p", "#S"); name != "" {
+			meta[KeyTmux] = name
+		}
+	}
+
+	if battery := batteryLevel(); battery != "" {
+		meta[KeyBattery] = battery
+	}
+
+	if top := run("git", "rev-parse", "--show-toplevel"); top != "" {
+		meta[KeyGitRepo] = filepath.Base(top)
+	}
+
+	return meta
+}
+
+func ttyName() string {
+	if runtime.GOOS == "linux" {
+		if name, err := os.Readlink("/proc/self/fd/0"); err == nil && strings.HasPrefix(name, "/dev/") {
+			return name
+		}
+		return ""
+	}
+	if runtime.GOOS == "windows" {
+		return ""
+	}
+	return run("tty")
+}
+
+func batteryLevel() string {
+	switch runtime.GOOS {
+	case "linux":
+		matches, _ := filepath.Glob("/sys/class/power_supply/BAT*/capacity")
+		for _, path := range matches {
+			if data, err := os.ReadFile(path); err == nil {
+				return strings.TrimSpace(string(data)) + "%"
+			}
+		}
+	case "darwin":
+		// "Now drawing from 'AC Power'\n -InternalBattery-0 (id=...)	87%; charging; ..."
+		out := run("pmset", "-g", "batt")
+		for _, field := range strings.FieldsFunc(out, func(r rune) bool { return r == '\t' || r == ';' || r == '\n' }) {
+			field = strings.TrimSpace(field)
+			if strings.HasSuffix(field, "%") {
+				return field
+			}
+		}
+	}
+	return ""
+}
+
+// run executes a command with a short timeout and returns its trimmed
+// stdout, or an empty string on any failure.
+func run(name string, args ...string) string {
+	ctx, cancel := context.WithTimeout(context.Background(), commandTimeout)
+	defer cancel()
+
+	cmd := exec.CommandContext(ctx, name, args...)
+	if name == "tty" {
+		cmd.Stdin = os.Stdin
+	}
+	out, err := cmd.Output()
+	if err != nil {
+		return ""
+	}
+	return strings.TrimSpace(string(out))
+}
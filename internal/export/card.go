@@ -0,0 +1,79 @@
+package export
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/adibhanna/focussessions/internal/models"
+)
+
+// cardDays is how many days the card's heatmap strip covers.
+const cardDays = 28
+
+// heatLevels shade a day by its focus time, from none to the busiest day.
+var heatLevels = []rune{'·', '░', '▒', '▓', '█'}
+
+// renderCard produces a compact summary of the period for pasting into chat:
+// totals, the current streak and a heatmap strip of the last four weeks.
+func renderCard(store Store, opts Options, sessions []models.Session, now time.Time) (string, error) {
+	completed, minutes := 0, 0
+	for _, s := range sessions {
+		if s.Completed {
+			completed++
+			minutes += s.ActualMinutes()
+		}
+	}
+
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+	daily, err := store.GetDailyMinutes(today.AddDate(0, 0, 1-cardDays), today.AddDate(0, 0, 1))
+	if err != nil {
+		return "", err
+	}
+	off, err := store.GetOffDaysIn(today.AddDate(0, 0, 1-cardDays), today.AddDate(0, 0, 1))
+	if err != nil {
+		return "", err
+	}
+
+	lines := []string{
+		fmt.Sprintf("🎯 Focus Sessions · %s", opts.Period),
+		fmt.Sprintf("%d sessions · %s focused", completed, models.FormatMinutes(minutes)),
+		fmt.Sprintf("🔥 %d-day streak", models.CurrentStreak(daily, off)),
+		heatStrip(daily) + "  last 4 weeks",
+	}
+
+	return box(lines), nil
+}
+
+// box draws a frame around lines.
+func box(lines []string) string {
+	width := 0
+	for _, line := range lines {
+		width = max(width, lipgloss.Width(line))
+	}
+	var b strings.Builder
+	b.WriteString("┌" + strings.Repeat("─", width+2) + "┐\n")
+	for _, line := range lines {
+		b.WriteString("│ " + line + strings.Repeat(" ", width-lipgloss.Width(line)) + " │\n")
+	}
+	b.WriteString("└" + strings.Repeat("─", width+2) + "┘\n")
+	return b.String()
+}
+
+func heatStrip(daily []int) string {
+	busiest := 0
+	for _, minutes := range daily {
+		busiest = max(busiest, minutes)
+	}
+	var b strings.Builder
+	for _, minutes := range daily {
+		level := 0
+		if minutes > 0 {
+			level = 1 + minutes*(len(heatLevels)-2)/busiest
+		}
+		b.WriteRune(heatLevels[level])
+	}
+	return b.String()
+}
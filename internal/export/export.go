@@ -0,0 +1,384 @@
+// Package export renders sessions for a period into shareable reports and
+// writes them to disk. It backs the export wizard used by the dashboard and
+// stats views.
+package export
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/adibhanna/focussessions/internal/models"
+)
+
+type Period int
+
+const (
+	Today Period = iota
+	ThisWeek
+	ThisMonth
+	ThisYear
+	AllTime
+	LastWeek // The week before this one, for the weekly email
+)
+
+var periodNames = []string{"Today", "This week", "This month", "This year", "All time", "Last week"}
+
+func (p Period) String() string { return periodNames[p] }
+
+// Periods lists every period in display order.
+func Periods() []Period { return []Period{Today, ThisWeek, ThisMonth, ThisYear, AllTime} }
+
+// Range returns the [from, to) interval covered by the period. AllTime
+// returns zero times.
+func (p Period) Range(now time.Time, weekStart time.Weekday) (time.Time, time.Time) {
+	day := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+	switch p {
+	case Today:
+		return day, day.AddDate(0, 0, 1)
+	case ThisWeek:
+		start := models.WeekStart(now, weekStart)
+		return start, start.AddDate(0, 0, 7)
+	case LastWeek:
+		start := models.WeekStart(now, weekStart).AddDate(0, 0, -7)
+		return start, start.AddDate(0, 0, 7)
+	case ThisMonth:
+		start := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, now.Location())
+		return start, start.AddDate(0, 1, 0)
+	case ThisYear:
+		start := time.Date(now.Year(), 1, 1, 0, 0, 0, 0, now.Location())
+		return start, start.AddDate(1, 0, 0)
+	}
+	return time.Time{}, time.Time{}
+}
+
+type Format int
+
+const (
+	Text Format = iota
+	CSV
+	JSON
+	Card
+	HTML
+	Markdown
+)
+
+var formatNames = []string{"Text report", "CSV", "JSON", "Stats card (copied to clipboard)", "HTML report with charts", "Markdown report"}
+
+func (f Format) String() string { return formatNames[f] }
+
+// Extension returns the file extension for the format, without the dot.
+func (f Format) Extension() string {
+	switch f {
+	case CSV:
+		return "csv"
+	case JSON:
+		return "json"
+	case HTML:
+		return "html"
+	case Markdown:
+		return "md"
+	}
+	return "txt"
+}
+
+// Formats lists every format in display order.
+func Formats() []Format { return []Format{Text, CSV, JSON, Card, HTML, Markdown} }
+
+// Options describes one export.
+type Options struct {
+	Period        Period
+	Format        Format
+	CompletedOnly bool
+}
+
+// Store is the storage exports read, satisfied by *storage.Storage.
+type Store interface {
+	FirstWeekday() time.Weekday
+	GetAllSessions() ([]models.Session, error)
+	GetSessionsInRange(from, to time.Time) ([]models.Session, error)
+	GetJournalRange(start, end time.Time) (map[string]string, error)
+	GetDailyMinutes(from, to time.Time) ([]int, error)
+	GetOffDaysIn(from, to time.Time) ([]bool, error)
+	ExportAllStats() (string, error)
+}
+
+// Render produces the export contents for opts.
+func Render(store Store, opts Options, now time.Time) ([]byte, error) {
+	if opts.Format == Text && opts.Period == AllTime && !opts.CompletedOnly {
+		report, err := store.ExportAllStats()
+		return []byte(report), err
+	}
+
+	sessions, err := Sessions(store, opts, now)
+	if err != nil {
+		return nil, err
+	}
+
+	switch opts.Format {
+	case CSV:
+		return renderCSV(sessions)
+	case JSON:
+		return json.MarshalIndent(sessions, "", "  ")
+	case Card:
+		card, err := renderCard(store, opts, sessions, now)
+		return []byte(card), err
+	case HTML:
+		return renderHTML(opts, sessions, store.FirstWeekday(), now)
+	}
+
+	var from, to time.Time
+	if opts.Period != AllTime {
+		from, to = opts.Period.Range(now, store.FirstWeekday())
+	}
+	notes, err := store.GetJournalRange(from, to)
+	if err != nil {
+		return nil, err
+	}
+	if opts.Format == Markdown {
+		return []byte(renderMarkdown(opts, sessions, notes, now)), nil
+	}
+	return []byte(renderText(opts, sessions, notes, now)), nil
+}
+
+// Sessions returns the sessions selected by opts, oldest first.
+func Sessions(store Store, opts Options, now time.Time) ([]models.Session, error) {
+	var sessions []models.Session
+	var err error
+	if opts.Period == AllTime {
+		sessions, err = store.GetAllSessions()
+	} else {
+		from, to := opts.Period.Range(now, store.FirstWeekday())
+		sessions, err = store.GetSessionsInRange(from, to)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	filtered := sessions[:0]
+	for _, session := range sessions {
+		if opts.CompletedOnly && !session.Completed {
+			continue
+		}
+		filtered = append(filtered, session)
+	}
+	sort.Slice(filtered, func(i, j int) bool {
+		return filtered[i].StartTime.Before(filtered[j].StartTime)
+	})
+	return filtered, nil
+}
+
+func renderCSV(sessions []models.Session) ([]byte, error) {
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	w.Write([]string{"id", "date", "start", "end", "planned_minutes", "actual_minutes", "completed", "tag", "project", "intention"})
+	for _, s := range sessions {
+		end := ""
+		if !s.EndTime.IsZero() {
+			end = s.EndTime.Format(time.RFC3339)
+		}
+		w.Write([]string{
+			s.ID,
+			s.Date,
+			s.StartTime.Format(time.RFC3339),
+			end,
+			strconv.Itoa(s.Duration),
+			strconv.Itoa(s.ActualMinutes()),
+			strconv.FormatBool(s.Completed),
+			s.Tag,
+			s.Project,
+			s.Intention,
+		})
+	}
+	w.Flush()
+	return buf.Bytes(), w.Error()
+}
+
+// renderText lists the sessions by day, with each day's journal note.
+func renderText(opts Options, sessions []models.Session, notes map[string]string, now time.Time) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "Focus Sessions - %s Report\n", opts.Period)
+	fmt.Fprintf(&b, "Generated: %s\n", now.Format("January 2, 2006 3:04 PM"))
+	fmt.Fprintf(&b, "=====================================\n\n")
+
+	completed, minutes := totals(sessions)
+	fmt.Fprintf(&b, "Sessions: %d (%d completed)\n", len(sessions), completed)
+	fmt.Fprintf(&b, "Total Focus Time: %s\n\n", models.FormatMinutes(minutes))
+
+	dates, byDate := byDay(sessions, notes)
+	for _, date := range dates {
+		day, _ := time.Parse("2006-01-02", date)
+		fmt.Fprintf(&b, "%s\n", day.Format("Monday, January 2, 2006"))
+		if note := notes[date]; note != "" {
+			fmt.Fprintf(&b, "  Journal: %s\n", strings.ReplaceAll(note, "\n", "\n           "))
+		}
+		for _, s := range byDate[date] {
+			line := fmt.Sprintf("  %s  %3d min  %s", s.StartTime.Format("3:04 PM"), s.ActualMinutes(), status(s))
+			if label := s.Label(); label != "" {
+				line += "  " + label
+			}
+			fmt.Fprintln(&b, line)
+		}
+		fmt.Fprintln(&b)
+	}
+
+	return b.String()
+}
+
+// renderMarkdown lays out the same report as renderText in Markdown, with
+// a table of sessions per day, e.g. for an email.
+func renderMarkdown(opts Options, sessions []models.Session, notes map[string]string, now time.Time) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "# Focus Sessions - %s\n\n", opts.Period)
+	fmt.Fprintf(&b, "_Generated %s_\n\n", now.Format("January 2, 2006 3:04 PM"))
+
+	completed, minutes := totals(sessions)
+	fmt.Fprintf(&b, "- **Sessions:** %d (%d completed)\n", len(sessions), completed)
+	fmt.Fprintf(&b, "- **Focus time:** %s\n", models.FormatMinutes(minutes))
+
+	dates, byDate := byDay(sessions, notes)
+	for _, date := range dates {
+		day, _ := time.Parse("2006-01-02", date)
+		fmt.Fprintf(&b, "\n## %s\n\n", day.Format("Monday, January 2, 2006"))
+		if note := notes[date]; note != "" {
+			fmt.Fprintf(&b, "> %s\n\n", strings.ReplaceAll(note, "\n", "\n> "))
+		}
+		if len(byDate[date]) == 0 {
+			continue
+		}
+		fmt.Fprintln(&b, "| Start | Minutes | Status | Label |")
+		fmt.Fprintln(&b, "|---|--:|---|---|")
+		for _, s := range byDate[date] {
+			label := strings.ReplaceAll(s.Label(), "|", "\\|")
+			fmt.Fprintf(&b, "| %s | %d | %s | %s |\n", s.StartTime.Format("3:04 PM"), s.ActualMinutes(), status(s), label)
+		}
+	}
+
+	return b.String()
+}
+
+// totals counts the completed sessions and their minutes.
+func totals(sessions []models.Session) (completed, minutes int) {
+	for _, s := range sessions {
+		if s.Completed {
+			completed++
+			minutes += s.ActualMinutes()
+		}
+	}
+	return completed, minutes
+}
+
+// byDay groups sessions by date, and returns the dates with sessions or a
+// journal note in order.
+func byDay(sessions []models.Session, notes map[string]string) ([]string, map[string][]models.Session) {
+	byDate := make(map[string][]models.Session)
+	var dates []string
+	for _, s := range sessions {
+		if _, ok := byDate[s.Date]; !ok {
+			dates = append(dates, s.Date)
+		}
+		byDate[s.Date] = append(byDate[s.Date], s)
+	}
+	for date := range notes {
+		if _, ok := byDate[date]; !ok {
+			dates = append(dates, date)
+		}
+	}
+	sort.Strings(dates)
+	return dates, byDate
+}
+
+// status describes how a session ended.
+func status(s models.Session) string {
+	switch {
+	case s.Abandoned:
+		return "abandoned"
+	case !s.Completed:
+		return "stopped early"
+	}
+	return "completed"
+}
+
+// Destination is where an export file is written.
+type Destination int
+
+const (
+	Downloads Destination = iota
+	Home
+	WorkingDir
+	Clipboard
+	CustomPath
+)
+
+var destinationNames = []string{"~/Downloads", "Home directory", "Current directory", "Clipboard", "Custom path…"}
+
+func (d Destination) String() string { return destinationNames[d] }
+
+// Destinations lists every destination in display order.
+func Destinations() []Destination {
+	return []Destination{Downloads, Home, WorkingDir, Clipboard, CustomPath}
+}
+
+// Filename returns the default file name for an export made at now.
+func Filename(format Format, now time.Time) string {
+	return fmt.Sprintf("focussessions-stats-%s.%s", now.Format("2006-01-02-150405"), format.Extension())
+}
+
+// Write saves data to the destination and returns the path written. For
+// CustomPath, custom is either a directory or a full file path. Clipboard
+// is not a file; copy the data with the clipboard package instead. Writing to
+// Downloads falls back to the home directory when Downloads doesn't exist.
+func Write(data []byte, dest Destination, custom string, format Format, now time.Time) (string, error) {
+	filename := Filename(format, now)
+
+	homeDir, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("failed to get home directory: %w", err)
+	}
+
+	var path string
+	switch dest {
+	case Downloads:
+		path = filepath.Join(homeDir, "Downloads", filename)
+		if err := os.WriteFile(path, data, 0644); err == nil {
+			return path, nil
+		}
+		// Try alternative location if Downloads doesn't exist
+		path = filepath.Join(homeDir, filename)
+	case Home:
+		path = filepath.Join(homeDir, filename)
+	case WorkingDir:
+		wd, err := os.Getwd()
+		if err != nil {
+			return "", err
+		}
+		path = filepath.Join(wd, filename)
+	case CustomPath:
+		custom = strings.TrimSpace(custom)
+		if custom == "" {
+			return "", fmt.Errorf("no path given")
+		}
+		if strings.HasPrefix(custom, "~/") {
+			custom = filepath.Join(homeDir, custom[2:])
+		}
+		path = custom
+		if info, err := os.Stat(custom); err == nil && info.IsDir() {
+			path = filepath.Join(custom, filename)
+		}
+	}
+
+	if path == "" {
+		return "", fmt.Errorf("%s is not a file destination", dest)
+	}
+	if err := os.WriteFile(path, data, 0644); err != nil {
+		return "", fmt.Errorf("failed to save file: %w", err)
+	}
+	return path, nil
+}
@@ -0,0 +1,284 @@
+package export
+
+import (
+	"bytes"
+	"fmt"
+	"html/template"
+	"time"
+
+	"github.com/adibhanna/focussessions/internal/models"
+)
+
+// Chart geometry, in SVG user units.
+const (
+	chartHeight = 120
+	chartWidth  = 720
+	heatCell    = 12
+	heatGap     = 2
+)
+
+// heatColors shade a heatmap cell by its focus time, from none to the
+// busiest day.
+var heatColors = []string{"#ebedf0", "#c6e48b", "#7bc96f", "#239a3b", "#196127"}
+
+type htmlBar struct {
+	X, Y, Width, Height int
+	Title               string
+}
+
+type htmlCell struct {
+	X, Y  int
+	Color string
+	Title string
+}
+
+type htmlLabel struct {
+	X    int
+	Text string
+}
+
+type htmlRow struct {
+	Date, Start, Status, Label string
+	Minutes                    int
+}
+
+type htmlReport struct {
+	Period       string
+	Generated    string
+	Sessions     int
+	Completed    int
+	Focus        string
+	ActiveDays   int
+	DailyAverage string
+	ChartWidth   int
+	ChartHeight  int
+	Days         []htmlBar
+	DayLabels    []htmlLabel
+	HeatWidth    int
+	HeatHeight   int
+	Heatmap      []htmlCell
+	Hours        []htmlBar
+	HourLabels   []htmlLabel
+	Rows         []htmlRow
+}
+
+// renderHTML produces a standalone page with the period's totals, a bar
+// chart of daily focus time, a calendar heatmap and an hour-of-day
+// histogram. Everything is inlined so the file can be opened or shared on
+// its own.
+func renderHTML(opts Options, sessions []models.Session, weekStart time.Weekday, now time.Time) ([]byte, error) {
+	from, to := opts.Period.Range(now, weekStart)
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+	if opts.Period == AllTime {
+		from, to = today, today.AddDate(0, 0, 1)
+		if len(sessions) > 0 {
+			first := sessions[0].StartTime.In(now.Location())
+			from = time.Date(first.Year(), first.Month(), first.Day(), 0, 0, 0, 0, now.Location())
+		}
+	}
+
+	index := make(map[string]int)
+	var dates []time.Time
+	for d := from; d.Before(to); d = d.AddDate(0, 0, 1) {
+		index[d.Format("2006-01-02")] = len(dates)
+		dates = append(dates, d)
+	}
+	daily := make([]int, len(dates))
+	var hourly [24]int
+
+	report := htmlReport{
+		Period:      opts.Period.String(),
+		Generated:   now.Format("Monday, January 2, 2006 at 3:04 PM"),
+		Sessions:    len(sessions),
+		ChartWidth:  chartWidth,
+		ChartHeight: chartHeight,
+	}
+	minutes := 0
+	for _, s := range sessions {
+		status := "completed"
+		if s.Abandoned {
+			status = "abandoned"
+		} else if !s.Completed {
+			status = "stopped early"
+		}
+		start := s.StartTime.In(now.Location())
+		report.Rows = append(report.Rows, htmlRow{
+			Date:    start.Format("Mon Jan 2, 2006"),
+			Start:   start.Format("3:04 PM"),
+			Status:  status,
+			Label:   s.Label(),
+			Minutes: s.ActualMinutes(),
+		})
+
+		if !s.Completed {
+			continue
+		}
+		report.Completed++
+		minutes += s.ActualMinutes()
+		if i, ok := index[start.Format("2006-01-02")]; ok {
+			daily[i] += s.ActualMinutes()
+		}
+		for minute := range s.ActualMinutes() {
+			hourly[start.Add(time.Duration(minute)*time.Minute).Hour()]++
+		}
+	}
+
+	for _, m := range daily {
+		if m > 0 {
+			report.ActiveDays++
+		}
+	}
+	report.Focus = models.FormatMinutes(minutes)
+	if report.ActiveDays > 0 {
+		report.DailyAverage = models.FormatMinutes(minutes / report.ActiveDays)
+	}
+
+	// Daily bars, labelled at the first of each month or every week for
+	// short ranges
+	busiest := 1
+	for _, m := range daily {
+		busiest = max(busiest, m)
+	}
+	step := chartWidth / max(len(daily), 1)
+	for i, m := range daily {
+		height := m * chartHeight / busiest
+		report.Days = append(report.Days, htmlBar{
+			X:      i * step,
+			Y:      chartHeight - height,
+			Width:  max(step-1, 1),
+			Height: height,
+			Title:  fmt.Sprintf("%s: %s", dates[i].Format("Mon Jan 2"), models.FormatMinutes(m)),
+		})
+		if (len(dates) > 62 && dates[i].Day() == 1) || (len(dates) <= 62 && (len(dates) <= 7 || dates[i].Weekday() == weekStart)) {
+			label := dates[i].Format("Jan 2")
+			if len(dates) > 62 {
+				label = dates[i].Format("Jan")
+			} else if len(dates) <= 7 {
+				label = dates[i].Format("Mon")
+			}
+			report.DayLabels = append(report.DayLabels, htmlLabel{X: i * step, Text: label})
+		}
+	}
+
+	// Heatmap: one column per week, one row per weekday
+	for i, m := range daily {
+		offset := int(dates[0].Weekday()-weekStart+7)%7 + i
+		level := 0
+		if m > 0 {
+			level = 1 + m*(len(heatColors)-2)/busiest
+		}
+		report.Heatmap = append(report.Heatmap, htmlCell{
+			X:     offset / 7 * (heatCell + heatGap),
+			Y:     offset % 7 * (heatCell + heatGap),
+			Color: heatColors[level],
+			Title: report.Days[i].Title,
+		})
+		report.HeatWidth = offset/7*(heatCell+heatGap) + heatCell
+	}
+	report.HeatHeight = 7*(heatCell+heatGap) - heatGap
+
+	// Hour-of-day histogram
+	busiestHour := 1
+	for _, m := range hourly {
+		busiestHour = max(busiestHour, m)
+	}
+	hourStep := chartWidth / 24
+	for hour, m := range hourly {
+		height := m * chartHeight / busiestHour
+		report.Hours = append(report.Hours, htmlBar{
+			X:      hour * hourStep,
+			Y:      chartHeight - height,
+			Width:  hourStep - 2,
+			Height: height,
+			Title:  fmt.Sprintf("%02d:00: %s", hour, models.FormatMinutes(m)),
+		})
+		if hour%3 == 0 {
+			report.HourLabels = append(report.HourLabels, htmlLabel{X: hour * hourStep, Text: fmt.Sprintf("%02d:00", hour)})
+		}
+	}
+
+	var buf bytes.Buffer
+	if err := htmlTemplate.Execute(&buf, report); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+var htmlTemplate = template.Must(template.New("report").Parse(`<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="utf-8">
+<meta name="viewport" content="width=device-width, initial-scale=1">
+<title>Focus Sessions · {{.Period}}</title>
+<style>
+body { font-family: -apple-system, BlinkMacSystemFont, "Segoe UI", Helvetica, Arial, sans-serif; color: #24292e; max-width: 780px; margin: 2rem auto; padding: 0 1rem; }
+h1 { margin-bottom: 0; }
+h2 { margin-top: 2.5rem; font-size: 1.1rem; }
+.generated { color: #6a737d; margin-top: .25rem; }
+.totals { display: flex; flex-wrap: wrap; gap: 1rem; margin-top: 1.5rem; }
+.total { background: #f6f8fa; border-radius: 6px; padding: .75rem 1rem; min-width: 120px; }
+.total strong { display: block; font-size: 1.4rem; }
+.total span { color: #6a737d; font-size: .85rem; }
+svg text { font-size: 10px; fill: #6a737d; }
+table { border-collapse: collapse; width: 100%; font-size: .9rem; }
+th, td { text-align: left; padding: .3rem .5rem; border-bottom: 1px solid #eaecef; }
+td.minutes { text-align: right; }
+.empty { color: #6a737d; }
+</style>
+</head>
+<body>
+<h1>🎯 Focus Sessions · {{.Period}}</h1>
+<p class="generated">Generated {{.Generated}}</p>
+
+<div class="totals">
+<div class="total"><strong>{{.Completed}}</strong><span>completed of {{.Sessions}} sessions</span></div>
+<div class="total"><strong>{{.Focus}}</strong><span>focus time</span></div>
+<div class="total"><strong>{{.ActiveDays}}</strong><span>active days</span></div>
+{{- if .DailyAverage}}
+<div class="total"><strong>{{.DailyAverage}}</strong><span>per active day</span></div>
+{{- end}}
+</div>
+
+<h2>Focus time per day</h2>
+<svg width="{{.ChartWidth}}" height="{{.ChartHeight}}" viewBox="0 -2 {{.ChartWidth}} {{.ChartHeight}}" style="overflow: visible; max-width: 100%">
+{{- range .Days}}
+<rect x="{{.X}}" y="{{.Y}}" width="{{.Width}}" height="{{.Height}}" fill="#7bc96f"><title>{{.Title}}</title></rect>
+{{- end}}
+<line x1="0" y1="{{.ChartHeight}}" x2="{{.ChartWidth}}" y2="{{.ChartHeight}}" stroke="#d1d5da"/>
+{{- range .DayLabels}}
+<text x="{{.X}}" y="{{$.ChartHeight}}" dy="14">{{.Text}}</text>
+{{- end}}
+</svg>
+
+<h2>Calendar</h2>
+<svg width="{{.HeatWidth}}" height="{{.HeatHeight}}" style="max-width: 100%">
+{{- range .Heatmap}}
+<rect x="{{.X}}" y="{{.Y}}" width="12" height="12" rx="2" fill="{{.Color}}"><title>{{.Title}}</title></rect>
+{{- end}}
+</svg>
+
+<h2>Focus time by hour of day</h2>
+<svg width="{{.ChartWidth}}" height="{{.ChartHeight}}" viewBox="0 -2 {{.ChartWidth}} {{.ChartHeight}}" style="overflow: visible; max-width: 100%">
+{{- range .Hours}}
+<rect x="{{.X}}" y="{{.Y}}" width="{{.Width}}" height="{{.Height}}" fill="#239a3b"><title>{{.Title}}</title></rect>
+{{- end}}
+<line x1="0" y1="{{.ChartHeight}}" x2="{{.ChartWidth}}" y2="{{.ChartHeight}}" stroke="#d1d5da"/>
+{{- range .HourLabels}}
+<text x="{{.X}}" y="{{$.ChartHeight}}" dy="14">{{.Text}}</text>
+{{- end}}
+</svg>
+
+<h2>Sessions</h2>
+{{- if .Rows}}
+<table>
+<tr><th>Date</th><th>Start</th><th>Minutes</th><th>Status</th><th>Label</th></tr>
+{{- range .Rows}}
+<tr><td>{{.Date}}</td><td>{{.Start}}</td><td class="minutes">{{.Minutes}}</td><td>{{.Status}}</td><td>{{.Label}}</td></tr>
+{{- end}}
+</table>
+{{- else}}
+<p class="empty">No sessions in this period.</p>
+{{- end}}
+</body>
+</html>
+`))
@@ -0,0 +1,50 @@
+package export
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/adibhanna/focussessions/internal/models"
+)
+
+// YearInReviewLines describes the highlights of a year, one per line, for
+// both the dashboard view and the exported card.
+func YearInReviewLines(review models.YearInReview) []string {
+	if review.Sessions == 0 {
+		return []string{"No completed sessions this year."}
+	}
+
+	lines := []string{
+		fmt.Sprintf("⏳ %s of focus in %d sessions", models.FormatMinutes(review.TotalMinutes), review.Sessions),
+		fmt.Sprintf("📅 %d active days", review.ActiveDays),
+		fmt.Sprintf("📆 Busiest month: %s (%s)", review.BusiestMonth, models.FormatMinutes(review.BusiestMonthMinutes)),
+		fmt.Sprintf("⭐ Best day: %s (%s)", review.BestDay.Format("Mon Jan 2"), models.FormatMinutes(review.BestDayMinutes)),
+	}
+	streak := fmt.Sprintf("🔥 Longest streak: %d days", review.LongestStreak)
+	if review.LongestStreak == 1 {
+		streak = "🔥 Longest streak: 1 day"
+	} else {
+		streak += fmt.Sprintf(" (%s – %s)", review.StreakStart.Format("Jan 2"), review.StreakEnd.Format("Jan 2"))
+	}
+	lines = append(lines, streak)
+	if review.FavoriteHour >= 0 {
+		hour := time.Date(2000, 1, 1, review.FavoriteHour, 0, 0, 0, time.Local).Format("3pm")
+		lines = append(lines, fmt.Sprintf("🕘 Favorite hour: %s (%s)", hour, models.FormatMinutes(review.FavoriteHourMinutes)))
+	}
+	if len(review.TopTags) > 0 {
+		tags := make([]string, len(review.TopTags))
+		for i, tag := range review.TopTags {
+			tags[i] = fmt.Sprintf("#%s %s", tag.Tag, models.FormatMinutes(tag.Minutes))
+		}
+		lines = append(lines, "🔖 Top tags: "+strings.Join(tags, ", "))
+	}
+	return lines
+}
+
+// RenderYearInReview frames the year's highlights as a card to save or
+// share.
+func RenderYearInReview(review models.YearInReview) string {
+	lines := append([]string{fmt.Sprintf("✨ Your %d in Focus", review.Year), ""}, YearInReviewLines(review)...)
+	return box(lines)
+}
@@ -0,0 +1,126 @@
+package hooks
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"runtime"
+	"strconv"
+	"strings"
+)
+
+// textVars are the placeholders of text the user typed, and the
+// environment variable holding each.
+var textVars = map[string]string{
+	"{tag}":       "FOCUS_TAG",
+	"{project}":   "FOCUS_PROJECT",
+	"{intention}": "FOCUS_INTENTION",
+}
+
+// Expand fills in the placeholders of command with payload: {event},
+// {status} ("completed" or "cancelled" once a session ends), {duration}
+// and {elapsed} in minutes, {tag}, {project}, {intention} and {remaining},
+// the sessions left to reach today's goal. Text values aren't put in the
+// command itself: their placeholders become references to the FOCUS_*
+// variables RunCommand sets, so the shell reads them as a single word
+// whether or not the placeholder is quoted, and never as commands.
+func Expand(command string, payload Payload) string {
+	return expand(command, payload, runtime.GOOS == "windows")
+}
+
+func expand(command string, payload Payload, windows bool) string {
+	status := ""
+	switch payload.Event {
+	case OnComplete:
+		status = "completed"
+	case OnCancel:
+		status = "cancelled"
+	}
+
+	values := map[string]string{
+		"{event}":     payload.Event,
+		"{status}":    status,
+		"{remaining}": strconv.Itoa(payload.Remaining),
+	}
+	if s := payload.Session; s != nil {
+		values["{duration}"] = strconv.Itoa(s.Duration)
+		values["{elapsed}"] = strconv.Itoa(s.ElapsedSeconds / 60)
+	}
+
+	var b strings.Builder
+	quote := byte(0) // The quote the shell is inside of at i, if any
+	for i := 0; i < len(command); i++ {
+		c := command[i]
+		if c == '{' {
+			if end := strings.IndexByte(command[i:], '}'); end > 0 {
+				name := command[i : i+end+1]
+				if value, ok := values[name]; ok {
+					b.WriteString(value)
+					i += end
+					continue
+				}
+				if v, ok := textVars[name]; ok && payload.Session != nil {
+					b.WriteString(reference(v, quote, windows))
+					i += end
+					continue
+				}
+			}
+		}
+		b.WriteByte(c)
+
+		// cmd.exe is left to quote as it likes, as delayed expansion
+		// happens after it has parsed the command
+		if windows {
+			continue
+		}
+		switch {
+		case c == '\\' && quote != '\'' && i+1 < len(command):
+			i++
+			b.WriteByte(command[i])
+		case (c == '\'' || c == '"') && quote == 0:
+			quote = c
+		case c == quote:
+			quote = 0
+		}
+	}
+	return b.String()
+}
+
+// reference returns what reads the environment variable v in a command,
+// inside quote (0 when not quoted). On Windows it is a delayed expansion,
+// which RunCommand turns on.
+func reference(v string, quote byte, windows bool) string {
+	if windows {
+		return "!" + v + "!"
+	}
+	switch quote {
+	case '"':
+		return "${" + v + "}"
+	case '\'':
+		// Close the single quotes around it, and open them again after
+		return `'"${` + v + `}"'`
+	}
+	return `"${` + v + `}"`
+}
+
+// RunCommand runs command through the shell with its placeholders filled
+// in from payload, which it also gets like a hook does.
+func RunCommand(command string, payload Payload) error {
+	if strings.TrimSpace(command) == "" {
+		return nil
+	}
+
+	expanded := Expand(command, payload)
+	var cmd *exec.Cmd
+	if runtime.GOOS == "windows" {
+		cmd = exec.Command("cmd", "/V:ON", "/C", expanded)
+	} else {
+		cmd = exec.Command("sh", "-c", expanded)
+	}
+	cmd.Env = append(os.Environ(), env(payload)...)
+	if err := cmd.Start(); err != nil {
+		return fmt.Errorf("command for %s: %w", payload.Event, err)
+	}
+	go cmd.Wait()
+	return nil
+}
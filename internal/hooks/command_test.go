@@ -0,0 +1,72 @@
+package hooks
+
+import (
+	"os"
+	"os/exec"
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/adibhanna/focussessions/internal/models"
+)
+
+func TestExpandShell(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("runs commands through sh")
+	}
+	tag := `it's "$HOME" & echo {tag}; %PATH% \`
+	payload := Payload{
+		Event:     OnComplete,
+		Session:   &models.Session{Tag: tag, Duration: 45, ElapsedSeconds: 1800},
+		Remaining: 3,
+	}
+
+	tests := []struct {
+		command, want string
+	}{
+		{`echo {tag}`, tag},
+		{`echo "{tag}"`, tag},
+		{`echo '{tag}'`, tag},
+		{`echo "tag: {tag}!"`, "tag: " + tag + "!"},
+		{`echo 'a {tag} b'`, "a " + tag + " b"},
+		{`echo "it\"s {tag}"`, `it"s ` + tag},
+		{`echo \'{tag}`, "'" + tag},
+		{`echo {status} {duration} {elapsed} {remaining} {event}`, "completed 45 30 3 on-complete"},
+		{`echo '{status}' {unknown}`, "completed {unknown}"},
+	}
+	for _, tt := range tests {
+		cmd := exec.Command("sh", "-c", expand(tt.command, payload, false))
+		cmd.Env = append(os.Environ(), env(payload)...)
+		out, err := cmd.Output()
+		if err != nil {
+			t.Errorf("%s: %v", tt.command, err)
+			continue
+		}
+		if got := strings.TrimSuffix(string(out), "\n"); got != tt.want {
+			t.Errorf("%s printed %q, want %q", tt.command, got, tt.want)
+		}
+	}
+}
+
+func TestExpandWindows(t *testing.T) {
+	payload := Payload{Event: OnStart, Session: &models.Session{Tag: "100% & del", Duration: 25}}
+	tests := []struct {
+		command, want string
+	}{
+		{`echo {tag}`, `echo !FOCUS_TAG!`},
+		{`echo "{project}: {intention}" {duration}`, `echo "!FOCUS_PROJECT!: !FOCUS_INTENTION!" 25`},
+		{`echo '{tag}'`, `echo '!FOCUS_TAG!'`},
+	}
+	for _, tt := range tests {
+		if got := expand(tt.command, payload, true); got != tt.want {
+			t.Errorf("expand(%q) = %q, want %q", tt.command, got, tt.want)
+		}
+	}
+}
+
+func TestExpandWithoutSession(t *testing.T) {
+	got := expand(`echo {tag} {event}`, Payload{Event: OnBreakStart}, false)
+	if want := `echo {tag} on-break-start`; got != want {
+		t.Errorf("expand = %q, want %q", got, want)
+	}
+}
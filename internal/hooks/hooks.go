@@ -0,0 +1,103 @@
+// Package hooks runs the user's executables and configured shell commands
+// on session lifecycle events. A hook is an executable in the hooks
+// directory named after its event, such as on-complete. It gets the event
+// as FOCUS_* environment variables and as JSON on stdin. Hooks and commands
+// run in the background: nothing waits for them and their output is
+// discarded.
+package hooks
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"strconv"
+	"time"
+
+	"github.com/adibhanna/focussessions/internal/models"
+)
+
+// Events a hook can be named after.
+const (
+	OnStart      = "on-start"
+	OnPause      = "on-pause"
+	OnComplete   = "on-complete"
+	OnCancel     = "on-cancel"
+	OnBreakStart = "on-break-start"
+)
+
+// Payload is what a hook receives on stdin.
+type Payload struct {
+	Event        string          `json:"event"`
+	Session      *models.Session `json:"session,omitempty"`       // The session the event is about, none for breaks
+	BreakMinutes int             `json:"break_minutes,omitempty"` // Length of the break, for on-break-start
+	Remaining    int             `json:"remaining_sessions"`      // Sessions left to reach today's goal
+}
+
+// Run starts the hook for payload.Event in dir, and does nothing when
+// there is none.
+func Run(dir string, payload Payload) error {
+	path, ok := find(dir, payload.Event)
+	if !ok {
+		return nil
+	}
+
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+	cmd := exec.Command(path)
+	cmd.Dir = dir
+	cmd.Env = append(os.Environ(), env(payload)...)
+	cmd.Stdin = bytes.NewReader(data)
+	if err := cmd.Start(); err != nil {
+		return fmt.Errorf("hook %s: %w", payload.Event, err)
+	}
+	go cmd.Wait()
+	return nil
+}
+
+// find returns the hook for event in dir. On Windows it may have any of
+// the usual executable extensions.
+func find(dir, event string) (string, bool) {
+	names := []string{event}
+	if runtime.GOOS == "windows" {
+		names = append(names, event+".exe", event+".bat", event+".cmd")
+	}
+	for _, name := range names {
+		path := filepath.Join(dir, name)
+		info, err := os.Stat(path)
+		if err != nil || !info.Mode().IsRegular() {
+			continue
+		}
+		return path, true
+	}
+	return "", false
+}
+
+// env describes payload as FOCUS_* environment variables.
+func env(payload Payload) []string {
+	vars := []string{
+		"FOCUS_EVENT=" + payload.Event,
+		"FOCUS_REMAINING=" + strconv.Itoa(payload.Remaining),
+	}
+	if payload.BreakMinutes > 0 {
+		vars = append(vars, "FOCUS_BREAK_MINUTES="+strconv.Itoa(payload.BreakMinutes))
+	}
+	if s := payload.Session; s != nil {
+		vars = append(vars,
+			"FOCUS_SESSION_ID="+s.ID,
+			"FOCUS_START="+s.StartTime.Format(time.RFC3339),
+			"FOCUS_DURATION="+strconv.Itoa(s.Duration),
+			"FOCUS_ELAPSED="+strconv.Itoa(s.ElapsedSeconds),
+			"FOCUS_TAG="+s.Tag,
+			"FOCUS_PROJECT="+s.Project,
+			"FOCUS_INTENTION="+s.Intention,
+			"FOCUS_STRICT="+strconv.FormatBool(s.Strict),
+		)
+	}
+	return vars
+}
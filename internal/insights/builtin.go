@@ -0,0 +1,169 @@
+package insights
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/adibhanna/focussessions/internal/models"
+)
+
+func init() {
+	Register(bestHours{})
+	Register(completionRate{})
+	Register(gaps{})
+}
+
+// bestHours finds the two-hour window in which most focus minutes start.
+type bestHours struct{}
+
+func (bestHours) Name() string { return "Best hours" }
+
+func (bestHours) Insights(r Range) []Finding {
+	var byHour [24]int
+	total := 0
+	for _, s := range r.Sessions {
+		if !s.Completed {
+			continue
+		}
+		minutes := s.ActualMinutes()
+		byHour[s.StartTime.Hour()] += minutes
+		total += minutes
+	}
+	if total == 0 {
+		return nil
+	}
+
+	best, bestMinutes := 0, -1
+	for h := 0; h < 24; h++ {
+		window := byHour[h] + byHour[(h+1)%24]
+		if window > bestMinutes {
+			best, bestMinutes = h, window
+		}
+	}
+
+	peak, peakMinutes := 0, -1
+	for h, minutes := range byHour {
+		if minutes > peakMinutes {
+			peak, peakMinutes = h, minutes
+		}
+	}
+
+	return []Finding{
+		{
+			Title: "Peak window",
+			Detail: fmt.Sprintf("%s–%s holds %d%% of your focus time",
+				hourLabel(best), hourLabel((best+2)%24), bestMinutes*100/total),
+		},
+		{
+			Title:  "Busiest hour",
+			Detail: fmt.Sprintf("Sessions starting at %s add up to %s", hourLabel(peak), models.FormatMinutes(peakMinutes)),
+		},
+	}
+}
+
+// completionRate compares finished sessions against the ones that were
+// cancelled or stopped early.
+type completionRate struct{}
+
+func (completionRate) Name() string { return "Completion rate" }
+
+func (completionRate) Insights(r Range) []Finding {
+	completed, finished := 0, 0
+	var weekdayDone, weekdayTotal [7]int
+	for _, s := range r.Sessions {
+		if s.Active {
+			continue
+		}
+		finished++
+		weekdayTotal[s.StartTime.Weekday()]++
+		if s.Completed {
+			completed++
+			weekdayDone[s.StartTime.Weekday()]++
+		}
+	}
+	if finished == 0 {
+		return nil
+	}
+
+	findings := []Finding{{
+		Title:  "Overall",
+		Detail: fmt.Sprintf("%d of %d sessions completed (%d%%)", completed, finished, completed*100/finished),
+	}}
+
+	worst, worstRate := -1, 101
+	for d := 0; d < 7; d++ {
+		if weekdayTotal[d] < 2 {
+			continue
+		}
+		if rate := weekdayDone[d] * 100 / weekdayTotal[d]; rate < worstRate {
+			worst, worstRate = d, rate
+		}
+	}
+	if worst >= 0 && worstRate < completed*100/finished {
+		findings = append(findings, Finding{
+			Title:  "Weakest day",
+			Detail: fmt.Sprintf("%ss finish only %d%% of sessions", time.Weekday(worst), worstRate),
+		})
+	}
+
+	return findings
+}
+
+// gaps reports days without any completed focus in the range.
+type gaps struct{}
+
+func (gaps) Name() string { return "Gaps" }
+
+func (gaps) Insights(r Range) []Finding {
+	active := make(map[string]bool)
+	for _, s := range r.Sessions {
+		if s.Completed {
+			active[s.Date] = true
+		}
+	}
+	if len(active) == 0 {
+		return nil
+	}
+
+	idle, off := 0, 0
+	longest, run := 0, 0
+	var longestEnd, day time.Time
+	for day = r.From; day.Before(r.To); day = day.AddDate(0, 0, 1) {
+		date := day.Format("2006-01-02")
+		if active[date] {
+			run = 0
+			continue
+		}
+		// A day marked off is a break that was planned, not a gap
+		if _, ok := r.OffDays[date]; ok {
+			off++
+			continue
+		}
+		idle++
+		run++
+		if run > longest {
+			longest, longestEnd = run, day
+		}
+	}
+
+	detail := fmt.Sprintf("%d of %d days had no completed sessions", idle, r.Days()-off)
+	if off > 0 {
+		detail += fmt.Sprintf(", not counting %d marked off", off)
+	}
+	findings := []Finding{{
+		Title:  "Days off",
+		Detail: detail,
+	}}
+	if longest > 1 {
+		start := longestEnd.AddDate(0, 0, -(longest - 1))
+		findings = append(findings, Finding{
+			Title:  "Longest gap",
+			Detail: fmt.Sprintf("%d days in a row (%s – %s)", longest, start.Format("Jan 2"), longestEnd.Format("Jan 2")),
+		})
+	}
+	return findings
+}
+
+func hourLabel(h int) string {
+	return time.Date(2000, 1, 1, h, 0, 0, 0, time.Local).Format("3pm")
+}
@@ -0,0 +1,76 @@
+// Package insights turns a range of sessions into short, human readable
+// findings. Each analysis is a Provider; the insights view simply runs every
+// registered provider, so adding a new analysis only requires implementing
+// the interface and registering it.
+package insights
+
+import (
+	"sync"
+	"time"
+
+	"github.com/adibhanna/focussessions/internal/models"
+)
+
+// Range is the input handed to every provider.
+type Range struct {
+	From     time.Time // inclusive
+	To       time.Time // exclusive
+	Sessions []models.Session
+	OffDays  map[string]string // Days marked off, keyed by date (YYYY-MM-DD)
+}
+
+// Days returns the number of calendar days covered by the range.
+func (r Range) Days() int {
+	return int(r.To.Sub(r.From).Hours()/24 + 0.5)
+}
+
+// Finding is a single titled observation.
+type Finding struct {
+	Title  string
+	Detail string
+}
+
+// Provider computes findings for a range of sessions. Providers should
+// return no findings rather than placeholder text when there isn't enough
+// data to say anything useful.
+type Provider interface {
+	Name() string
+	Insights(r Range) []Finding
+}
+
+var (
+	mu        sync.Mutex
+	providers []Provider
+)
+
+// Register adds a provider. Providers run in registration order.
+func Register(p Provider) {
+	mu.Lock()
+	defer mu.Unlock()
+	providers = append(providers, p)
+}
+
+// Providers returns the registered providers.
+func Providers() []Provider {
+	mu.Lock()
+	defer mu.Unlock()
+	return append([]Provider(nil), providers...)
+}
+
+// Section groups the findings produced by one provider.
+type Section struct {
+	Name     string
+	Findings []Finding
+}
+
+// Run executes every registered provider against r, skipping providers that
+// had nothing to report.
+func Run(r Range) []Section {
+	var sections []Section
+	for _, p := range Providers() {
+		if findings := p.Insights(r); len(findings) > 0 {
+			sections = append(sections, Section{Name: p.Name(), Findings: findings})
+		}
+	}
+	return sections
+}
@@ -0,0 +1,64 @@
+package insights
+
+import (
+	"fmt"
+
+	"github.com/adibhanna/focussessions/internal/models"
+)
+
+func init() {
+	Register(methods{})
+}
+
+// methods compares the focus method presets sessions were started with.
+type methods struct{}
+
+func (methods) Name() string { return "Methods" }
+
+func (methods) Insights(r Range) []Finding {
+	type tally struct {
+		finished, completed, minutes int
+		focused                      []models.Session
+	}
+	tallies := make(map[string]*tally)
+	stamped := false
+	for _, s := range r.Sessions {
+		if s.Active {
+			continue
+		}
+		t := tallies[s.Method]
+		if t == nil {
+			t = &tally{}
+			tallies[s.Method] = t
+		}
+		t.finished++
+		stamped = stamped || s.Method != ""
+		if s.Completed {
+			t.completed++
+			t.minutes += s.ActualMinutes()
+			t.focused = append(t.focused, s)
+		}
+	}
+	if !stamped {
+		return nil
+	}
+
+	var findings []Finding
+	add := func(title string, t *tally) {
+		detail := fmt.Sprintf("%d of %d sessions completed (%d%%), %s focused",
+			t.completed, t.finished, t.completed*100/t.finished, models.FormatMinutes(t.minutes))
+		if focus := models.AverageFocus(t.focused); focus > 0 {
+			detail += fmt.Sprintf(", focus %.1f", focus)
+		}
+		findings = append(findings, Finding{Title: title, Detail: detail})
+	}
+	for _, method := range models.Methods {
+		if t := tallies[method.Name]; t != nil {
+			add(method.Label, t)
+		}
+	}
+	if t := tallies[""]; t != nil {
+		add("Custom", t)
+	}
+	return findings
+}
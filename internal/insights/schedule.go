@@ -0,0 +1,123 @@
+package insights
+
+import (
+	"fmt"
+
+	"github.com/adibhanna/focussessions/internal/models"
+)
+
+func init() {
+	Register(schedule{})
+}
+
+// minWindowSessions is how many sessions a window needs before its focus
+// quality is trusted.
+const minWindowSessions = 3
+
+// neutralEnergy stands in for sessions without an energy rating.
+const neutralEnergy = 3
+
+// Window is a two-hour span of the day and the focus quality of the
+// sessions started in it.
+type Window struct {
+	Start    int     // hour of day the window starts at
+	Quality  float64 // 0–1, completion weighted by energy
+	Sessions int
+	Energy   float64 // average energy rating, 0 if none was rated
+}
+
+// End returns the hour the window ends at.
+func (w Window) End() int {
+	return (w.Start + 2) % 24
+}
+
+// StartLabel renders the start of the window as "9am".
+func (w Window) StartLabel() string {
+	return hourLabel(w.Start)
+}
+
+// Label renders the window as "9–11am".
+func (w Window) Label() string {
+	return hourLabel(w.Start) + "–" + hourLabel(w.End())
+}
+
+// sessionQuality scores a finished session: the share of its planned time
+// that was actually focused, scaled by its energy rating.
+func sessionQuality(s models.Session) float64 {
+	done := 1.0
+	if !s.Completed && s.Duration > 0 {
+		done = min(float64(s.ActualMinutes())/float64(s.Duration), 1)
+	}
+	energy := s.Energy
+	if energy == 0 {
+		energy = neutralEnergy
+	}
+	return done * float64(energy) / 5
+}
+
+// PeakWindow returns the two-hour window with the best average focus
+// quality, combining completion with energy ratings. It reports false when
+// no window has enough sessions to judge.
+func PeakWindow(sessions []models.Session) (Window, bool) {
+	var quality, energy [24]float64
+	var count, rated [24]int
+	for _, s := range sessions {
+		if s.Active {
+			continue
+		}
+		h := s.StartTime.Hour()
+		quality[h] += sessionQuality(s)
+		count[h]++
+		if s.Energy > 0 {
+			energy[h] += float64(s.Energy)
+			rated[h]++
+		}
+	}
+
+	var best Window
+	found := false
+	for h := 0; h < 24; h++ {
+		next := (h + 1) % 24
+		n := count[h] + count[next]
+		if n < minWindowSessions {
+			continue
+		}
+		w := Window{Start: h, Quality: (quality[h] + quality[next]) / float64(n), Sessions: n}
+		if r := rated[h] + rated[next]; r > 0 {
+			w.Energy = (energy[h] + energy[next]) / float64(r)
+		}
+		if !found || w.Quality > best.Quality || (w.Quality == best.Quality && w.Sessions > best.Sessions) {
+			best, found = w, true
+		}
+	}
+	return best, found
+}
+
+// schedule suggests when to put tomorrow's hardest session.
+type schedule struct{}
+
+func (schedule) Name() string { return "Scheduling" }
+
+func (schedule) Insights(r Range) []Finding {
+	w, ok := PeakWindow(r.Sessions)
+	if !ok {
+		return nil
+	}
+
+	detail := fmt.Sprintf("Your focus quality peaks %s (%d sessions", w.Label(), w.Sessions)
+	if w.Energy > 0 {
+		detail += fmt.Sprintf(", energy %.1f/5", w.Energy)
+	}
+	detail += ")"
+
+	return []Finding{
+		{
+			Title:  "Tomorrow's hardest session",
+			Detail: "Schedule it at " + w.StartLabel(),
+		},
+		{
+			Title:  "Focus quality",
+			Detail: detail,
+		},
+	}
+}
@@ -0,0 +1,57 @@
+// Package media plays and pauses the music player: any MPRIS player
+// through playerctl on Linux, and Spotify or Music on macOS. Like package
+// sound it is fire-and-forget.
+package media
+
+import (
+	"errors"
+	"fmt"
+	"os/exec"
+	"runtime"
+)
+
+// ErrNoPlayer is returned when no supported player control is available.
+var ErrNoPlayer = errors.New("no media player control found")
+
+// macPlayers lists the macOS apps controlled, in order of preference. Only
+// a running one is told to play or pause.
+var macPlayers = []string{"Spotify", "Music"}
+
+// Play resumes the music player.
+func Play() error {
+	return control("play")
+}
+
+// Pause pauses the music player.
+func Pause() error {
+	return control("pause")
+}
+
+func control(action string) error {
+	switch runtime.GOOS {
+	case "linux":
+		return start("playerctl", action)
+	case "darwin":
+		for _, app := range macPlayers {
+			script := fmt.Sprintf(`if application %q is running then tell application %q to %s`, app, app, action)
+			if err := start("osascript", "-e", script); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	return ErrNoPlayer
+}
+
+func start(name string, args ...string) error {
+	bin, err := exec.LookPath(name)
+	if err != nil {
+		return ErrNoPlayer
+	}
+	cmd := exec.Command(bin, args...)
+	if err := cmd.Start(); err != nil {
+		return err
+	}
+	go cmd.Wait()
+	return nil
+}
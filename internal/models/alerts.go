@@ -0,0 +1,28 @@
+package models
+
+import "time"
+
+// Quiet reports whether t falls within the quiet hours, from QuietStart up
+// to QuietEnd, which may be past midnight. There are none when the two are
+// equal.
+func (c Config) Quiet(t time.Time) bool {
+	hour := t.Hour()
+	switch {
+	case c.QuietStart == c.QuietEnd:
+		return false
+	case c.QuietStart < c.QuietEnd:
+		return hour >= c.QuietStart && hour < c.QuietEnd
+	}
+	return hour >= c.QuietStart || hour < c.QuietEnd
+}
+
+// NotifyAt reports whether desktop notifications may be shown at t.
+func (c Config) NotifyAt(t time.Time) bool {
+	return c.Notifications && !c.Quiet(t)
+}
+
+// SoundAt reports whether sounds, including the terminal bell and speech,
+// may play at t.
+func (c Config) SoundAt(t time.Time) bool {
+	return c.Sounds && !c.Quiet(t)
+}
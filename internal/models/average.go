@@ -0,0 +1,23 @@
+package models
+
+import "slices"
+
+// Which days per-day averages are taken over, as values of
+// Config.AverageDays. Days still to come and days marked off are never
+// counted.
+const (
+	AverageElapsed  = "elapsed"  // Every day of the period so far
+	AverageActive   = "active"   // Only days with a completed session
+	AverageWorkdays = "workdays" // Only Monday to Friday
+)
+
+// AverageBases lists the values of Config.AverageDays.
+var AverageBases = []string{AverageElapsed, AverageActive, AverageWorkdays}
+
+// AverageBasis returns the configured average basis.
+func (c Config) AverageBasis() string {
+	if !slices.Contains(AverageBases, c.AverageDays) {
+		return AverageElapsed
+	}
+	return c.AverageDays
+}
@@ -0,0 +1,45 @@
+package models
+
+import "slices"
+
+// How break activity prompts are shown, as values of Config.BreakReminders.
+// An empty value shows them.
+const (
+	BreakRemindersShow   = "show"   // Show the prompt under the break countdown
+	BreakRemindersNotify = "notify" // Also send it as a desktop notification when the break starts
+	BreakRemindersOff    = "off"    // Don't suggest anything
+)
+
+// BreakReminderModes lists the values of Config.BreakReminders.
+var BreakReminderModes = []string{BreakRemindersShow, BreakRemindersNotify, BreakRemindersOff}
+
+// DefaultBreakPrompts are the activities suggested during breaks when
+// Config.BreakPrompts is empty.
+var DefaultBreakPrompts = []string{
+	"Look at something 20 feet away for 20 seconds",
+	"Stand up and stretch",
+	"Drink a glass of water",
+	"Roll your shoulders and unclench your jaw",
+	"Walk around for a minute",
+}
+
+// BreakReminderMode returns how break prompts are shown.
+func (c Config) BreakReminderMode() string {
+	if !slices.Contains(BreakReminderModes, c.BreakReminders) {
+		return BreakRemindersShow
+	}
+	return c.BreakReminders
+}
+
+// BreakPrompt returns the nth activity to suggest during breaks, cycling
+// through the configured prompts, or "" when prompts are off.
+func (c Config) BreakPrompt(n int) string {
+	if c.BreakReminderMode() == BreakRemindersOff {
+		return ""
+	}
+	prompts := c.BreakPrompts
+	if len(prompts) == 0 {
+		prompts = DefaultBreakPrompts
+	}
+	return prompts[n%len(prompts)]
+}
@@ -0,0 +1,43 @@
+package models
+
+// Progress bar styles for the running timer, as values of
+// Config.ProgressStyle. An empty style is the gradient bar.
+const (
+	ProgressGradient = "gradient"
+	ProgressThin     = "thin"
+	ProgressBlock    = "block"
+	ProgressPercent  = "percent"
+	ProgressDial     = "dial"
+)
+
+// ProgressStyles lists the progress bar styles.
+var ProgressStyles = []string{ProgressGradient, ProgressThin, ProgressBlock, ProgressPercent, ProgressDial}
+
+// Renderings of the big countdown, as values of Config.ClockFont. An empty
+// font is the block font.
+const (
+	ClockBlock  = "block"  // Five rows of full blocks
+	ClockSmall  = "small"  // Three rows of half blocks
+	ClockSlim   = "slim"   // Three rows of thin strokes, like a figlet font
+	ClockText   = "text"   // Just the digits, e.g. 24:59
+	ClockAnalog = "analog" // A clock face whose hand points at the minutes left
+)
+
+// ClockFonts lists the renderings of the countdown.
+var ClockFonts = []string{ClockBlock, ClockSmall, ClockSlim, ClockText, ClockAnalog}
+
+// Progress returns the configured progress bar style.
+func (c Config) Progress() string {
+	if c.ProgressStyle == "" {
+		return ProgressGradient
+	}
+	return c.ProgressStyle
+}
+
+// Font returns the configured rendering of the countdown.
+func (c Config) Font() string {
+	if c.ClockFont == "" {
+		return ClockBlock
+	}
+	return c.ClockFont
+}
@@ -0,0 +1,29 @@
+package models
+
+import "time"
+
+// ElapsedAt returns the seconds elapsed in the session at now, reckoned
+// from its stored times. A running session with a pause ledger counts the
+// time since it started less the time spent paused, so slow or missed
+// saves can't make it lag behind. It goes by the wall clock, the only one
+// that survives the session being saved and loaded again: it is how a
+// session is picked up from disk, while the dashboard times the session it
+// runs on the monotonic clock from there. Older sessions count the time
+// since their last heartbeat, or since they started when saved before
+// heartbeats existed.
+func (s Session) ElapsedAt(now time.Time) int {
+	if !s.Active {
+		return s.ElapsedSeconds
+	}
+	if s.Ledger {
+		now = now.Round(0)
+		return max(int((now.Sub(s.StartTime.Round(0))-s.PausedFor(now))/time.Second), 0)
+	}
+	if s.Paused {
+		return s.ElapsedSeconds
+	}
+	if s.HeartbeatAt.IsZero() {
+		return int(now.Sub(s.StartTime).Seconds())
+	}
+	return s.ElapsedSeconds + int(now.Sub(s.HeartbeatAt).Seconds())
+}
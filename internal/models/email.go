@@ -0,0 +1,64 @@
+package models
+
+import "time"
+
+// Email defaults.
+const (
+	DefaultSMTPPort   = 587 // Submission with STARTTLS
+	DefaultReportHour = 8
+)
+
+// EmailSettings is the SMTP account the weekly report is sent from, and
+// who receives it, e.g. yourself, a coach or a manager.
+type EmailSettings struct {
+	Host     string   `json:"host"`
+	Port     int      `json:"port,omitempty"` // 587 by default; 465 connects over TLS
+	Username string   `json:"username,omitempty"`
+	Password string   `json:"password,omitempty"`
+	From     string   `json:"from,omitempty"` // The username by default
+	To       []string `json:"to"`
+
+	// Weekly has the daemon send last week's report on Monday morning, at
+	// SendHour or later.
+	Weekly   bool `json:"weekly,omitempty"`
+	SendHour int  `json:"send_hour,omitempty"` // 8 by default
+}
+
+// Ready reports whether there is a server to send through and someone to
+// send to.
+func (e EmailSettings) Ready() bool {
+	return e.Host != "" && e.Sender() != "" && len(e.To) > 0
+}
+
+// SMTPPort returns the configured port, or the default one.
+func (e EmailSettings) SMTPPort() int {
+	if e.Port <= 0 {
+		return DefaultSMTPPort
+	}
+	return e.Port
+}
+
+// Sender returns the address the report is sent from.
+func (e EmailSettings) Sender() string {
+	if e.From == "" {
+		return e.Username
+	}
+	return e.From
+}
+
+// ReportDue reports whether the weekly report is due at now: on Mondays
+// from SendHour on, unless it was already sent since the start of the day.
+func (e EmailSettings) ReportDue(now, sentAt time.Time) bool {
+	if !e.Weekly || now.Weekday() != time.Monday {
+		return false
+	}
+	hour := e.SendHour
+	if hour <= 0 || hour > 23 {
+		hour = DefaultReportHour
+	}
+	if now.Hour() < hour {
+		return false
+	}
+	monday := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+	return sentAt.Before(monday)
+}
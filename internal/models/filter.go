@@ -0,0 +1,40 @@
+package models
+
+import "strings"
+
+// StatsFilter restricts the stats to the sessions with a tag or in a
+// project. The zero value counts every session.
+type StatsFilter struct {
+	Tag     string
+	Project string
+}
+
+// IsZero reports whether the filter lets every session through.
+func (f StatsFilter) IsZero() bool {
+	return f.Tag == "" && f.Project == ""
+}
+
+// Matches reports whether the session is counted under the filter. Tags
+// and projects are compared ignoring case.
+func (f StatsFilter) Matches(s Session) bool {
+	if f.Tag != "" && !strings.EqualFold(f.Tag, s.Tag) {
+		return false
+	}
+	if f.Project != "" && !strings.EqualFold(f.Project, s.Project) {
+		return false
+	}
+	return true
+}
+
+// String describes the filter, e.g. "#writing" or "project thesis".
+func (f StatsFilter) String() string {
+	switch {
+	case f.Tag != "" && f.Project != "":
+		return "#" + f.Tag + " in " + f.Project
+	case f.Tag != "":
+		return "#" + f.Tag
+	case f.Project != "":
+		return "project " + f.Project
+	}
+	return "all sessions"
+}
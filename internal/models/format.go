@@ -0,0 +1,15 @@
+package models
+
+import "fmt"
+
+// FormatMinutes renders a number of minutes as "2h 5m", "2h" or "45m".
+func FormatMinutes(total int) string {
+	hours, mins := total/60, total%60
+	if hours > 0 {
+		if mins > 0 {
+			return fmt.Sprintf("%dh %dm", hours, mins)
+		}
+		return fmt.Sprintf("%dh", hours)
+	}
+	return fmt.Sprintf("%dm", mins)
+}
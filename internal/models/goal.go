@@ -0,0 +1,29 @@
+package models
+
+// PastGoal is a daily session goal that was in effect on the days before
+// Until.
+type PastGoal struct {
+	Until string `json:"until"` // YYYY-MM-DD, the day the next goal took effect
+	Goal  int    `json:"goal"`
+}
+
+// GoalOn returns the daily session goal in effect on date (YYYY-MM-DD), so
+// that changing the goal doesn't rewrite whether past days met it.
+func (c Config) GoalOn(date string) int {
+	for _, past := range c.PastGoals {
+		if date < past.Until {
+			return past.Goal
+		}
+	}
+	return c.DailySessionGoal
+}
+
+// RecordGoalChange notes that goal stops being the daily session goal on
+// date. Only the first change of a day is kept, as the goal that day is
+// whatever it was changed to last.
+func (c *Config) RecordGoalChange(goal int, date string) {
+	if n := len(c.PastGoals); n > 0 && c.PastGoals[n-1].Until >= date {
+		return
+	}
+	c.PastGoals = append(c.PastGoals, PastGoal{Until: date, Goal: goal})
+}
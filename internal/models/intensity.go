@@ -0,0 +1,56 @@
+package models
+
+// Session intensities, from least to most demanding. Sessions without an
+// intensity are normal.
+const (
+	IntensityLight  = "light"
+	IntensityNormal = "normal"
+	IntensityDeep   = "deep"
+)
+
+// Intensities lists the intensities in order.
+var Intensities = []string{IntensityLight, IntensityNormal, IntensityDeep}
+
+// DefaultIntensityWeights returns how much a minute at each intensity counts
+// toward the weighted focus score unless configured otherwise.
+func DefaultIntensityWeights() map[string]float64 {
+	return map[string]float64{
+		IntensityLight:  0.5,
+		IntensityNormal: 1,
+		IntensityDeep:   1.5,
+	}
+}
+
+// IntensityLevel returns the session's intensity, treating an unset one as
+// normal.
+func (s Session) IntensityLevel() string {
+	if s.Intensity == "" {
+		return IntensityNormal
+	}
+	return s.Intensity
+}
+
+// NextIntensity cycles light → normal → deep → light.
+func NextIntensity(intensity string) string {
+	for i, level := range Intensities {
+		if level == intensity {
+			return Intensities[(i+1)%len(Intensities)]
+		}
+	}
+	return IntensityDeep
+}
+
+// IntensityWeight returns the weight of a minute at intensity, preferring
+// the configured weights over the defaults.
+func (c Config) IntensityWeight(intensity string) float64 {
+	if intensity == "" {
+		intensity = IntensityNormal
+	}
+	if w, ok := c.IntensityWeights[intensity]; ok {
+		return w
+	}
+	if w, ok := DefaultIntensityWeights()[intensity]; ok {
+		return w
+	}
+	return 1
+}
@@ -0,0 +1,43 @@
+package models
+
+import (
+	"sort"
+	"strings"
+	"time"
+)
+
+// Interruption is a moment a session was interrupted, with a short reason.
+type Interruption struct {
+	At     time.Time `json:"at"`
+	Reason string    `json:"reason,omitempty"`
+}
+
+// InterruptionCount groups the interruptions sharing a reason.
+type InterruptionCount struct {
+	Reason string
+	Times  []time.Time
+}
+
+// InterruptionBreakdown groups the sessions' interruptions by reason,
+// ignoring case, with the most frequent reasons first.
+func InterruptionBreakdown(sessions []Session) []InterruptionCount {
+	index := make(map[string]int)
+	var counts []InterruptionCount
+	for _, s := range sessions {
+		for _, in := range s.Interruptions {
+			reason := strings.TrimSpace(in.Reason)
+			key := strings.ToLower(reason)
+			i, ok := index[key]
+			if !ok {
+				i = len(counts)
+				index[key] = i
+				counts = append(counts, InterruptionCount{Reason: reason})
+			}
+			counts[i].Times = append(counts[i].Times, in.At)
+		}
+	}
+	sort.SliceStable(counts, func(i, j int) bool {
+		return len(counts[i].Times) > len(counts[j].Times)
+	})
+	return counts
+}
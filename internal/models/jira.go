@@ -0,0 +1,19 @@
+package models
+
+import "regexp"
+
+// JiraSettings says where completed sessions are logged as work. Sessions
+// are logged to the issue named by their tag, such as "PROJ-123".
+type JiraSettings struct {
+	URL   string `json:"url"`             // e.g. "https://yourteam.atlassian.net"
+	Email string `json:"email,omitempty"` // Jira Cloud account the API token belongs to
+	Token string `json:"token"`           // API token, or a personal access token on Jira Server when Email is empty
+}
+
+var issueKeyPattern = regexp.MustCompile(`^[A-Z][A-Z0-9_]*-[1-9][0-9]*$`)
+
+// IssueKey returns the Jira issue a session is logged to, taken from its
+// tag, and whether the tag names one.
+func (s Session) IssueKey() (string, bool) {
+	return s.Tag, issueKeyPattern.MatchString(s.Tag)
+}
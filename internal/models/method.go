@@ -0,0 +1,64 @@
+package models
+
+// Method is a built-in focus method preset.
+type Method struct {
+	Name   string // Stored in Config.Method and Session.Method
+	Label  string
+	Focus  int // Session length in minutes
+	Break  int // Break length in minutes
+	Cycles int // Sessions recommended per day
+	Blurb  string
+}
+
+// Methods lists the built-in presets.
+var Methods = []Method{
+	{
+		Name:   "pomodoro",
+		Label:  "Pomodoro 25/5",
+		Focus:  25,
+		Break:  5,
+		Cycles: 8,
+		Blurb:  "Short sprints with frequent breaks. Good for getting started on tasks you're avoiding; take a longer break after every four.",
+	},
+	{
+		Name:   "52-17",
+		Label:  "52/17",
+		Focus:  52,
+		Break:  17,
+		Cycles: 6,
+		Blurb:  "The rhythm of the most productive workers in a DeskTime study. Fully step away from the screen during the 17 minutes.",
+	},
+	{
+		Name:   "ultradian",
+		Label:  "Ultradian 90/20",
+		Focus:  90,
+		Break:  20,
+		Cycles: 4,
+		Blurb:  "Follows the body's 90-minute rest-activity cycle. Suited to deep work; more than four cycles a day is hard to sustain.",
+	},
+}
+
+// MethodByName returns the preset called name.
+func MethodByName(name string) (Method, bool) {
+	for _, method := range Methods {
+		if method.Name == name {
+			return method, true
+		}
+	}
+	return Method{}, false
+}
+
+// Apply writes the method's session and break lengths and daily goal into
+// the config.
+func (m Method) Apply(config *Config) {
+	config.Method = m.Name
+	config.SessionDuration = m.Focus
+	config.BreakDuration = m.Break
+	config.DailySessionGoal = m.Cycles
+}
+
+// Matches reports whether the config still uses the method's session and
+// break lengths.
+func (m Method) Matches(config Config) bool {
+	return config.SessionDuration == m.Focus && config.BreakDuration == m.Break
+}
@@ -0,0 +1,84 @@
+package models
+
+import (
+	"fmt"
+	"slices"
+	"time"
+)
+
+// Ways a milestone is announced, as values of Config.MilestoneAlerts.
+const (
+	AlertFlash  = "flash"  // Flash the countdown
+	AlertBell   = "bell"   // Ring the terminal bell
+	AlertNotify = "notify" // Show a desktop notification
+	AlertNone   = "none"   // Announce nothing, when alone
+)
+
+// AlertKinds lists the ways a milestone can be announced.
+var AlertKinds = []string{AlertFlash, AlertBell, AlertNotify}
+
+// MilestoneHalfway is the Config.Milestones entry for the middle of a
+// session. Other entries give the time left, such as "10m".
+const MilestoneHalfway = "half"
+
+// Milestone is a point in a session to be alerted at.
+type Milestone struct {
+	Left    int    // Seconds left in the session
+	Message string // Shown when it is reached, e.g. "10 minutes left"
+}
+
+// MilestonesFor returns the configured milestones of a session lasting
+// duration seconds. Entries that don't parse or fall outside the session
+// are skipped.
+func (c Config) MilestonesFor(duration int) []Milestone {
+	var milestones []Milestone
+	for _, entry := range c.Milestones {
+		if entry == MilestoneHalfway {
+			milestones = append(milestones, Milestone{Left: duration / 2, Message: "Halfway there"})
+			continue
+		}
+		left, err := time.ParseDuration(entry)
+		if err != nil || left <= 0 || int(left.Seconds()) >= duration {
+			continue
+		}
+		milestones = append(milestones, Milestone{Left: int(left.Seconds()), Message: leftMessage(left)})
+	}
+	return milestones
+}
+
+func leftMessage(left time.Duration) string {
+	switch {
+	case left == time.Minute:
+		return "1 minute left"
+	case left%time.Minute == 0:
+		return fmt.Sprintf("%d minutes left", int(left.Minutes()))
+	}
+	return fmt.Sprintf("%s left", left)
+}
+
+// MilestoneAlert reports whether milestones are announced with kind. With
+// no alerts configured they flash the countdown.
+func (c Config) MilestoneAlert(kind string) bool {
+	if len(c.MilestoneAlerts) == 0 {
+		return kind == AlertFlash
+	}
+	return slices.Contains(c.MilestoneAlerts, kind)
+}
+
+// SetMilestoneAlert turns announcing milestones with kind on or off,
+// keeping the other alerts as they are.
+func (c *Config) SetMilestoneAlert(kind string, on bool) {
+	var alerts []string
+	for _, k := range AlertKinds {
+		if (k == kind && on) || (k != kind && c.MilestoneAlert(k)) {
+			alerts = append(alerts, k)
+		}
+	}
+	switch {
+	case len(alerts) == 0:
+		alerts = []string{AlertNone}
+	case slices.Equal(alerts, []string{AlertFlash}):
+		alerts = nil
+	}
+	c.MilestoneAlerts = alerts
+}
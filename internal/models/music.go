@@ -0,0 +1,20 @@
+package models
+
+// What the music player does around sessions, as values of Config.Music.
+// An empty value leaves it alone.
+const (
+	MusicSessions = "sessions" // Play during sessions and pause for breaks
+	MusicBreaks   = "breaks"   // Play during breaks and pause for sessions
+)
+
+// MusicPlays reports whether music should play for a session, or for a
+// break when session is false. ok is false when the player is left alone.
+func (c Config) MusicPlays(session bool) (play, ok bool) {
+	switch c.Music {
+	case MusicSessions:
+		return session, true
+	case MusicBreaks:
+		return !session, true
+	}
+	return false, false
+}
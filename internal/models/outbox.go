@@ -0,0 +1,20 @@
+package models
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// OutboxEntry is an event waiting to be delivered to an integration. It
+// stays queued, retried with backoff, until delivery succeeds.
+type OutboxEntry struct {
+	ID          string          `json:"id"`
+	Kind        string          `json:"kind"`   // Integration delivering it, e.g. "webhook"
+	Target      string          `json:"target"` // Where it goes, e.g. the webhook URL
+	Event       string          `json:"event"`  // What happened, e.g. "session.completed"
+	Payload     json.RawMessage `json:"payload"`
+	CreatedAt   time.Time       `json:"created_at"`
+	Attempts    int             `json:"attempts"`
+	NextAttempt time.Time       `json:"next_attempt"`
+	LastError   string          `json:"last_error,omitempty"`
+}
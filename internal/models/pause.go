@@ -0,0 +1,65 @@
+package models
+
+import (
+	"fmt"
+	"time"
+)
+
+// Pause marks the session paused at now.
+func (s *Session) Pause(now time.Time) {
+	s.Paused = true
+	s.PausedSince = now
+}
+
+// Unpause ends the current pause at now and adds it to the session's total
+// pause time.
+func (s *Session) Unpause(now time.Time) {
+	if s.Paused && !s.PausedSince.IsZero() {
+		s.PausedSeconds += int(now.Sub(s.PausedSince).Seconds())
+	}
+	s.Paused = false
+	s.PausedSince = time.Time{}
+}
+
+// PausedFor returns how long the session has been paused in total at now.
+func (s Session) PausedFor(now time.Time) time.Duration {
+	total := time.Duration(s.PausedSeconds) * time.Second
+	if s.Paused && !s.PausedSince.IsZero() {
+		total += now.Sub(s.PausedSince)
+	}
+	return total
+}
+
+// PauseDeadline returns when the current pause of a paused session runs
+// out, either because the pause budget is used up or because it has lasted
+// PauseExpiry hours. ok is false when neither limit applies.
+func (c Config) PauseDeadline(s Session) (deadline time.Time, ok bool) {
+	if !s.Paused || s.PausedSince.IsZero() {
+		return time.Time{}, false
+	}
+	if c.PauseBudget > 0 {
+		left := time.Duration(c.PauseBudget)*time.Minute - time.Duration(s.PausedSeconds)*time.Second
+		deadline, ok = s.PausedSince.Add(left), true
+	}
+	if c.PauseExpiry > 0 {
+		expiry := s.PausedSince.Add(time.Duration(c.PauseExpiry) * time.Hour)
+		if !ok || expiry.Before(deadline) {
+			deadline, ok = expiry, true
+		}
+	}
+	return deadline, ok
+}
+
+// PauseExceeded explains why a paused session should be abandoned at now,
+// e.g. "used up its 15m pause budget", and is empty while it may stay
+// paused.
+func (c Config) PauseExceeded(s Session, now time.Time) string {
+	deadline, ok := c.PauseDeadline(s)
+	if !ok || now.Before(deadline) {
+		return ""
+	}
+	if c.PauseExpiry > 0 && now.Sub(s.PausedSince) >= time.Duration(c.PauseExpiry)*time.Hour {
+		return fmt.Sprintf("paused for over %dh", c.PauseExpiry)
+	}
+	return fmt.Sprintf("used up its %s pause budget", FormatMinutes(c.PauseBudget))
+}
@@ -0,0 +1,47 @@
+package models
+
+// PlannedBlock is one session someone intends to do on a given day.
+type PlannedBlock struct {
+	Duration int    `json:"duration"` // in minutes
+	Tag      string `json:"tag,omitempty"`
+}
+
+// DayPlan lists the sessions planned for a day.
+type DayPlan struct {
+	Date   string         `json:"date"` // YYYY-MM-DD format
+	Blocks []PlannedBlock `json:"blocks"`
+}
+
+// PlanProgress compares a day's plan with the sessions completed that day.
+type PlanProgress struct {
+	Plan           DayPlan `json:"plan"`
+	Done           []bool  `json:"done"` // Done[i] is set once block i has a matching session
+	PlannedMinutes int     `json:"planned_minutes"`
+	ActualMinutes  int     `json:"actual_minutes"`
+}
+
+// DoneCount returns the number of planned blocks already done.
+func (p PlanProgress) DoneCount() int {
+	n := 0
+	for _, done := range p.Done {
+		if done {
+			n++
+		}
+	}
+	return n
+}
+
+// Remaining returns the number of planned blocks still to do.
+func (p PlanProgress) Remaining() int {
+	return len(p.Plan.Blocks) - p.DoneCount()
+}
+
+// Next returns the index of the first block still to do, or -1.
+func (p PlanProgress) Next() int {
+	for i, done := range p.Done {
+		if !done {
+			return i
+		}
+	}
+	return -1
+}
@@ -0,0 +1,45 @@
+package models
+
+// Push services, as values of PushSettings.Service. An empty service is
+// ntfy.
+const (
+	PushNtfy     = "ntfy"
+	PushPushover = "pushover"
+	PushTelegram = "telegram"
+)
+
+// PushServices lists the push services.
+var PushServices = []string{PushNtfy, PushPushover, PushTelegram}
+
+// PushSettings says where push notifications are sent when a session or a
+// break ends, to buzz a phone while you're away from the desk. Each service
+// reads its own fields.
+type PushSettings struct {
+	Service string `json:"service,omitempty"` // One of PushServices
+	URL     string `json:"url,omitempty"`     // ntfy server, https://ntfy.sh by default
+	Topic   string `json:"topic,omitempty"`   // ntfy topic
+	Token   string `json:"token,omitempty"`   // ntfy access token, Pushover application token or Telegram bot token
+	User    string `json:"user,omitempty"`    // Pushover user key
+	ChatID  string `json:"chat_id,omitempty"` // Telegram chat the bot writes to
+}
+
+// Via returns the configured push service.
+func (p PushSettings) Via() string {
+	if p.Service == "" {
+		return PushNtfy
+	}
+	return p.Service
+}
+
+// Ready reports whether the fields the service needs are set.
+func (p PushSettings) Ready() bool {
+	switch p.Via() {
+	case PushNtfy:
+		return p.Topic != ""
+	case PushPushover:
+		return p.Token != "" && p.User != ""
+	case PushTelegram:
+		return p.Token != "" && p.ChatID != ""
+	}
+	return false
+}
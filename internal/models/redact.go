@@ -0,0 +1,30 @@
+package models
+
+// Redacted returns a copy of c without its credentials: the SMTP and MQTT
+// passwords, the Jira token and the push service's token and user key. It
+// is what c looks like to code that shouldn't be able to send as the user,
+// such as scripts.
+func (c Config) Redacted() Config {
+	// The settings are pointers, so each is copied before it is blanked
+	if c.Email != nil {
+		email := *c.Email
+		email.Password = ""
+		c.Email = &email
+	}
+	if c.MQTT != nil {
+		mqtt := *c.MQTT
+		mqtt.Password = ""
+		c.MQTT = &mqtt
+	}
+	if c.Jira != nil {
+		jira := *c.Jira
+		jira.Token = ""
+		c.Jira = &jira
+	}
+	if c.Push != nil {
+		push := *c.Push
+		push.Token, push.User = "", ""
+		c.Push = &push
+	}
+	return c
+}
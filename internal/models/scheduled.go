@@ -0,0 +1,94 @@
+package models
+
+import (
+	"sort"
+	"strings"
+	"time"
+)
+
+// ScheduledSession is a focus block planned at fixed times, such as every
+// weekday at 09:00 and 14:00.
+type ScheduledSession struct {
+	Days     []string `json:"days,omitempty"`     // Weekday names, "weekdays" or "weekends"; every day when empty
+	Times    []string `json:"times"`              // Start times such as "09:00"
+	Duration int      `json:"duration,omitempty"` // Minutes, the session length when 0
+	Tag      string   `json:"tag,omitempty"`
+}
+
+// ScheduledStart is one occurrence of a scheduled session.
+type ScheduledStart struct {
+	At       time.Time
+	Duration int // Minutes, the session length when 0
+	Tag      string
+}
+
+// OnDay reports whether the session is scheduled on day.
+func (s ScheduledSession) OnDay(day time.Weekday) bool {
+	if len(s.Days) == 0 {
+		return true
+	}
+	weekend := day == time.Saturday || day == time.Sunday
+	for _, name := range s.Days {
+		switch strings.ToLower(strings.TrimSpace(name)) {
+		case "weekdays":
+			if !weekend {
+				return true
+			}
+		case "weekends":
+			if weekend {
+				return true
+			}
+		default:
+			if d, ok := ParseWeekday(name); ok && d == day {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// ScheduledStarts returns the scheduled sessions starting on the day of t,
+// in order. Times that don't parse are skipped.
+func (c Config) ScheduledStarts(t time.Time) []ScheduledStart {
+	var starts []ScheduledStart
+	for _, s := range c.ScheduledSessions {
+		if !s.OnDay(t.Weekday()) {
+			continue
+		}
+		for _, value := range s.Times {
+			clock, err := time.Parse("15:04", strings.TrimSpace(value))
+			if err != nil {
+				continue
+			}
+			at := time.Date(t.Year(), t.Month(), t.Day(), clock.Hour(), clock.Minute(), 0, 0, t.Location())
+			starts = append(starts, ScheduledStart{At: at, Duration: s.Duration, Tag: s.Tag})
+		}
+	}
+	sort.SliceStable(starts, func(i, j int) bool { return starts[i].At.Before(starts[j].At) })
+	return starts
+}
+
+// NextScheduledStart returns the first scheduled session starting after
+// now, looking a week ahead.
+func (c Config) NextScheduledStart(now time.Time) (ScheduledStart, bool) {
+	for offset := 0; offset <= 7; offset++ {
+		for _, start := range c.ScheduledStarts(now.AddDate(0, 0, offset)) {
+			if start.At.After(now) {
+				return start, true
+			}
+		}
+	}
+	return ScheduledStart{}, false
+}
+
+// Label describes the start, e.g. "14:00 writing (45m)".
+func (s ScheduledStart) Label() string {
+	label := s.At.Format("15:04")
+	if s.Tag != "" {
+		label += " " + s.Tag
+	}
+	if s.Duration > 0 {
+		label += " (" + FormatMinutes(s.Duration) + ")"
+	}
+	return label
+}
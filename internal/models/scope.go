@@ -0,0 +1,67 @@
+package models
+
+import (
+	"encoding/json"
+	"slices"
+)
+
+// Data scopes an integration can be granted, as values of Config.Scopes.
+// The session's ID and whether it is active, paused or completed are
+// always shared.
+const (
+	ScopeDurations   = "durations"   // Start and end times, planned and elapsed time
+	ScopeLabels      = "labels"      // Tag, project, intention, intensity and method
+	ScopeNotes       = "notes"       // Reflection, energy, distractions and interruptions
+	ScopeEnvironment = "environment" // Host and captured environment metadata
+)
+
+// Scopes lists every data scope.
+var Scopes = []string{ScopeDurations, ScopeLabels, ScopeNotes, ScopeEnvironment}
+
+// DefaultScopes are granted to integrations without configured scopes.
+// Notes and the environment are personal enough to require opting in.
+var DefaultScopes = []string{ScopeDurations, ScopeLabels}
+
+// scopeFields maps each scope to the JSON fields of Session it covers.
+// Fields not listed here are never shared with integrations.
+var scopeFields = map[string][]string{
+	"":               {"id", "active", "paused", "completed"},
+	ScopeDurations:   {"start_time", "end_time", "duration", "elapsed_seconds", "date", "week", "month", "year"},
+	ScopeLabels:      {"tag", "project", "intention", "intensity", "method"},
+	ScopeNotes:       {"focus", "notes", "energy", "distractions", "interruptions"},
+	ScopeEnvironment: {"host", "heartbeat_at", "metadata"},
+}
+
+// IntegrationScopes returns the scopes granted to the integration kind.
+func (c Config) IntegrationScopes(kind string) []string {
+	if scopes, ok := c.Scopes[kind]; ok {
+		return scopes
+	}
+	return DefaultScopes
+}
+
+// Scoped returns the session as a JSON object holding only the fields
+// covered by scopes.
+func (s Session) Scoped(scopes []string) (map[string]json.RawMessage, error) {
+	data, err := json.Marshal(s)
+	if err != nil {
+		return nil, err
+	}
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil, err
+	}
+
+	scoped := make(map[string]json.RawMessage)
+	for scope, names := range scopeFields {
+		if scope != "" && !slices.Contains(scopes, scope) {
+			continue
+		}
+		for _, name := range names {
+			if value, ok := fields[name]; ok {
+				scoped[name] = value
+			}
+		}
+	}
+	return scoped, nil
+}
@@ -1,6 +1,7 @@
 package models
 
 import (
+	"strings"
 	"time"
 )
 
@@ -17,50 +18,400 @@ type Session struct {
 	Active         bool      `json:"active"`          // Is this session currently active
 	ElapsedSeconds int       `json:"elapsed_seconds"` // Seconds elapsed so far
 	Paused         bool      `json:"paused"`          // Is the session paused
+
+	// PausedSince is when the current pause started and PausedSeconds the
+	// time spent in earlier pauses. Abandoned sessions were cancelled for
+	// pausing too long (see Config.PauseBudget). Sessions with Ledger set
+	// derive their elapsed time from StartTime and this pause ledger (see
+	// ElapsedAt).
+	PausedSince   time.Time `json:"paused_since,omitempty"`
+	PausedSeconds int       `json:"paused_seconds,omitempty"`
+	Abandoned     bool      `json:"abandoned,omitempty"`
+	Ledger        bool      `json:"ledger,omitempty"`
+
+	// Host is the machine running the session and HeartbeatAt the last
+	// time it saved progress. They let a session be paused on one machine
+	// and resumed on another sharing the same data directory.
+	Host        string    `json:"host,omitempty"`
+	HeartbeatAt time.Time `json:"heartbeat_at,omitempty"`
+
+	// Labels describing what the session is spent on. They can be changed
+	// while the session is running.
+	Tag       string `json:"tag,omitempty"`
+	Project   string `json:"project,omitempty"`
+	Intention string `json:"intention,omitempty"`
+
+	// TaskID is the task list entry the session was started against.
+	TaskID string `json:"task_id,omitempty"`
+
+	// Intensity is light, normal or deep; empty means normal.
+	Intensity string `json:"intensity,omitempty"`
+
+	// Method is the preset (see Methods) the session was started with, or
+	// empty for custom lengths.
+	Method string `json:"method,omitempty"`
+
+	// Strict sessions can't be paused, and cancelling them takes typing a
+	// confirmation word.
+	Strict bool `json:"strict,omitempty"`
+
+	// Energy is a self-rated energy level from 1 (drained) to 5 (sharp),
+	// or 0 when not rated.
+	Energy int `json:"energy,omitempty"`
+
+	// Reflection recorded after the session completes: a focus quality
+	// rating from 1 to 5 (0 when skipped) and optional notes.
+	Focus int    `json:"focus,omitempty"`
+	Notes string `json:"notes,omitempty"`
+
+	// Distractions counts the times focus broke during the session.
+	Distractions int `json:"distractions,omitempty"`
+
+	// Interruptions logged while the session ran, with their reasons.
+	Interruptions []Interruption `json:"interruptions,omitempty"`
+
+	// Metadata holds the environment captured when the session started
+	// (hostname, tty, tmux session, battery, git repo) when enabled.
+	Metadata map[string]string `json:"metadata,omitempty"`
+}
+
+// ActualMinutes returns the time actually spent in the session, falling back
+// to the wall-clock span and then to the planned duration for completed
+// sessions that didn't record elapsed time.
+func (s Session) ActualMinutes() int {
+	minutes := s.ElapsedSeconds / 60
+	if minutes == 0 && !s.EndTime.IsZero() && !s.StartTime.IsZero() {
+		minutes = int(s.EndTime.Sub(s.StartTime).Minutes())
+	}
+	if minutes == 0 && s.Completed {
+		minutes = s.Duration
+	}
+	return minutes
+}
+
+// IsFalseStart reports whether the session stopped before running min
+// minutes, so it is tallied separately instead of counting toward stats. A
+// min of 0 counts every session.
+func (s Session) IsFalseStart(min int) bool {
+	return min > 0 && !s.Active && s.ActualMinutes() < min
+}
+
+// EarnsPartialCredit reports whether a stopped session ran at least min
+// minutes, so its time counts toward focus time. A min of 0 credits none.
+func (s Session) EarnsPartialCredit(min int) bool {
+	return min > 0 && !s.Active && !s.Completed && s.ActualMinutes() >= min
+}
+
+// Label returns a short description built from the session's tag, project
+// and intention, or an empty string if none are set.
+func (s Session) Label() string {
+	var parts []string
+	if s.Project != "" {
+		parts = append(parts, s.Project)
+	}
+	if s.Tag != "" {
+		parts = append(parts, "#"+s.Tag)
+	}
+	label := strings.Join(parts, " ")
+	if s.Intention != "" {
+		if label != "" {
+			label += " — "
+		}
+		label += s.Intention
+	}
+	return label
+}
+
+// AverageFocus returns the average focus rating of the rated sessions, or 0
+// when none were rated.
+func AverageFocus(sessions []Session) float64 {
+	total, rated := 0, 0
+	for _, s := range sessions {
+		if s.Focus > 0 {
+			total += s.Focus
+			rated++
+		}
+	}
+	if rated == 0 {
+		return 0
+	}
+	return float64(total) / float64(rated)
+}
+
+// MatchesMetadata reports whether any metadata key or value contains query,
+// ignoring case. An empty query matches every session.
+func (s Session) MatchesMetadata(query string) bool {
+	query = strings.ToLower(strings.TrimSpace(query))
+	if query == "" {
+		return true
+	}
+	for k, v := range s.Metadata {
+		if strings.Contains(strings.ToLower(k), query) || strings.Contains(strings.ToLower(v), query) {
+			return true
+		}
+	}
+	return false
 }
 
 type Config struct {
-	SessionDuration  int `json:"session_duration"`   // Default session duration in minutes
-	DailySessionGoal int `json:"daily_session_goal"` // Number of sessions goal per day
-	WorkStartHour    int `json:"work_start_hour"`    // Start hour (24h format)
-	WorkEndHour      int `json:"work_end_hour"`      // End hour (24h format)
+	SessionDuration     int    `json:"session_duration"`              // Default session duration in minutes
+	DailySessionGoal    int    `json:"daily_session_goal"`            // Number of sessions goal per day
+	WorkStartHour       int    `json:"work_start_hour"`               // Start hour (24h format)
+	WorkEndHour         int    `json:"work_end_hour"`                 // End hour (24h format)
+	FsyncCriticalWrites bool   `json:"fsync_critical_writes"`         // Flush completions and config saves to disk
+	CaptureEnvironment  bool   `json:"capture_environment"`           // Record host/tty/tmux/battery/git on session start
+	WeekStartDay        string `json:"week_start_day"`                // "monday" (ISO weeks) or "sunday"
+	ZenDim              bool   `json:"zen_dim"`                       // Draw the zen mode countdown in muted colors
+	BreakDuration       int    `json:"break_duration"`                // Break length in minutes
+	AutoContinue        bool   `json:"auto_continue"`                 // Chain sessions and breaks automatically
+	AutoContinueDelay   int    `json:"auto_continue_delay"`           // Seconds to confirm before an automatic transition
+	BreathingGuide      bool   `json:"breathing_guide"`               // Show a box breathing animation during breaks
+	PromptIntention     bool   `json:"prompt_intention"`              // Ask for a one-line intention when starting a session
+	Reflect             bool   `json:"reflect"`                       // Rate focus quality and take notes after a session
+	Buddy               bool   `json:"buddy"`                         // Show a co-working buddy's countdown next to yours
+	Strict              bool   `json:"strict"`                        // Start sessions in strict mode: no pausing, confirm to cancel
+	Method              string `json:"method,omitempty"`              // Preset the lengths come from (see Methods), empty when custom
+	ProgressStyle       string `json:"progress_style,omitempty"`      // Timer progress bar (see ProgressStyles)
+	ClockFont           string `json:"clock_font,omitempty"`          // How the big countdown is drawn (see ClockFonts)
+	StaleSessions       string `json:"stale_sessions,omitempty"`      // What to do with a long-finished active session on startup (see StalePolicies)
+	StaleGrace          int    `json:"stale_grace,omitempty"`         // Minutes past its planned end before an active session is stale
+	PauseBudget         int    `json:"pause_budget,omitempty"`        // Minutes a session may spend paused in total before it is abandoned, 0 for no limit
+	PauseExpiry         int    `json:"pause_expiry,omitempty"`        // Hours a session may stay paused before it is abandoned, 0 to keep it forever
+	MinSessionMinutes   int    `json:"min_session_minutes,omitempty"` // Sessions shorter than this are false starts, left out of stats
+	PartialCredit       int    `json:"partial_credit,omitempty"`      // Stopped sessions that ran at least this many minutes count toward focus time, 0 for none
+	TaskbarProgress     bool   `json:"taskbar_progress,omitempty"`    // Show timer progress on the terminal's taskbar icon (OSC 9;4)
+	Music               string `json:"music,omitempty"`               // Play or pause the music player for sessions and breaks (see MusicSessions), empty to leave it alone
+	IdleReminder        int    `json:"idle_reminder,omitempty"`       // Minutes without a session during work hours before a nudge, 0 for none
+	AverageDays         string `json:"average_days,omitempty"`        // Days per-day averages are taken over (see AverageBases)
+	BreakReminders      string `json:"break_reminders,omitempty"`     // How break activity prompts are shown (see BreakReminderModes)
+	Notifications       bool   `json:"notifications"`                 // Show desktop notifications
+	Sounds              bool   `json:"sounds"`                        // Play sounds, the terminal bell and speech
+	QuietStart          int    `json:"quiet_start,omitempty"`         // Hour notifications and sounds stop (see Quiet)
+	QuietEnd            int    `json:"quiet_end,omitempty"`           // Hour notifications and sounds start again
+
+	// BuddyDir is a friend's data directory shared with this machine (for
+	// example through a synced folder). The buddy shows their running
+	// timer; when empty a simulated buddy keeps your configured schedule.
+	BuddyDir string `json:"buddy_dir,omitempty"`
+
+	// PastGoals are the earlier daily session goals, oldest first, so past
+	// days are judged against the goal of the time (see GoalOn). They are
+	// recorded by storage whenever the goal changes.
+	PastGoals []PastGoal `json:"past_goals,omitempty"`
+
+	// BreakPrompts are the activities suggested during breaks, such as
+	// "Drink a glass of water", taken in turn. DefaultBreakPrompts are
+	// used when it is empty.
+	BreakPrompts []string `json:"break_prompts,omitempty"`
+
+	// HomeWidgets are the widgets shown on the home view, in order (see
+	// HomeWidgetNames). DefaultHomeWidgets are used when it is empty.
+	HomeWidgets []string `json:"home_widgets,omitempty"`
+
+	// ProjectTargets maps a project name to its weekly target in minutes.
+	ProjectTargets map[string]int `json:"project_targets,omitempty"`
+
+	// IntensityWeights overrides how much a minute at each intensity counts
+	// toward the weighted focus score (see DefaultIntensityWeights).
+	IntensityWeights map[string]float64 `json:"intensity_weights,omitempty"`
+
+	// Speech chooses which announcements are read aloud, keyed by event
+	// (see the Speech* constants).
+	Speech map[string]bool `json:"speech,omitempty"`
+
+	// Milestones are points in a session to be alerted at: "half" for the
+	// middle, or the time left such as "10m".
+	Milestones []string `json:"milestones,omitempty"`
+
+	// MilestoneAlerts chooses how milestones are announced (see the Alert*
+	// constants). The countdown flashes when there are none.
+	MilestoneAlerts []string `json:"milestone_alerts,omitempty"`
+
+	// OnSessionStartCmd and OnSessionEndCmd are shell commands run when a
+	// session starts and when it completes or is cancelled. Placeholders
+	// such as {duration}, {tag} and {remaining} are filled in, quoted or
+	// not (see hooks.Expand).
+	OnSessionStartCmd string `json:"on_session_start_cmd,omitempty"`
+	OnSessionEndCmd   string `json:"on_session_end_cmd,omitempty"`
+
+	// Blocklist holds sites kept out of reach while a session runs, by
+	// adding them to the hosts file. BlockCommand and UnblockCommand are
+	// shell commands run instead when set.
+	Blocklist      []string `json:"blocklist,omitempty"`
+	BlockCommand   string   `json:"block_command,omitempty"`
+	UnblockCommand string   `json:"unblock_command,omitempty"`
+
+	// MQTT publishes the timer state to a broker, for home automation
+	// such as a busy light outside the office door.
+	MQTT *MQTTSettings `json:"mqtt,omitempty"`
+
+	// ScheduledSessions are focus blocks planned at fixed times. The
+	// daemon reminds you when one is due and the dashboard offers to start
+	// it.
+	ScheduledSessions []ScheduledSession `json:"scheduled_sessions,omitempty"`
+
+	// Jira logs completed sessions tagged with an issue key as work on
+	// that issue, through the outbox.
+	Jira *JiraSettings `json:"jira,omitempty"`
+
+	// Push sends a notification to your phone when a session or a break
+	// ends, through the outbox.
+	Push *PushSettings `json:"push,omitempty"`
+
+	// Email sends the weekly report by email, through the outbox.
+	Email *EmailSettings `json:"email,omitempty"`
+
+	// Messages are shown at random under the running timer and when a
+	// session completes, together with those in messages.txt.
+	Messages []string `json:"messages,omitempty"`
+
+	// Webhooks are URLs that receive a JSON POST for each completed
+	// session, delivered through the outbox.
+	Webhooks []string `json:"webhooks,omitempty"`
+
+	// Scopes limits the session data each integration kind receives (see
+	// the Scope* constants); kinds without an entry get DefaultScopes.
+	Scopes map[string][]string `json:"scopes,omitempty"`
+
+	// Tags holds per-tag preferences keyed by tag name.
+	Tags map[string]TagSettings `json:"tags,omitempty"`
+}
+
+// MQTTSettings says where the timer state is published.
+type MQTTSettings struct {
+	Broker   string `json:"broker"`          // e.g. "tcp://homeassistant.local:1883"
+	Topic    string `json:"topic,omitempty"` // DefaultMQTTTopic when empty
+	Username string `json:"username,omitempty"`
+	Password string `json:"password,omitempty"`
+}
+
+// DefaultMQTTTopic is where the timer state is published unless another
+// topic is configured.
+const DefaultMQTTTopic = "focussessions/state"
+
+// TagSettings personalizes the completion of sessions with a given tag.
+type TagSettings struct {
+	Message string `json:"message,omitempty"` // Shown instead of the default completion message
+	Sound   string `json:"sound,omitempty"`   // Audio file played on completion
 }
 
 func DefaultConfig() Config {
 	return Config{
-		SessionDuration:  60,
-		DailySessionGoal: 8,
-		WorkStartHour:    8,
-		WorkEndHour:      16,
+		SessionDuration:     60,
+		DailySessionGoal:    8,
+		WorkStartHour:       8,
+		WorkEndHour:         16,
+		FsyncCriticalWrites: true,
+		WeekStartDay:        "monday",
+		BreakDuration:       10,
+		AutoContinueDelay:   5,
+		Reflect:             true,
+		Notifications:       true,
+		Sounds:              true,
 	}
 }
 
 type DayStats struct {
-	Date          string    `json:"date"`
-	SessionsCount int       `json:"sessions_count"`
-	TotalMinutes  int       `json:"total_minutes"`
-	Sessions      []Session `json:"sessions"`
+	Date             string         `json:"date"`
+	SessionsCount    int            `json:"sessions_count"`
+	TotalMinutes     int            `json:"total_minutes"`
+	AverageFocus     float64        `json:"average_focus,omitempty"`     // Average focus rating (1-5), 0 when unrated
+	FalseStarts      int            `json:"false_starts,omitempty"`      // Sessions too short to count (see Config.MinSessionMinutes)
+	PartialSessions  int            `json:"partial_sessions,omitempty"`  // Stopped sessions credited in TotalMinutes (see Config.PartialCredit)
+	PartialMinutes   int            `json:"partial_minutes,omitempty"`   // Minutes those sessions add to TotalMinutes
+	WeightedMinutes  int            `json:"weighted_minutes"`            // Minutes weighted by intensity
+	IntensityMinutes map[string]int `json:"intensity_minutes,omitempty"` // Minutes per intensity
+	Distractions     int            `json:"distractions,omitempty"`      // Distractions logged in completed sessions
+	Sessions         []Session      `json:"sessions"`
 }
 
 type WeekStats struct {
-	Week          int        `json:"week"`
-	Year          int        `json:"year"`
-	SessionsCount int        `json:"sessions_count"`
-	TotalMinutes  int        `json:"total_minutes"`
-	DailyStats    []DayStats `json:"daily_stats"`
+	Week             int            `json:"week"`
+	Year             int            `json:"year"`
+	SessionsCount    int            `json:"sessions_count"`
+	TotalMinutes     int            `json:"total_minutes"`
+	AverageFocus     float64        `json:"average_focus,omitempty"`     // Average focus rating (1-5), 0 when unrated
+	FalseStarts      int            `json:"false_starts,omitempty"`      // Sessions too short to count (see Config.MinSessionMinutes)
+	PartialSessions  int            `json:"partial_sessions,omitempty"`  // Stopped sessions credited in TotalMinutes (see Config.PartialCredit)
+	PartialMinutes   int            `json:"partial_minutes,omitempty"`   // Minutes those sessions add to TotalMinutes
+	WeightedMinutes  int            `json:"weighted_minutes"`            // Minutes weighted by intensity
+	IntensityMinutes map[string]int `json:"intensity_minutes,omitempty"` // Minutes per intensity
+	Distractions     int            `json:"distractions,omitempty"`      // Distractions logged in completed sessions
+	DailyStats       []DayStats     `json:"daily_stats"`
 }
 
 type MonthStats struct {
-	Month         string      `json:"month"`
-	Year          int         `json:"year"`
-	SessionsCount int         `json:"sessions_count"`
-	TotalMinutes  int         `json:"total_minutes"`
-	WeeklyStats   []WeekStats `json:"weekly_stats"`
+	Month            string         `json:"month"`
+	Year             int            `json:"year"`
+	SessionsCount    int            `json:"sessions_count"`
+	TotalMinutes     int            `json:"total_minutes"`
+	AverageFocus     float64        `json:"average_focus,omitempty"`     // Average focus rating (1-5), 0 when unrated
+	FalseStarts      int            `json:"false_starts,omitempty"`      // Sessions too short to count (see Config.MinSessionMinutes)
+	PartialSessions  int            `json:"partial_sessions,omitempty"`  // Stopped sessions credited in TotalMinutes (see Config.PartialCredit)
+	PartialMinutes   int            `json:"partial_minutes,omitempty"`   // Minutes those sessions add to TotalMinutes
+	WeightedMinutes  int            `json:"weighted_minutes"`            // Minutes weighted by intensity
+	IntensityMinutes map[string]int `json:"intensity_minutes,omitempty"` // Minutes per intensity
+	WeeklyStats      []WeekStats    `json:"weekly_stats"`
 }
 
 type YearStats struct {
-	Year          int          `json:"year"`
-	SessionsCount int          `json:"sessions_count"`
-	TotalMinutes  int          `json:"total_minutes"`
-	MonthlyStats  []MonthStats `json:"monthly_stats"`
+	Year             int            `json:"year"`
+	SessionsCount    int            `json:"sessions_count"`
+	TotalMinutes     int            `json:"total_minutes"`
+	AverageFocus     float64        `json:"average_focus,omitempty"`     // Average focus rating (1-5), 0 when unrated
+	FalseStarts      int            `json:"false_starts,omitempty"`      // Sessions too short to count (see Config.MinSessionMinutes)
+	PartialSessions  int            `json:"partial_sessions,omitempty"`  // Stopped sessions credited in TotalMinutes (see Config.PartialCredit)
+	PartialMinutes   int            `json:"partial_minutes,omitempty"`   // Minutes those sessions add to TotalMinutes
+	WeightedMinutes  int            `json:"weighted_minutes"`            // Minutes weighted by intensity
+	IntensityMinutes map[string]int `json:"intensity_minutes,omitempty"` // Minutes per intensity
+	MonthlyStats     []MonthStats   `json:"monthly_stats"`
+}
+
+// RangeStats aggregates the sessions of any range of days, such as a sprint
+// or a quarter.
+type RangeStats struct {
+	First            time.Time      `json:"first"` // First day of the range
+	Last             time.Time      `json:"last"`  // Last day of the range, inclusive
+	Days             int            `json:"days"`
+	ActiveDays       int            `json:"active_days"` // Days with a completed session
+	SessionsCount    int            `json:"sessions_count"`
+	TotalMinutes     int            `json:"total_minutes"`
+	AverageFocus     float64        `json:"average_focus,omitempty"`     // Average focus rating (1-5), 0 when unrated
+	FalseStarts      int            `json:"false_starts,omitempty"`      // Sessions too short to count (see Config.MinSessionMinutes)
+	PartialSessions  int            `json:"partial_sessions,omitempty"`  // Stopped sessions credited in TotalMinutes (see Config.PartialCredit)
+	PartialMinutes   int            `json:"partial_minutes,omitempty"`   // Minutes those sessions add to TotalMinutes
+	WeightedMinutes  int            `json:"weighted_minutes"`            // Minutes weighted by intensity
+	IntensityMinutes map[string]int `json:"intensity_minutes,omitempty"` // Minutes per intensity
+	Distractions     int            `json:"distractions,omitempty"`      // Distractions logged in completed sessions
+	DailyStats       []DayStats     `json:"daily_stats"`                 // Days with completed sessions, oldest first
+}
+
+// Comparison is the change in a period's completed sessions and minutes
+// versus the period before it.
+type Comparison struct {
+	Sessions int `json:"sessions"`
+	Minutes  int `json:"minutes"`
+}
+
+// PaceStats compares today's completed sessions with the average completed
+// by the same time of day on the same weekday in recent weeks.
+type PaceStats struct {
+	At      time.Time `json:"at"`
+	Today   int       `json:"today"`
+	Typical float64   `json:"typical"`
+	Samples int       `json:"samples"` // Number of past weekdays averaged
+}
+
+// ProjectBurndown tracks a project's completed minutes in the current week
+// against its weekly target.
+type ProjectBurndown struct {
+	Project  string `json:"project"`
+	Target   int    `json:"target"`    // Weekly target in minutes
+	Minutes  int    `json:"minutes"`   // Completed so far this week
+	DaysLeft int    `json:"days_left"` // Days remaining in the week, including today
+}
+
+// Remaining returns the minutes still needed to reach the target.
+func (b ProjectBurndown) Remaining() int {
+	return max(b.Target-b.Minutes, 0)
 }
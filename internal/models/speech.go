@@ -0,0 +1,17 @@
+package models
+
+// Announcements that can be read aloud, as keys of Config.Speech.
+const (
+	SpeechSessionComplete = "session_complete"
+	SpeechFiveMinutesLeft = "five_minutes_left"
+	SpeechBreakOver       = "break_over"
+	SpeechGoalReached     = "goal_reached"
+)
+
+// SpeechEvents lists every announcement that can be read aloud.
+var SpeechEvents = []string{
+	SpeechSessionComplete,
+	SpeechFiveMinutesLeft,
+	SpeechBreakOver,
+	SpeechGoalReached,
+}
@@ -0,0 +1,38 @@
+package models
+
+import (
+	"slices"
+	"time"
+)
+
+// What happens on startup to an active session that should have ended long
+// ago, as values of Config.StaleSessions. An empty policy completes it.
+const (
+	StaleComplete = "complete" // Record it as completed with the time it ran
+	StaleCancel   = "cancel"   // Record it as stopped early
+	StaleResume   = "resume"   // Resume it as before
+)
+
+// StalePolicies lists the stale session policies.
+var StalePolicies = []string{StaleComplete, StaleCancel, StaleResume}
+
+// DefaultStaleGrace is how long past its planned end, in minutes, an
+// active session is left alone before it counts as stale.
+const DefaultStaleGrace = 60
+
+// StalePolicy returns the configured stale session policy.
+func (c Config) StalePolicy() string {
+	if !slices.Contains(StalePolicies, c.StaleSessions) {
+		return StaleComplete
+	}
+	return c.StaleSessions
+}
+
+// StaleAfter returns when an active session counts as stale.
+func (c Config) StaleAfter(session Session) time.Time {
+	grace := c.StaleGrace
+	if grace <= 0 {
+		grace = DefaultStaleGrace
+	}
+	return session.StartTime.Add(time.Duration(session.Duration+grace) * time.Minute)
+}
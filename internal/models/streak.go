@@ -0,0 +1,21 @@
+package models
+
+// CurrentStreak counts the days with focus time ending today, or yesterday
+// when nothing has been done yet today. daily holds the minutes of each day
+// up to today and off whether each is marked off; days off are skipped
+// rather than breaking the streak.
+func CurrentStreak(daily []int, off []bool) int {
+	i := len(daily) - 1
+	if i >= 0 && daily[i] == 0 {
+		i--
+	}
+	streak := 0
+	for ; i >= 0; i-- {
+		if daily[i] > 0 {
+			streak++
+		} else if !off[i] {
+			break
+		}
+	}
+	return streak
+}
@@ -0,0 +1,61 @@
+package models
+
+import (
+	"sort"
+	"time"
+)
+
+// Task is an item on the task list that sessions can be started against.
+type Task struct {
+	ID        string    `json:"id"`
+	Title     string    `json:"title"`
+	Estimate  int       `json:"estimate,omitempty"` // Pomodoros expected to finish it, 0 when not estimated
+	Done      bool      `json:"done,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+	DoneAt    time.Time `json:"done_at,omitempty"`
+}
+
+// TaskProgress is a task with the focus spent on it.
+type TaskProgress struct {
+	Task
+	Pomodoros    int // Completed sessions started against the task
+	FocusMinutes int // Time spent in those sessions
+}
+
+// EstimateReport compares the pomodoros estimated for finished tasks with
+// the sessions they actually took.
+type EstimateReport struct {
+	Tasks     []TaskProgress // Finished tasks with an estimate, most recently done first
+	Estimated int            // Pomodoros estimated for them
+	Actual    int            // Completed sessions spent on them
+	Within    int            // Tasks finished in no more pomodoros than estimated
+}
+
+// NewEstimateReport gathers the finished, estimated tasks of tasks.
+func NewEstimateReport(tasks []TaskProgress) EstimateReport {
+	var report EstimateReport
+	for _, task := range tasks {
+		if !task.Done || task.Estimate == 0 {
+			continue
+		}
+		report.Tasks = append(report.Tasks, task)
+		report.Estimated += task.Estimate
+		report.Actual += task.Pomodoros
+		if task.Pomodoros <= task.Estimate {
+			report.Within++
+		}
+	}
+	sort.SliceStable(report.Tasks, func(i, j int) bool {
+		return report.Tasks[i].DoneAt.After(report.Tasks[j].DoneAt)
+	})
+	return report
+}
+
+// Drift is how far the actual pomodoros are off the estimates, e.g. 0.25
+// when tasks took a quarter more than estimated.
+func (r EstimateReport) Drift() float64 {
+	if r.Estimated == 0 {
+		return 0
+	}
+	return float64(r.Actual)/float64(r.Estimated) - 1
+}
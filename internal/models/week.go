@@ -0,0 +1,57 @@
+package models
+
+import (
+	"strings"
+	"time"
+)
+
+// ParseWeekday parses a weekday name such as "monday", "Sun" or "sunday".
+func ParseWeekday(name string) (time.Weekday, bool) {
+	name = strings.ToLower(strings.TrimSpace(name))
+	if len(name) < 3 {
+		return time.Sunday, false
+	}
+	for d := time.Sunday; d <= time.Saturday; d++ {
+		if strings.HasPrefix(strings.ToLower(d.String()), name) {
+			return d, true
+		}
+	}
+	return time.Sunday, false
+}
+
+// FirstWeekday returns the configured first day of the week. Only Sunday
+// and Monday are supported; anything else falls back to Monday (ISO weeks).
+func (c Config) FirstWeekday() time.Weekday {
+	if d, ok := ParseWeekday(c.WeekStartDay); ok && d == time.Sunday {
+		return time.Sunday
+	}
+	return time.Monday
+}
+
+// WeekNumber returns the year and week number of t for weeks starting on
+// start. Monday-based weeks are ISO weeks. Sunday-based weeks are numbered
+// like the ISO week that begins the following day, so each Sunday–Saturday
+// week shares its number with the Monday inside it.
+func WeekNumber(t time.Time, start time.Weekday) (year, week int) {
+	if start == time.Sunday {
+		t = t.AddDate(0, 0, 1)
+	}
+	return t.ISOWeek()
+}
+
+// WeekStart returns midnight on the first day of the week containing t.
+func WeekStart(t time.Time, start time.Weekday) time.Time {
+	day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+	offset := (int(day.Weekday()) - int(start) + 7) % 7
+	return day.AddDate(0, 0, -offset)
+}
+
+// Weekdays returns the seven weekdays in display order for weeks starting
+// on start.
+func Weekdays(start time.Weekday) []time.Weekday {
+	days := make([]time.Weekday, 7)
+	for i := range days {
+		days[i] = time.Weekday((int(start) + i) % 7)
+	}
+	return days
+}
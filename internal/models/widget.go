@@ -0,0 +1,41 @@
+package models
+
+import (
+	"slices"
+	"strings"
+)
+
+// Widgets of the home view, as values of Config.HomeWidgets.
+const (
+	WidgetTimer     = "timer"     // The big countdown clock, its progress bar and status
+	WidgetToday     = "today"     // The date, today's sessions against the goal and when it will be met
+	WidgetStreak    = "streak"    // Consecutive days with focus time
+	WidgetSparkline = "sparkline" // Sessions per day over the last two weeks
+	WidgetPace      = "pace"      // Today's sessions against a typical day so far
+	WidgetPlan      = "plan"      // Progress through today's plan
+	WidgetSchedule  = "schedule"  // The next scheduled session and tomorrow's best time to focus
+)
+
+// HomeWidgetNames lists the values of Config.HomeWidgets.
+var HomeWidgetNames = []string{WidgetTimer, WidgetToday, WidgetStreak, WidgetSparkline, WidgetPace, WidgetPlan, WidgetSchedule}
+
+// DefaultHomeWidgets are the widgets shown, in order, when
+// Config.HomeWidgets is empty.
+var DefaultHomeWidgets = []string{WidgetTimer, WidgetToday, WidgetSparkline, WidgetPace, WidgetPlan, WidgetSchedule}
+
+// Widgets returns the widgets to show on the home view, in order. Unknown
+// and repeated names are skipped, and the defaults are used when none are
+// left.
+func (c Config) Widgets() []string {
+	var widgets []string
+	for _, name := range c.HomeWidgets {
+		name = strings.ToLower(strings.TrimSpace(name))
+		if slices.Contains(HomeWidgetNames, name) && !slices.Contains(widgets, name) {
+			widgets = append(widgets, name)
+		}
+	}
+	if len(widgets) == 0 {
+		return DefaultHomeWidgets
+	}
+	return widgets
+}
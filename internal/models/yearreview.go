@@ -0,0 +1,40 @@
+package models
+
+import "time"
+
+// YearInReview highlights a year of completed sessions, for an end-of-year
+// look back.
+type YearInReview struct {
+	Year         int `json:"year"`
+	Sessions     int `json:"sessions"`
+	TotalMinutes int `json:"total_minutes"`
+	ActiveDays   int `json:"active_days"`
+
+	// BusiestMonth is the month with the most focus time, or 0 when the
+	// year has no sessions.
+	BusiestMonth        time.Month `json:"busiest_month"`
+	BusiestMonthMinutes int        `json:"busiest_month_minutes"`
+
+	BestDay        time.Time `json:"best_day"`
+	BestDayMinutes int       `json:"best_day_minutes"`
+
+	// LongestStreak is the most consecutive days with a session, from
+	// StreakStart to StreakEnd. Days off in between don't break it.
+	LongestStreak int       `json:"longest_streak"`
+	StreakStart   time.Time `json:"streak_start"`
+	StreakEnd     time.Time `json:"streak_end"`
+
+	// FavoriteHour is the hour of the day with the most focus time, or -1
+	// when the year has no sessions.
+	FavoriteHour        int `json:"favorite_hour"`
+	FavoriteHourMinutes int `json:"favorite_hour_minutes"`
+
+	// TopTags are the most focused-on tags, busiest first.
+	TopTags []TagMinutes `json:"top_tags,omitempty"`
+}
+
+// TagMinutes is the focus time spent on a tag.
+type TagMinutes struct {
+	Tag     string `json:"tag"`
+	Minutes int    `json:"minutes"`
+}
@@ -0,0 +1,164 @@
+// Package mqtt publishes messages to an MQTT broker. It speaks just enough
+// of MQTT 3.1.1 to connect, publish one message at QoS 0 and disconnect,
+// which is all the timer state updates need.
+package mqtt
+
+import (
+	"bytes"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"strings"
+	"time"
+)
+
+// Kind names MQTT in the scopes of Config.Scopes.
+const Kind = "mqtt"
+
+// timeout bounds connecting to the broker and each exchange with it.
+const timeout = 5 * time.Second
+
+// Packet types, in the high nibble of the fixed header.
+const (
+	packetConnect    = 0x10
+	packetConnack    = 0x20
+	packetPublish    = 0x30
+	packetDisconnect = 0xE0
+)
+
+// Broker is where messages are published.
+type Broker struct {
+	URL      string // tcp://host:1883, mqtt://host, ssl://host:8883, mqtts://host or host:port
+	Username string
+	Password string
+	ClientID string
+}
+
+// Publish sends payload to topic, retained when retain is set so new
+// subscribers get the latest message straight away.
+func (b Broker) Publish(topic string, payload []byte, retain bool) error {
+	conn, err := b.dial()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	conn.SetDeadline(time.Now().Add(timeout))
+
+	if _, err := conn.Write(b.connect()); err != nil {
+		return err
+	}
+	ack := make([]byte, 4)
+	if _, err := io.ReadFull(conn, ack); err != nil {
+		return fmt.Errorf("mqtt: reading connack: %w", err)
+	}
+	if ack[0] != packetConnack || ack[3] != 0 {
+		return fmt.Errorf("mqtt: broker refused the connection (code %d)", ack[3])
+	}
+
+	header := byte(packetPublish)
+	if retain {
+		header |= 0x01
+	}
+	var body bytes.Buffer
+	writeString(&body, topic)
+	body.Write(payload)
+	if _, err := conn.Write(packet(header, body.Bytes())); err != nil {
+		return err
+	}
+	_, err = conn.Write([]byte{packetDisconnect, 0})
+	return err
+}
+
+func (b Broker) dial() (net.Conn, error) {
+	address, secure, err := parseURL(b.URL)
+	if err != nil {
+		return nil, err
+	}
+	dialer := &net.Dialer{Timeout: timeout}
+	if secure {
+		return tls.DialWithDialer(dialer, "tcp", address, nil)
+	}
+	return dialer.Dial("tcp", address)
+}
+
+// parseURL returns the host:port to dial and whether to use TLS.
+func parseURL(url string) (string, bool, error) {
+	secure := false
+	port := "1883"
+	switch {
+	case strings.HasPrefix(url, "ssl://"), strings.HasPrefix(url, "mqtts://"):
+		secure, port = true, "8883"
+	case strings.HasPrefix(url, "tcp://"), strings.HasPrefix(url, "mqtt://"), !strings.Contains(url, "://"):
+	default:
+		return "", false, fmt.Errorf("mqtt: unsupported broker URL %q", url)
+	}
+	host := url
+	if i := strings.Index(url, "://"); i >= 0 {
+		host = url[i+3:]
+	}
+	host = strings.TrimSuffix(host, "/")
+	if host == "" {
+		return "", false, errors.New("mqtt: no broker host")
+	}
+	if _, _, err := net.SplitHostPort(host); err != nil {
+		host = net.JoinHostPort(host, port)
+	}
+	return host, secure, nil
+}
+
+// connect builds a CONNECT packet for a clean session.
+func (b Broker) connect() []byte {
+	var body bytes.Buffer
+	writeString(&body, "MQTT")
+	body.WriteByte(4) // protocol level 3.1.1
+
+	flags := byte(0x02) // clean session
+	if b.Username != "" {
+		flags |= 0x80
+		if b.Password != "" {
+			flags |= 0x40
+		}
+	}
+	body.WriteByte(flags)
+	body.Write([]byte{0, 60}) // keep alive, in seconds
+
+	clientID := b.ClientID
+	if clientID == "" {
+		clientID = "focussessions"
+	}
+	writeString(&body, clientID)
+	if b.Username != "" {
+		writeString(&body, b.Username)
+		if b.Password != "" {
+			writeString(&body, b.Password)
+		}
+	}
+	return packet(packetConnect, body.Bytes())
+}
+
+// packet prefixes body with a fixed header.
+func packet(header byte, body []byte) []byte {
+	out := []byte{header}
+	n := len(body)
+	for {
+		digit := byte(n % 128)
+		n /= 128
+		if n > 0 {
+			digit |= 0x80
+		}
+		out = append(out, digit)
+		if n == 0 {
+			break
+		}
+	}
+	return append(out, body...)
+}
+
+// writeString writes s with its two-byte length prefix.
+func writeString(buf *bytes.Buffer, s string) {
+	buf.WriteByte(byte(len(s) >> 8))
+	buf.WriteByte(byte(len(s)))
+	buf.WriteString(s)
+}
@@ -0,0 +1,49 @@
+// Package notify shows desktop notifications using whatever notifier the
+// system provides.
+package notify
+
+import (
+	"errors"
+	"fmt"
+	"os/exec"
+	"runtime"
+	"strings"
+)
+
+// ErrNoNotifier is returned when no supported notifier is installed.
+var ErrNoNotifier = errors.New("no desktop notifier found")
+
+// command returns the notifier invocation for title and body on this OS.
+func command(title, body string) ([]string, bool) {
+	switch runtime.GOOS {
+	case "darwin":
+		script := fmt.Sprintf("display notification %s with title %s", quoteAppleScript(body), quoteAppleScript(title))
+		return []string{"osascript", "-e", script}, true
+	case "linux":
+		return []string{"notify-send", "--app-name=focussessions", title, body}, true
+	case "windows":
+		script := `[Windows.UI.Notifications.ToastNotificationManager, Windows.UI.Notifications, ContentType = WindowsRuntime] > $null; ` +
+			`$xml = [Windows.UI.Notifications.ToastNotificationManager]::GetTemplateContent(1); ` +
+			`$text = $xml.GetElementsByTagName('text'); $text[0].AppendChild($xml.CreateTextNode($args[0])) > $null; $text[1].AppendChild($xml.CreateTextNode($args[1])) > $null; ` +
+			`[Windows.UI.Notifications.ToastNotificationManager]::CreateToastNotifier('Focus Sessions').Show([Windows.UI.Notifications.ToastNotification]::new($xml))`
+		return []string{"powershell", "-NoProfile", "-Command", script, title, body}, true
+	}
+	return nil, false
+}
+
+func quoteAppleScript(s string) string {
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(s) + `"`
+}
+
+// Send shows a notification and waits for the notifier to accept it.
+func Send(title, body string) error {
+	args, ok := command(title, body)
+	if !ok {
+		return ErrNoNotifier
+	}
+	bin, err := exec.LookPath(args[0])
+	if err != nil {
+		return ErrNoNotifier
+	}
+	return exec.Command(bin, args[1:]...).Run()
+}
@@ -0,0 +1,219 @@
+package outbox
+
+import (
+	"bytes"
+	"crypto/tls"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net"
+	"net/smtp"
+	"net/textproto"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/adibhanna/focussessions/internal/export"
+	"github.com/adibhanna/focussessions/internal/models"
+	"github.com/adibhanna/focussessions/internal/storage"
+)
+
+// smtpTimeout is how long sending an email may take.
+const smtpTimeout = 30 * time.Second
+
+// implicitTLSPort is the SMTP port spoken over TLS from the start, rather
+// than upgraded with STARTTLS.
+const implicitTLSPort = 465
+
+// EmailMessage is an email with a plain-text body and an HTML one, which
+// mail clients pick from.
+type EmailMessage struct {
+	Subject string `json:"subject"`
+	Text    string `json:"text"`
+	HTML    string `json:"html,omitempty"`
+}
+
+// WeeklyReport returns the email of last week's report: the Markdown
+// report as its text and the HTML report with charts as its HTML.
+func WeeklyReport(store *storage.Storage, now time.Time) (EmailMessage, error) {
+	config, err := store.GetConfig()
+	if err != nil {
+		return EmailMessage{}, err
+	}
+	text, err := export.Render(store, export.Options{Period: export.LastWeek, Format: export.Markdown}, now)
+	if err != nil {
+		return EmailMessage{}, err
+	}
+	html, err := export.Render(store, export.Options{Period: export.LastWeek, Format: export.HTML}, now)
+	if err != nil {
+		return EmailMessage{}, err
+	}
+	from, _ := export.LastWeek.Range(now, config.FirstWeekday())
+	return EmailMessage{
+		Subject: "Focus Sessions - week of " + from.Format("January 2, 2006"),
+		Text:    string(text),
+		HTML:    string(html),
+	}, nil
+}
+
+// PublishWeeklyReport queues last week's report for the recipients in
+// config, when email is set up.
+func PublishWeeklyReport(store *storage.Storage, config models.Config, now time.Time) error {
+	if config.Email == nil || !config.Email.Ready() {
+		return nil
+	}
+	message, err := WeeklyReport(store, now)
+	if err != nil {
+		return err
+	}
+	data, err := json.Marshal(message)
+	if err != nil {
+		return err
+	}
+	// The server and credentials are read at delivery, as for push
+	return store.EnqueueOutbox(models.OutboxEntry{
+		ID:          uuid.New().String(),
+		Kind:        KindEmail,
+		Target:      strings.Join(config.Email.To, ", "),
+		Event:       EventWeeklyReport,
+		Payload:     data,
+		CreatedAt:   now,
+		NextAttempt: now,
+	})
+}
+
+func deliverEmail(entry models.OutboxEntry, email *models.EmailSettings, now time.Time) error {
+	var message EmailMessage
+	if err := json.Unmarshal(entry.Payload, &message); err != nil {
+		return err
+	}
+	return SendEmail(email, message, now)
+}
+
+// SendEmail sends message to the recipients in email straight away.
+func SendEmail(email *models.EmailSettings, message EmailMessage, now time.Time) error {
+	if email == nil || !email.Ready() {
+		return errors.New("email is not configured")
+	}
+	data, err := composeEmail(*email, message, now)
+	if err != nil {
+		return err
+	}
+
+	port := email.SMTPPort()
+	addr := net.JoinHostPort(email.Host, strconv.Itoa(port))
+	tlsConfig := &tls.Config{ServerName: email.Host}
+	dialer := &net.Dialer{Timeout: smtpTimeout}
+	var conn net.Conn
+	if port == implicitTLSPort {
+		conn, err = tls.DialWithDialer(dialer, "tcp", addr, tlsConfig)
+	} else {
+		conn, err = dialer.Dial("tcp", addr)
+	}
+	if err != nil {
+		return err
+	}
+	conn.SetDeadline(time.Now().Add(smtpTimeout))
+
+	c, err := smtp.NewClient(conn, email.Host)
+	if err != nil {
+		conn.Close()
+		return err
+	}
+	defer c.Close()
+
+	if port != implicitTLSPort {
+		if ok, _ := c.Extension("STARTTLS"); ok {
+			if err := c.StartTLS(tlsConfig); err != nil {
+				return err
+			}
+		}
+	}
+	if email.Username != "" {
+		// PlainAuth refuses to send the password over a connection that
+		// isn't encrypted, except to localhost
+		if err := c.Auth(smtp.PlainAuth("", email.Username, email.Password, email.Host)); err != nil {
+			return err
+		}
+	}
+	if err := c.Mail(email.Sender()); err != nil {
+		return err
+	}
+	for _, to := range email.To {
+		if err := c.Rcpt(to); err != nil {
+			return fmt.Errorf("%s: %w", to, err)
+		}
+	}
+	w, err := c.Data()
+	if err != nil {
+		return err
+	}
+	if _, err := w.Write(data); err != nil {
+		return err
+	}
+	if err := w.Close(); err != nil {
+		return err
+	}
+	return c.Quit()
+}
+
+// composeEmail returns message as a MIME email, with a plain-text part and
+// an HTML part when it has one.
+func composeEmail(email models.EmailSettings, message EmailMessage, now time.Time) ([]byte, error) {
+	var b bytes.Buffer
+	header := func(key, value string) { fmt.Fprintf(&b, "%s: %s\r\n", key, value) }
+	header("From", email.Sender())
+	header("To", strings.Join(email.To, ", "))
+	header("Subject", mime.QEncoding.Encode("utf-8", message.Subject))
+	header("Date", now.Format(time.RFC1123Z))
+	header("MIME-Version", "1.0")
+
+	if message.HTML == "" {
+		header("Content-Type", "text/plain; charset=utf-8")
+		header("Content-Transfer-Encoding", "quoted-printable")
+		b.WriteString("\r\n")
+		if err := writeQuoted(&b, message.Text); err != nil {
+			return nil, err
+		}
+		return b.Bytes(), nil
+	}
+
+	parts := multipart.NewWriter(&b)
+	header("Content-Type", "multipart/alternative; boundary="+parts.Boundary())
+	b.WriteString("\r\n")
+	for _, part := range []struct{ contentType, body string }{
+		{"text/plain; charset=utf-8", message.Text},
+		{"text/html; charset=utf-8", message.HTML},
+	} {
+		w, err := parts.CreatePart(textproto.MIMEHeader{
+			"Content-Type":              {part.contentType},
+			"Content-Transfer-Encoding": {"quoted-printable"},
+		})
+		if err != nil {
+			return nil, err
+		}
+		if err := writeQuoted(w, part.body); err != nil {
+			return nil, err
+		}
+	}
+	if err := parts.Close(); err != nil {
+		return nil, err
+	}
+	return b.Bytes(), nil
+}
+
+// writeQuoted writes text quoted-printable, which keeps lines short enough
+// for SMTP whatever the text holds and ends them with CRLF.
+func writeQuoted(w io.Writer, text string) error {
+	qw := quotedprintable.NewWriter(w)
+	if _, err := qw.Write([]byte(text)); err != nil {
+		return err
+	}
+	return qw.Close()
+}
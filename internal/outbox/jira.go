@@ -0,0 +1,84 @@
+package outbox
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/adibhanna/focussessions/internal/models"
+)
+
+// jiraTime is the timestamp format Jira expects for a worklog start.
+const jiraTime = "2006-01-02T15:04:05.000-0700"
+
+// jiraWorklog builds the worklog for a completed session tagged with an
+// issue key, and reports whether there is one to log. The worklog always
+// holds the start time and length; the session label is added as its
+// comment when the jira scopes include labels.
+func jiraWorklog(config models.Config, event string, session models.Session, now time.Time) (models.OutboxEntry, bool, error) {
+	if config.Jira == nil || config.Jira.URL == "" || event != EventSessionCompleted || !session.Completed {
+		return models.OutboxEntry{}, false, nil
+	}
+	issue, ok := session.IssueKey()
+	if !ok {
+		return models.OutboxEntry{}, false, nil
+	}
+
+	worklog := map[string]any{
+		"started":          session.StartTime.Format(jiraTime),
+		"timeSpentSeconds": max(session.ActualMinutes(), 1) * 60,
+	}
+	if slices.Contains(config.IntegrationScopes(KindJira), models.ScopeLabels) && session.Intention != "" {
+		worklog["comment"] = session.Intention
+	}
+	data, err := json.Marshal(worklog)
+	if err != nil {
+		return models.OutboxEntry{}, false, err
+	}
+
+	return models.OutboxEntry{
+		ID:          uuid.New().String(),
+		Kind:        KindJira,
+		Target:      strings.TrimSuffix(config.Jira.URL, "/") + "/rest/api/2/issue/" + issue + "/worklog",
+		Event:       event,
+		Payload:     data,
+		CreatedAt:   now,
+		NextAttempt: now,
+	}, true, nil
+}
+
+// deliverJira posts a worklog with the configured credentials, which are
+// read at delivery so they are never written to the outbox.
+func deliverJira(entry models.OutboxEntry, jira *models.JiraSettings) error {
+	if jira == nil || jira.Token == "" {
+		return errors.New("jira is not configured")
+	}
+
+	req, err := http.NewRequest(http.MethodPost, entry.Target, bytes.NewReader(entry.Payload))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if jira.Email != "" {
+		req.SetBasicAuth(jira.Email, jira.Token)
+	} else {
+		req.Header.Set("Authorization", "Bearer "+jira.Token)
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("jira returned %s", resp.Status)
+	}
+	return nil
+}
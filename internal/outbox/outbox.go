@@ -0,0 +1,168 @@
+// Package outbox delivers integration events queued in storage. Events are
+// queued first and delivered later, so a network failure delays them
+// instead of blocking the timer or dropping them; the daemon retries them
+// with exponential backoff until they go through.
+package outbox
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/adibhanna/focussessions/internal/models"
+	"github.com/adibhanna/focussessions/internal/storage"
+)
+
+// Integration kinds.
+const (
+	KindWebhook = "webhook"
+	KindJira    = "jira"
+	KindPush    = "push"
+	KindEmail   = "email"
+)
+
+// Events published to integrations.
+const (
+	EventSessionCompleted = "session.completed"
+	EventBreakEnded       = "break.ended"
+	EventWeeklyReport     = "report.weekly"
+)
+
+// Retry timing: the first retry waits minBackoff, doubling per attempt up
+// to maxBackoff.
+const (
+	minBackoff = 30 * time.Second
+	maxBackoff = time.Hour
+)
+
+// DefaultLimit is how many entries a flush delivers at most, to stay well
+// within the rate limits of the services on the other end.
+const DefaultLimit = 10
+
+var client = &http.Client{Timeout: 10 * time.Second}
+
+// Backoff returns how long to wait before retrying an entry that failed
+// attempts times.
+func Backoff(attempts int) time.Duration {
+	d := minBackoff
+	for i := 1; i < attempts && d < maxBackoff; i++ {
+		d *= 2
+	}
+	return min(d, maxBackoff)
+}
+
+// Queue is where events are queued for delivery, satisfied by
+// *storage.Storage.
+type Queue interface {
+	EnqueueOutbox(entries ...models.OutboxEntry) error
+}
+
+// Publish queues event about session for every configured integration.
+// Each integration only receives the session fields its configured scopes
+// cover.
+func Publish(store Queue, config models.Config, event string, session models.Session, now time.Time) error {
+	var entries []models.OutboxEntry
+	if len(config.Webhooks) > 0 {
+		payload, err := session.Scoped(config.IntegrationScopes(KindWebhook))
+		if err != nil {
+			return err
+		}
+		data, err := json.Marshal(map[string]any{"event": event, "at": now, "data": payload})
+		if err != nil {
+			return err
+		}
+		for _, url := range config.Webhooks {
+			entries = append(entries, models.OutboxEntry{
+				ID:          uuid.New().String(),
+				Kind:        KindWebhook,
+				Target:      url,
+				Event:       event,
+				Payload:     data,
+				CreatedAt:   now,
+				NextAttempt: now,
+			})
+		}
+	}
+
+	worklog, ok, err := jiraWorklog(config, event, session, now)
+	if err != nil {
+		return err
+	}
+	if ok {
+		entries = append(entries, worklog)
+	}
+
+	push, ok, err := pushEntry(config, event, sessionPush(config, session), now)
+	if err != nil {
+		return err
+	}
+	if ok {
+		entries = append(entries, push)
+	}
+
+	if len(entries) == 0 {
+		return nil
+	}
+	return store.EnqueueOutbox(entries...)
+}
+
+// Flush delivers up to limit entries that are due, oldest first, and
+// reschedules the ones that fail. Delivered entries leave the queue.
+func Flush(store *storage.Storage, now time.Time, limit int) (delivered, failed int, err error) {
+	entries, err := store.GetOutbox()
+	if err != nil {
+		return 0, 0, err
+	}
+	config, err := store.GetConfig()
+	if err != nil {
+		return 0, 0, err
+	}
+
+	for _, entry := range entries {
+		if delivered+failed >= limit {
+			break
+		}
+		if entry.NextAttempt.After(now) {
+			continue
+		}
+
+		if deliverErr := deliver(entry, config, now); deliverErr != nil {
+			failed++
+			if err := store.RetryOutbox(entry.ID, deliverErr, now.Add(Backoff(entry.Attempts+1))); err != nil {
+				return delivered, failed, err
+			}
+			continue
+		}
+		delivered++
+		if err := store.RemoveOutbox(entry.ID); err != nil {
+			return delivered, failed, err
+		}
+	}
+	return delivered, failed, nil
+}
+
+func deliver(entry models.OutboxEntry, config models.Config, now time.Time) error {
+	switch entry.Kind {
+	case KindWebhook:
+		resp, err := client.Post(entry.Target, "application/json", bytes.NewReader(entry.Payload))
+		if err != nil {
+			return err
+		}
+		resp.Body.Close()
+		if resp.StatusCode < 200 || resp.StatusCode > 299 {
+			return fmt.Errorf("webhook returned %s", resp.Status)
+		}
+		return nil
+	case KindJira:
+		return deliverJira(entry, config.Jira)
+	case KindPush:
+		return deliverPush(entry, config.Push)
+	case KindEmail:
+		return deliverEmail(entry, config.Email, now)
+	}
+	return fmt.Errorf("unknown integration %q", entry.Kind)
+}
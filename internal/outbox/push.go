@@ -0,0 +1,158 @@
+package outbox
+
+import (
+	"bytes"
+	"cmp"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/adibhanna/focussessions/internal/models"
+)
+
+// defaultNtfyServer is where ntfy topics live unless a server is set.
+const defaultNtfyServer = "https://ntfy.sh"
+
+// PushMessage is what a push notification says.
+type PushMessage struct {
+	Title string `json:"title"`
+	Text  string `json:"text"`
+}
+
+// sessionPush returns the notification for a completed session. It tells
+// how long the session ran and what it was about when the push scopes
+// include durations and labels.
+func sessionPush(config models.Config, session models.Session) PushMessage {
+	scopes := config.IntegrationScopes(KindPush)
+	var details []string
+	if slices.Contains(scopes, models.ScopeDurations) {
+		details = append(details, fmt.Sprintf("%dm of focus", session.ActualMinutes()))
+	}
+	if label := session.Label(); label != "" && slices.Contains(scopes, models.ScopeLabels) {
+		details = append(details, label)
+	}
+	return PushMessage{
+		Title: "Session complete",
+		Text:  strings.Join(append(details, "time for a break"), " • "),
+	}
+}
+
+// PublishBreak queues the push notification for a break that ran to the
+// end, when push notifications are set up.
+func PublishBreak(store Queue, config models.Config, now time.Time) error {
+	entry, ok, err := pushEntry(config, EventBreakEnded, PushMessage{Title: "Break over", Text: "Time to focus again"}, now)
+	if err != nil || !ok {
+		return err
+	}
+	return store.EnqueueOutbox(entry)
+}
+
+// pushEntry builds the entry sending message, and reports whether there
+// is one to send. The entry names the service but not where it goes: the
+// topic and credentials are read at delivery, so they are never written
+// to the outbox.
+func pushEntry(config models.Config, event string, message PushMessage, now time.Time) (models.OutboxEntry, bool, error) {
+	if config.Push == nil || !config.Push.Ready() {
+		return models.OutboxEntry{}, false, nil
+	}
+	data, err := json.Marshal(message)
+	if err != nil {
+		return models.OutboxEntry{}, false, err
+	}
+	return models.OutboxEntry{
+		ID:          uuid.New().String(),
+		Kind:        KindPush,
+		Target:      config.Push.Via(),
+		Event:       event,
+		Payload:     data,
+		CreatedAt:   now,
+		NextAttempt: now,
+	}, true, nil
+}
+
+func deliverPush(entry models.OutboxEntry, push *models.PushSettings) error {
+	var message PushMessage
+	if err := json.Unmarshal(entry.Payload, &message); err != nil {
+		return err
+	}
+	return SendPush(push, message)
+}
+
+// SendPush sends message through the configured push service straight
+// away.
+func SendPush(push *models.PushSettings, message PushMessage) error {
+	if push == nil || !push.Ready() {
+		return errors.New("push notifications are not configured")
+	}
+	req, err := pushRequest(*push, message)
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		// The Telegram URL holds the bot token; keep it out of the error
+		var urlErr *url.Error
+		if errors.As(err, &urlErr) {
+			return urlErr.Err
+		}
+		return err
+	}
+	resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("%s returned %s", push.Via(), resp.Status)
+	}
+	return nil
+}
+
+// pushRequest returns the request sending message through the service.
+func pushRequest(push models.PushSettings, message PushMessage) (*http.Request, error) {
+	switch push.Via() {
+	case models.PushNtfy:
+		server := strings.TrimSuffix(cmp.Or(push.URL, defaultNtfyServer), "/")
+		req, err := http.NewRequest(http.MethodPost, server+"/"+url.PathEscape(push.Topic), strings.NewReader(message.Text))
+		if err != nil {
+			return nil, err
+		}
+		req.Header.Set("Title", message.Title)
+		req.Header.Set("Tags", "tomato")
+		if push.Token != "" {
+			req.Header.Set("Authorization", "Bearer "+push.Token)
+		}
+		return req, nil
+
+	case models.PushPushover:
+		form := url.Values{
+			"token":   {push.Token},
+			"user":    {push.User},
+			"title":   {message.Title},
+			"message": {message.Text},
+		}
+		req, err := http.NewRequest(http.MethodPost, "https://api.pushover.net/1/messages.json", strings.NewReader(form.Encode()))
+		if err != nil {
+			return nil, err
+		}
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		return req, nil
+
+	case models.PushTelegram:
+		data, err := json.Marshal(map[string]string{"chat_id": push.ChatID, "text": message.Title + "\n" + message.Text})
+		if err != nil {
+			return nil, err
+		}
+		req, err := http.NewRequest(http.MethodPost, "https://api.telegram.org/bot"+push.Token+"/sendMessage", bytes.NewReader(data))
+		if err != nil {
+			return nil, err
+		}
+		req.Header.Set("Content-Type", "application/json")
+		return req, nil
+	}
+	return nil, fmt.Errorf("unknown push service %q", push.Service)
+}
@@ -0,0 +1,186 @@
+// Package plugins runs the executables in the plugins directory on session
+// events and reads back what they ask the dashboard to do. Unlike hooks, a
+// plugin gets every event and can answer it: it receives the event as JSON
+// on stdin and may print commands as JSON, one object per line, such as
+// {"command": "toast", "text": "Logged to the team sheet"}.
+package plugins
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/adibhanna/focussessions/internal/hooks"
+	"github.com/adibhanna/focussessions/internal/models"
+)
+
+// Kind is the integration kind whose scopes limit the session fields
+// plugins receive.
+const Kind = "plugin"
+
+// Timeout is how long a plugin may take to answer an event before it is
+// stopped.
+const Timeout = 5 * time.Second
+
+// Commands a plugin can send back.
+const (
+	CommandToast  = "toast"  // Show text on the dashboard
+	CommandNotify = "notify" // Show a desktop notification with title and text
+	CommandStart  = "start"  // Start a session of minutes (the configured length when 0) tagged tag
+	CommandPause  = "pause"  // Pause the running session
+	CommandResume = "resume" // Resume the paused session
+	CommandBreak  = "break"  // Take a break
+)
+
+// Commands lists every command a plugin can send.
+var Commands = []string{CommandToast, CommandNotify, CommandStart, CommandPause, CommandResume, CommandBreak}
+
+// Event is what a plugin receives on stdin. The event names are those of
+// the hooks, e.g. "on-complete".
+type Event struct {
+	Event        string                     `json:"event"`
+	At           time.Time                  `json:"at"`
+	Session      map[string]json.RawMessage `json:"session,omitempty"`       // The session's fields allowed by the plugin scopes, none for breaks
+	BreakMinutes int                        `json:"break_minutes,omitempty"` // Length of the break, for on-break-start
+	Remaining    int                        `json:"remaining_sessions"`      // Sessions left to reach today's goal
+}
+
+// Command is one line of a plugin's answer.
+type Command struct {
+	Command string `json:"command"`
+	Title   string `json:"title,omitempty"`
+	Text    string `json:"text,omitempty"`
+	Minutes int    `json:"minutes,omitempty"`
+	Tag     string `json:"tag,omitempty"`
+}
+
+// NewEvent returns the event for payload as of now, sharing only the
+// session fields covered by the plugin scopes in config.
+func NewEvent(config models.Config, payload hooks.Payload, now time.Time) (Event, error) {
+	event := Event{
+		Event:        payload.Event,
+		At:           now,
+		BreakMinutes: payload.BreakMinutes,
+		Remaining:    payload.Remaining,
+	}
+	if payload.Session != nil {
+		session, err := payload.Session.Scoped(config.IntegrationScopes(Kind))
+		if err != nil {
+			return Event{}, err
+		}
+		event.Session = session
+	}
+	return event, nil
+}
+
+// List returns the plugins in dir by name. A missing directory has none.
+func List(dir string) ([]string, error) {
+	entries, err := os.ReadDir(dir)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var names []string
+	for _, entry := range entries {
+		info, err := entry.Info()
+		if err != nil || !info.Mode().IsRegular() || !executable(entry.Name(), info.Mode()) {
+			continue
+		}
+		names = append(names, entry.Name())
+	}
+	sort.Strings(names)
+	return names, nil
+}
+
+// executable reports whether a file can be run as a plugin: by its mode,
+// or on Windows by its extension.
+func executable(name string, mode os.FileMode) bool {
+	if runtime.GOOS == "windows" {
+		switch strings.ToLower(filepath.Ext(name)) {
+		case ".exe", ".bat", ".cmd":
+			return true
+		}
+		return false
+	}
+	return mode&0111 != 0
+}
+
+// RunAll sends event to every plugin in dir, one after the other, and
+// returns the commands they answered with in order. A plugin that fails is
+// reported in errs and the others still run.
+func RunAll(dir string, event Event) (commands []Command, errs []error) {
+	names, err := List(dir)
+	if err != nil {
+		return nil, []error{err}
+	}
+	for _, name := range names {
+		answer, err := Run(filepath.Join(dir, name), event)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("plugin %s: %w", name, err))
+		}
+		commands = append(commands, answer...)
+	}
+	return commands, errs
+}
+
+// Run sends event to the plugin at path and reads its commands. It gives
+// up after Timeout. Lines that aren't commands are reported, while the
+// commands before them are kept.
+func Run(path string, event Event) ([]Command, error) {
+	data, err := json.Marshal(event)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), Timeout)
+	defer cancel()
+
+	cmd := exec.CommandContext(ctx, path)
+	cmd.Dir = filepath.Dir(path)
+	cmd.Stdin = bytes.NewReader(data)
+	var stdout bytes.Buffer
+	cmd.Stdout = &stdout
+	if err := cmd.Run(); err != nil {
+		if ctx.Err() != nil {
+			return nil, fmt.Errorf("no answer within %s", Timeout)
+		}
+		return nil, err
+	}
+	return Parse(&stdout)
+}
+
+// Parse reads commands from a plugin's output, one JSON object per line.
+// Blank lines are skipped.
+func Parse(output io.Reader) ([]Command, error) {
+	var commands []Command
+	scanner := bufio.NewScanner(output)
+	for n := 1; scanner.Scan(); n++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+		var command Command
+		if err := json.Unmarshal([]byte(line), &command); err != nil {
+			return commands, fmt.Errorf("line %d: %w", n, err)
+		}
+		if !slices.Contains(Commands, command.Command) {
+			return commands, fmt.Errorf("line %d: unknown command %q", n, command.Command)
+		}
+		commands = append(commands, command)
+	}
+	return commands, scanner.Err()
+}
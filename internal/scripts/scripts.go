@@ -0,0 +1,395 @@
+// Package scripts runs the Lua scripts in the scripts directory on session
+// events. Scripts run inside the app in a sandbox: they can't touch files,
+// run programs or reach the network, but they can read the session history
+// and stats through the focus table and answer an event with the same
+// commands as a plugin. A script handles an event by defining a function
+// named after it, e.g. on_complete(event), and may define report() to
+// compute custom stats for `focussessions scripts report`.
+package scripts
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	lua "github.com/yuin/gopher-lua"
+
+	"github.com/adibhanna/focussessions/internal/hooks"
+	"github.com/adibhanna/focussessions/internal/models"
+	"github.com/adibhanna/focussessions/internal/plugins"
+)
+
+// Ext is the extension of script files.
+const Ext = ".lua"
+
+// Timeout is how long a script may run before it is stopped.
+const Timeout = 5 * time.Second
+
+// ReportFunc is the function a script defines to compute a report.
+const ReportFunc = "report"
+
+// dateFormat is how the focus table takes and gives dates.
+const dateFormat = "2006-01-02"
+
+// Store is the storage scripts read through the focus table, satisfied by
+// *storage.Storage.
+type Store interface {
+	GetConfig() (models.Config, error)
+	GetDayStats(date string) (models.DayStats, error)
+	WeekOf(t time.Time) (year, week int)
+	GetWeekStats(year int, week int) (models.WeekStats, error)
+	GetMonthStats(year int, month int) (models.MonthStats, error)
+	GetSessionsInRange(from, to time.Time) ([]models.Session, error)
+}
+
+// Handler returns the name of the function handling event, e.g.
+// "on_complete" for on-complete.
+func Handler(event string) string {
+	return strings.ReplaceAll(event, "-", "_")
+}
+
+// List returns the scripts in dir by name. A missing directory has none.
+func List(dir string) ([]string, error) {
+	entries, err := os.ReadDir(dir)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var names []string
+	for _, entry := range entries {
+		if entry.Type().IsRegular() && filepath.Ext(entry.Name()) == Ext {
+			names = append(names, entry.Name())
+		}
+	}
+	sort.Strings(names)
+	return names, nil
+}
+
+// RunAll sends the event in payload to every script in dir that handles
+// it, one after the other, and returns the commands they sent in order. A
+// script that fails is reported in errs and the others still run.
+func RunAll(dir string, store Store, payload hooks.Payload, now time.Time, output io.Writer) (commands []plugins.Command, errs []error) {
+	names, err := List(dir)
+	if err != nil {
+		return nil, []error{err}
+	}
+	for _, name := range names {
+		sent, err := Run(filepath.Join(dir, name), store, payload, now, output)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("script %s: %w", name, err))
+		}
+		commands = append(commands, sent...)
+	}
+	return commands, errs
+}
+
+// Run calls the handler of the script at path for the event in payload,
+// if it has one, and returns the commands it sent. What the script prints
+// goes to output. The commands sent before an error are kept.
+func Run(path string, store Store, payload hooks.Payload, now time.Time, output io.Writer) ([]plugins.Command, error) {
+	s := newScript(store, now, output)
+	defer s.close()
+
+	if err := s.load(path); err != nil {
+		return nil, err
+	}
+	handler := s.state.GetGlobal(Handler(payload.Event))
+	if handler.Type() != lua.LTFunction {
+		return nil, nil
+	}
+
+	event := s.state.NewTable()
+	event.RawSetString("event", lua.LString(payload.Event))
+	event.RawSetString("at", lua.LString(now.Format(time.RFC3339)))
+	event.RawSetString("remaining_sessions", lua.LNumber(payload.Remaining))
+	if payload.Session != nil {
+		session, err := s.value(payload.Session)
+		if err != nil {
+			return nil, err
+		}
+		event.RawSetString("session", session)
+	} else {
+		event.RawSetString("break_minutes", lua.LNumber(payload.BreakMinutes))
+	}
+
+	err := s.call(handler, event)
+	return s.commands, err
+}
+
+// Report calls the report function of the script at path and returns what
+// it gave back as text. What the script prints goes to output.
+func Report(path string, store Store, now time.Time, output io.Writer) (string, error) {
+	s := newScript(store, now, output)
+	defer s.close()
+
+	if err := s.load(path); err != nil {
+		return "", err
+	}
+	report := s.state.GetGlobal(ReportFunc)
+	if report.Type() != lua.LTFunction {
+		return "", fmt.Errorf("no %s function", ReportFunc)
+	}
+	if err := s.call(report); err != nil {
+		return "", err
+	}
+	result := s.state.Get(-1)
+	s.state.Pop(1)
+	if result == lua.LNil {
+		return "", nil
+	}
+	return lua.LVAsString(result), nil
+}
+
+// script is one run of a script: its sandboxed Lua state and the commands
+// it has sent so far.
+type script struct {
+	state    *lua.LState
+	cancel   context.CancelFunc
+	store    Store
+	now      time.Time
+	output   io.Writer
+	commands []plugins.Command
+}
+
+// newScript returns a Lua state with only the libraries that can't reach
+// outside of it, and the focus table.
+func newScript(store Store, now time.Time, output io.Writer) *script {
+	state := lua.NewState(lua.Options{SkipOpenLibs: true})
+	for _, lib := range []struct {
+		name string
+		open lua.LGFunction
+	}{
+		{lua.BaseLibName, lua.OpenBase},
+		{lua.TabLibName, lua.OpenTable},
+		{lua.StringLibName, lua.OpenString},
+		{lua.MathLibName, lua.OpenMath},
+	} {
+		state.Push(state.NewFunction(lib.open))
+		state.Push(lua.LString(lib.name))
+		state.Call(1, 0)
+	}
+	// The base library can still load files
+	for _, name := range []string{"dofile", "loadfile", "require", "module"} {
+		state.SetGlobal(name, lua.LNil)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), Timeout)
+	state.SetContext(ctx)
+
+	s := &script{state: state, cancel: cancel, store: store, now: now, output: output}
+	state.SetGlobal("print", state.NewFunction(s.print))
+	state.SetGlobal("focus", state.SetFuncs(state.NewTable(), map[string]lua.LGFunction{
+		"date":       s.date,
+		"day":        s.day,
+		"week":       s.week,
+		"month":      s.month,
+		"sessions":   s.sessions,
+		"config":     s.config,
+		"toast":      s.toast,
+		"notify":     s.notify,
+		"start":      s.start,
+		"pause":      s.send(plugins.CommandPause),
+		"resume":     s.send(plugins.CommandResume),
+		"take_break": s.send(plugins.CommandBreak),
+	}))
+	return s
+}
+
+func (s *script) close() {
+	s.cancel()
+	s.state.Close()
+}
+
+// load runs the script at path, defining its functions.
+func (s *script) load(path string) error {
+	source, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	chunk, err := s.state.Load(bytes.NewReader(source), filepath.Base(path))
+	if err != nil {
+		return err
+	}
+	if err := s.call(chunk); err != nil {
+		return err
+	}
+	s.state.Pop(1)
+	return nil
+}
+
+// call calls fn with args, leaving its first result on the stack.
+func (s *script) call(fn lua.LValue, args ...lua.LValue) error {
+	err := s.state.CallByParam(lua.P{Fn: fn, NRet: 1, Protect: true}, args...)
+	if err != nil && s.state.Context().Err() != nil {
+		return fmt.Errorf("still running after %s", Timeout)
+	}
+	return err
+}
+
+// value returns v as a Lua value, with the same field names as in the data
+// files.
+func (s *script) value(v any) (lua.LValue, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	var decoded any
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return nil, err
+	}
+	return s.convert(decoded), nil
+}
+
+func (s *script) convert(v any) lua.LValue {
+	switch v := v.(type) {
+	case bool:
+		return lua.LBool(v)
+	case float64:
+		return lua.LNumber(v)
+	case string:
+		return lua.LString(v)
+	case []any:
+		table := s.state.CreateTable(len(v), 0)
+		for _, item := range v {
+			table.Append(s.convert(item))
+		}
+		return table
+	case map[string]any:
+		table := s.state.CreateTable(0, len(v))
+		for key, item := range v {
+			table.RawSetString(key, s.convert(item))
+		}
+		return table
+	}
+	return lua.LNil
+}
+
+// push returns v to the script, raising an error when it can't be read.
+func (s *script) push(v any, err error) int {
+	if err != nil {
+		s.state.RaiseError("%v", err)
+	}
+	value, err := s.value(v)
+	if err != nil {
+		s.state.RaiseError("%v", err)
+	}
+	s.state.Push(value)
+	return 1
+}
+
+// dateArg returns the date passed as argument n, today when none is.
+func (s *script) dateArg(n int) time.Time {
+	text := s.state.OptString(n, "")
+	if text == "" {
+		return s.now
+	}
+	date, err := time.ParseInLocation(dateFormat, text, s.now.Location())
+	if err != nil {
+		s.state.ArgError(n, "date must be YYYY-MM-DD")
+	}
+	return date
+}
+
+// print writes its arguments to the output, separated by tabs.
+func (s *script) print(L *lua.LState) int {
+	parts := make([]string, L.GetTop())
+	for i := range parts {
+		parts[i] = L.ToStringMeta(L.Get(i + 1)).String()
+	}
+	fmt.Fprintln(s.output, strings.Join(parts, "\t"))
+	return 0
+}
+
+// date returns today's date moved by a number of days, e.g. focus.date(-1)
+// for yesterday.
+func (s *script) date(L *lua.LState) int {
+	days := L.OptInt(1, 0)
+	L.Push(lua.LString(s.now.AddDate(0, 0, days).Format(dateFormat)))
+	return 1
+}
+
+// day returns the stats of a date, today by default.
+func (s *script) day(L *lua.LState) int {
+	return s.push(s.store.GetDayStats(s.dateArg(1).Format(dateFormat)))
+}
+
+// week returns the stats of the week containing a date, this week by
+// default.
+func (s *script) week(L *lua.LState) int {
+	year, week := s.store.WeekOf(s.dateArg(1))
+	return s.push(s.store.GetWeekStats(year, week))
+}
+
+// month returns the stats of the month containing a date, this month by
+// default.
+func (s *script) month(L *lua.LState) int {
+	date := s.dateArg(1)
+	return s.push(s.store.GetMonthStats(date.Year(), int(date.Month())))
+}
+
+// sessions returns the sessions started from one date to another, both
+// included. The last date defaults to the first, and the first to today.
+func (s *script) sessions(L *lua.LState) int {
+	from := s.dateArg(1)
+	to := from
+	if L.GetTop() >= 2 {
+		to = s.dateArg(2)
+	}
+	first := time.Date(from.Year(), from.Month(), from.Day(), 0, 0, 0, 0, from.Location())
+	last := time.Date(to.Year(), to.Month(), to.Day()+1, 0, 0, 0, 0, to.Location())
+	sessions, err := s.store.GetSessionsInRange(first, last)
+	if sessions == nil {
+		sessions = []models.Session{}
+	}
+	return s.push(sessions, err)
+}
+
+// config returns the settings, without the credentials of email, MQTT,
+// Jira and push notifications.
+func (s *script) config(L *lua.LState) int {
+	config, err := s.store.GetConfig()
+	return s.push(config.Redacted(), err)
+}
+
+func (s *script) toast(L *lua.LState) int {
+	s.commands = append(s.commands, plugins.Command{Command: plugins.CommandToast, Text: L.CheckString(1)})
+	return 0
+}
+
+// notify takes the text, or the title and the text.
+func (s *script) notify(L *lua.LState) int {
+	command := plugins.Command{Command: plugins.CommandNotify, Text: L.CheckString(1)}
+	if L.GetTop() >= 2 {
+		command.Title, command.Text = command.Text, L.CheckString(2)
+	}
+	s.commands = append(s.commands, command)
+	return 0
+}
+
+// start takes the minutes and the tag, both optional.
+func (s *script) start(L *lua.LState) int {
+	s.commands = append(s.commands, plugins.Command{
+		Command: plugins.CommandStart,
+		Minutes: L.OptInt(1, 0),
+		Tag:     L.OptString(2, ""),
+	})
+	return 0
+}
+
+// send returns a function sending command, which takes no arguments.
+func (s *script) send(command string) lua.LGFunction {
+	return func(L *lua.LState) int {
+		s.commands = append(s.commands, plugins.Command{Command: command})
+		return 0
+	}
+}
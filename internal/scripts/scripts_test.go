@@ -0,0 +1,51 @@
+package scripts
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/adibhanna/focussessions/internal/models"
+)
+
+// configStore is a Store holding only a config.
+type configStore struct {
+	Store
+	config models.Config
+}
+
+func (s configStore) GetConfig() (models.Config, error) { return s.config, nil }
+
+func TestConfigRedacted(t *testing.T) {
+	config := models.DefaultConfig()
+	config.Email = &models.EmailSettings{Host: "smtp.example.com", Username: "me", Password: "smtp-secret", To: []string{"me@example.com"}}
+	config.MQTT = &models.MQTTSettings{Broker: "tcp://localhost:1883", Username: "me", Password: "mqtt-secret"}
+	config.Jira = &models.JiraSettings{Email: "me@example.com", Token: "jira-secret"}
+	config.Push = &models.PushSettings{Service: models.PushPushover, Token: "app-secret", User: "user-secret"}
+
+	path := filepath.Join(t.TempDir(), "report.lua")
+	script := `function report()
+	local c = focus.config()
+	local values = {c.email.password, c.mqtt.password, c.jira.token, c.push.token, c.push.user, c.email.username, c.push.service}
+	for i = 1, 7 do
+		values[i] = values[i] or ""
+	end
+	return table.concat(values, ",")
+end`
+	if err := os.WriteFile(path, []byte(script), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := Report(path, configStore{config: config}, time.Now(), io.Discard)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := ",,,,,me,pushover"; got != want {
+		t.Errorf("report = %q, want %q", got, want)
+	}
+	if config.Email.Password != "smtp-secret" || config.Push.Token != "app-secret" {
+		t.Errorf("redacting changed the stored config")
+	}
+}
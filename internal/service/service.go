@@ -0,0 +1,213 @@
+// Package service installs the daemon as a user-level background service:
+// a systemd user unit on Linux or a launchd agent on macOS. Each profile
+// gets its own service, running the daemon for that profile.
+package service
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"strings"
+)
+
+// Names identifying the default profile's service to systemd and launchd.
+// Other profiles add their name, see UnitName and Label.
+const (
+	unitName = "focussessions"
+	label    = "com.adibhanna.focussessions"
+)
+
+// ErrUnsupported is returned on systems without systemd or launchd.
+var ErrUnsupported = errors.New("background services are only supported with systemd (Linux) and launchd (macOS)")
+
+// UnitName returns the systemd unit of profile's service:
+// focussessions.service for the default profile (""), and e.g.
+// focussessions-work.service for the work profile.
+func UnitName(profile string) string {
+	if profile == "" {
+		return unitName + ".service"
+	}
+	return unitName + "-" + nameEscape(profile) + ".service"
+}
+
+// Label returns the launchd label of profile's service, e.g.
+// com.adibhanna.focussessions.work for the work profile.
+func Label(profile string) string {
+	if profile == "" {
+		return label
+	}
+	return label + "." + nameEscape(profile)
+}
+
+// Path returns where the service file of profile is installed on this
+// system.
+func Path(profile string) (string, error) {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+	switch runtime.GOOS {
+	case "linux":
+		return filepath.Join(home, ".config", "systemd", "user", UnitName(profile)), nil
+	case "darwin":
+		return filepath.Join(home, "Library", "LaunchAgents", Label(profile)+".plist"), nil
+	}
+	return "", ErrUnsupported
+}
+
+// args returns the arguments running the daemon of profile.
+func args(profile string) []string {
+	if profile == "" {
+		return []string{"daemon"}
+	}
+	return []string{"--profile", profile, "daemon"}
+}
+
+// Unit renders a systemd user unit running `exe daemon` for profile.
+func Unit(exe, profile string) string {
+	command := []string{systemdQuote(exe)}
+	for _, arg := range args(profile) {
+		command = append(command, systemdQuote(arg))
+	}
+	description := "Focus Sessions daemon"
+	if profile != "" {
+		description += " for the " + profile + " profile"
+	}
+	return fmt.Sprintf(`[Unit]
+Description=%s (finishes sessions and sends reminders)
+
+[Service]
+ExecStart=%s
+Restart=on-failure
+RestartSec=10
+
+[Install]
+WantedBy=default.target
+`, strings.ReplaceAll(description, "%", "%%"), strings.Join(command, " "))
+}
+
+// Plist renders a launchd agent running `exe daemon` for profile at login.
+func Plist(exe, profile, logPath string) string {
+	var arguments strings.Builder
+	for _, arg := range append([]string{exe}, args(profile)...) {
+		fmt.Fprintf(&arguments, "\t\t<string>%s</string>\n", xmlEscape(arg))
+	}
+	return fmt.Sprintf(`<?xml version="1.0" encoding="UTF-8"?>
+<!DOCTYPE plist PUBLIC "-//Apple//DTD PLIST 1.0//EN" "http://www.apple.com/DTDs/PropertyList-1.0.dtd">
+<plist version="1.0">
+<dict>
+	<key>Label</key>
+	<string>%s</string>
+	<key>ProgramArguments</key>
+	<array>
+%s	</array>
+	<key>RunAtLoad</key>
+	<true/>
+	<key>KeepAlive</key>
+	<true/>
+	<key>StandardOutPath</key>
+	<string>%s</string>
+	<key>StandardErrorPath</key>
+	<string>%s</string>
+</dict>
+</plist>
+`, xmlEscape(Label(profile)), arguments.String(), xmlEscape(logPath), xmlEscape(logPath))
+}
+
+// Install writes the service file running exe for profile, logging to
+// logPath under launchd, and starts the service.
+func Install(exe, profile, logPath string) (string, error) {
+	path, err := Path(profile)
+	if err != nil {
+		return "", err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return "", err
+	}
+
+	var content string
+	switch runtime.GOOS {
+	case "linux":
+		content = Unit(exe, profile)
+	case "darwin":
+		content = Plist(exe, profile, logPath)
+	}
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		return "", err
+	}
+
+	switch runtime.GOOS {
+	case "linux":
+		if err := run("systemctl", "--user", "daemon-reload"); err != nil {
+			return path, err
+		}
+		return path, run("systemctl", "--user", "enable", "--now", UnitName(profile))
+	default:
+		run("launchctl", "unload", path) // not loaded on first install
+		return path, run("launchctl", "load", "-w", path)
+	}
+}
+
+// Uninstall stops the service of profile and removes its file.
+func Uninstall(profile string) (string, error) {
+	path, err := Path(profile)
+	if err != nil {
+		return "", err
+	}
+
+	switch runtime.GOOS {
+	case "linux":
+		run("systemctl", "--user", "disable", "--now", UnitName(profile))
+	case "darwin":
+		run("launchctl", "unload", "-w", path)
+	}
+	if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+		return path, err
+	}
+	if runtime.GOOS == "linux" {
+		return path, run("systemctl", "--user", "daemon-reload")
+	}
+	return path, nil
+}
+
+func run(name string, args ...string) error {
+	out, err := exec.Command(name, args...).CombinedOutput()
+	if err != nil {
+		return fmt.Errorf("%s %s: %v: %s", name, strings.Join(args, " "), err, strings.TrimSpace(string(out)))
+	}
+	return nil
+}
+
+// systemdQuote quotes an argument for ExecStart when it contains spaces,
+// and keeps systemd from expanding % specifiers and $ variables in it.
+func systemdQuote(s string) string {
+	s = strings.NewReplacer("%", "%%", "$", "$$").Replace(s)
+	if !strings.ContainsAny(s, " \t\"\\'") {
+		return s
+	}
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(s) + `"`
+}
+
+// nameEscape returns profile as it goes into a unit name or label: ASCII
+// letters, digits and '-' are kept, and any other byte, '_' included, is
+// written as '_' and its hex code, so profiles never share a service.
+func nameEscape(profile string) string {
+	var b strings.Builder
+	for i := 0; i < len(profile); i++ {
+		c := profile[i]
+		switch {
+		case c >= 'a' && c <= 'z', c >= 'A' && c <= 'Z', c >= '0' && c <= '9', c == '-':
+			b.WriteByte(c)
+		default:
+			fmt.Fprintf(&b, "_%02x", c)
+		}
+	}
+	return b.String()
+}
+
+func xmlEscape(s string) string {
+	return strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;").Replace(s)
+}
@@ -0,0 +1,53 @@
+package service
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestServiceNames(t *testing.T) {
+	tests := []struct {
+		profile, unit, label string
+	}{
+		{"", "focussessions.service", "com.adibhanna.focussessions"},
+		{"work", "focussessions-work.service", "com.adibhanna.focussessions.work"},
+		{"side project", "focussessions-side_20project.service", "com.adibhanna.focussessions.side_20project"},
+		{"a_b", "focussessions-a_5fb.service", "com.adibhanna.focussessions.a_5fb"},
+	}
+	for _, tt := range tests {
+		if got := UnitName(tt.profile); got != tt.unit {
+			t.Errorf("UnitName(%q) = %q, want %q", tt.profile, got, tt.unit)
+		}
+		if got := Label(tt.profile); got != tt.label {
+			t.Errorf("Label(%q) = %q, want %q", tt.profile, got, tt.label)
+		}
+	}
+}
+
+func TestUnitExecStart(t *testing.T) {
+	tests := []struct {
+		exe, profile, want string
+	}{
+		{"/usr/bin/focussessions", "", "ExecStart=/usr/bin/focussessions daemon\n"},
+		{"/usr/bin/focussessions", "work", "ExecStart=/usr/bin/focussessions --profile work daemon\n"},
+		{"/opt/my apps/focussessions", "100%", `ExecStart="/opt/my apps/focussessions" --profile 100%% daemon` + "\n"},
+		{"/usr/bin/focussessions", "side project", `ExecStart=/usr/bin/focussessions --profile "side project" daemon` + "\n"},
+	}
+	for _, tt := range tests {
+		if unit := Unit(tt.exe, tt.profile); !strings.Contains(unit, tt.want) {
+			t.Errorf("Unit(%q, %q) has no %q:\n%s", tt.exe, tt.profile, tt.want, unit)
+		}
+	}
+}
+
+func TestPlistArguments(t *testing.T) {
+	plist := Plist("/usr/local/bin/focussessions", "R&D", "/tmp/daemon.log")
+	for _, want := range []string{
+		"<string>com.adibhanna.focussessions.R_26D</string>",
+		"\t\t<string>/usr/local/bin/focussessions</string>\n\t\t<string>--profile</string>\n\t\t<string>R&amp;D</string>\n\t\t<string>daemon</string>\n\t</array>",
+	} {
+		if !strings.Contains(plist, want) {
+			t.Errorf("plist has no %q:\n%s", want, plist)
+		}
+	}
+}
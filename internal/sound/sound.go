@@ -0,0 +1,43 @@
+// Package sound plays short audio files using whatever player the system
+// provides. Playback is fire-and-forget; nothing waits for it to finish.
+package sound
+
+import (
+	"errors"
+	"os"
+	"os/exec"
+	"runtime"
+)
+
+// players lists candidate command-line players per OS, in order of
+// preference.
+var players = map[string][][]string{
+	"darwin":  {{"afplay"}},
+	"linux":   {{"paplay"}, {"pw-play"}, {"aplay", "-q"}, {"ffplay", "-nodisp", "-autoexit", "-loglevel", "quiet"}},
+	"windows": {{"powershell", "-NoProfile", "-Command", "(New-Object Media.SoundPlayer $args[0]).PlaySync()"}},
+}
+
+// ErrNoPlayer is returned when no supported audio player is installed.
+var ErrNoPlayer = errors.New("no audio player found")
+
+// Play starts playing the file at path in the background.
+func Play(path string) error {
+	if _, err := os.Stat(path); err != nil {
+		return err
+	}
+
+	for _, player := range players[runtime.GOOS] {
+		bin, err := exec.LookPath(player[0])
+		if err != nil {
+			continue
+		}
+		args := append(append([]string{}, player[1:]...), path)
+		cmd := exec.Command(bin, args...)
+		if err := cmd.Start(); err != nil {
+			return err
+		}
+		go cmd.Wait()
+		return nil
+	}
+	return ErrNoPlayer
+}
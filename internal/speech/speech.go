@@ -0,0 +1,39 @@
+// Package speech reads short announcements aloud using the system's
+// text-to-speech. Like package sound it is fire-and-forget.
+package speech
+
+import (
+	"errors"
+	"os/exec"
+	"runtime"
+)
+
+// voices lists candidate text-to-speech commands per OS, in order of
+// preference. The text is passed as the last argument.
+var voices = map[string][][]string{
+	"darwin":  {{"say"}},
+	"linux":   {{"spd-say"}, {"espeak-ng"}, {"espeak"}},
+	"windows": {{"powershell", "-NoProfile", "-Command", "Add-Type -AssemblyName System.Speech; (New-Object System.Speech.Synthesis.SpeechSynthesizer).Speak($args[0])"}},
+}
+
+// ErrNoVoice is returned when no supported text-to-speech command is
+// installed.
+var ErrNoVoice = errors.New("no text-to-speech command found")
+
+// Say starts reading text aloud in the background.
+func Say(text string) error {
+	for _, voice := range voices[runtime.GOOS] {
+		bin, err := exec.LookPath(voice[0])
+		if err != nil {
+			continue
+		}
+		args := append(append([]string{}, voice[1:]...), text)
+		cmd := exec.Command(bin, args...)
+		if err := cmd.Start(); err != nil {
+			return err
+		}
+		go cmd.Wait()
+		return nil
+	}
+	return ErrNoVoice
+}
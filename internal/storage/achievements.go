@@ -0,0 +1,49 @@
+package storage
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+func (s *Storage) achievementsFile() string {
+	return filepath.Join(s.dataDir, "achievements.json")
+}
+
+// GetAchievements returns when each unlocked achievement was unlocked,
+// keyed by achievement ID.
+func (s *Storage) GetAchievements() (map[string]time.Time, error) {
+	unlocked := make(map[string]time.Time)
+	data, err := os.ReadFile(s.achievementsFile())
+	if os.IsNotExist(err) {
+		return unlocked, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(data, &unlocked); err != nil {
+		return nil, err
+	}
+	return unlocked, nil
+}
+
+// UnlockAchievements records the achievements with ids as unlocked at at.
+// Achievements that are already unlocked keep their original time.
+func (s *Storage) UnlockAchievements(at time.Time, ids ...string) error {
+	unlocked, err := s.GetAchievements()
+	if err != nil {
+		return err
+	}
+	for _, id := range ids {
+		if _, ok := unlocked[id]; !ok {
+			unlocked[id] = at
+		}
+	}
+
+	data, err := json.MarshalIndent(unlocked, "", "  ")
+	if err != nil {
+		return err
+	}
+	return s.writeFile(s.achievementsFile(), data, true)
+}
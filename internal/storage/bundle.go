@@ -0,0 +1,214 @@
+package storage
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/adibhanna/focussessions/internal/models"
+)
+
+// bundleManifest is the name of the manifest entry identifying a bundle.
+const bundleManifest = "focussessions-bundle.json"
+
+// maxBundleEntry guards against absurdly large entries in a bundle.
+const maxBundleEntry = 256 << 20
+
+type manifest struct {
+	CreatedAt time.Time `json:"created_at"`
+	Files     []string  `json:"files"`
+}
+
+// bundleFiles lists the data files included in a bundle: every regular,
+// non-hidden file at the top of the data directory but logs. New data files
+// (achievements, journal, ...) are picked up without changes here.
+func (s *Storage) bundleFiles() ([]string, error) {
+	entries, err := os.ReadDir(s.dataDir)
+	if err != nil {
+		return nil, err
+	}
+
+	var files []string
+	for _, entry := range entries {
+		if !entry.Type().IsRegular() || strings.HasPrefix(entry.Name(), ".") || filepath.Ext(entry.Name()) == ".log" {
+			continue
+		}
+		files = append(files, entry.Name())
+	}
+	return files, nil
+}
+
+// WriteBundle writes a gzip-compressed tar archive of the whole data
+// directory to w. The passwords and tokens in the config are left out
+// unless secrets is set (see models.Config.Redacted), as bundles are meant
+// to be copied around.
+func (s *Storage) WriteBundle(w io.Writer, secrets bool) ([]string, error) {
+	files, err := s.bundleFiles()
+	if err != nil {
+		return nil, err
+	}
+
+	gz := gzip.NewWriter(w)
+	tw := tar.NewWriter(gz)
+
+	now := time.Now()
+	meta, err := json.MarshalIndent(manifest{CreatedAt: now, Files: files}, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	if err := writeTarEntry(tw, bundleManifest, meta, now); err != nil {
+		return nil, err
+	}
+
+	for _, name := range files {
+		data, err := os.ReadFile(filepath.Join(s.dataDir, name))
+		if err != nil {
+			return nil, err
+		}
+		if name == filepath.Base(s.configFile()) && !secrets {
+			if data, err = redactConfig(data); err != nil {
+				return nil, fmt.Errorf("%s: %w", name, err)
+			}
+		}
+		if err := writeTarEntry(tw, name, data, now); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := tw.Close(); err != nil {
+		return nil, err
+	}
+	if err := gz.Close(); err != nil {
+		return nil, err
+	}
+	return files, nil
+}
+
+// redactConfig returns the config file data without its credentials.
+func redactConfig(data []byte) ([]byte, error) {
+	var config models.Config
+	if err := json.Unmarshal(data, &config); err != nil {
+		return nil, err
+	}
+	return json.MarshalIndent(config.Redacted(), "", "  ")
+}
+
+func writeTarEntry(tw *tar.Writer, name string, data []byte, modTime time.Time) error {
+	header := &tar.Header{
+		Name:    name,
+		Mode:    0644,
+		Size:    int64(len(data)),
+		ModTime: modTime,
+	}
+	if err := tw.WriteHeader(header); err != nil {
+		return err
+	}
+	_, err := tw.Write(data)
+	return err
+}
+
+// RestoreBundle replaces the data directory contents with the files in a
+// bundle read from r. Every data file there is first moved into a
+// timestamped backup directory inside the data directory, so none the
+// bundle lacks is left to mix with the restored ones.
+// It returns the restored file names and the backup directory, if any.
+func (s *Storage) RestoreBundle(r io.Reader) ([]string, string, error) {
+	gz, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, "", fmt.Errorf("not a focussessions bundle: %w", err)
+	}
+	defer gz.Close()
+
+	contents := make(map[string][]byte)
+	var names []string
+	foundManifest := false
+
+	tr := tar.NewReader(gz)
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, "", err
+		}
+		if header.Typeflag != tar.TypeReg {
+			continue
+		}
+
+		name := header.Name
+		if name != filepath.Base(name) || strings.HasPrefix(name, ".") {
+			return nil, "", fmt.Errorf("unexpected entry %q in bundle", header.Name)
+		}
+		if header.Size > maxBundleEntry {
+			return nil, "", fmt.Errorf("entry %q is too large", name)
+		}
+
+		data, err := io.ReadAll(io.LimitReader(tr, maxBundleEntry))
+		if err != nil {
+			return nil, "", err
+		}
+
+		if name == bundleManifest {
+			foundManifest = true
+			continue
+		}
+		if strings.HasSuffix(name, ".json") && !json.Valid(data) {
+			return nil, "", fmt.Errorf("%s in bundle is not valid JSON", name)
+		}
+		contents[name] = data
+		names = append(names, name)
+	}
+
+	if !foundManifest {
+		return nil, "", fmt.Errorf("not a focussessions bundle: missing %s", bundleManifest)
+	}
+
+	backupDir, err := s.replaceFiles(names, contents)
+	if err != nil {
+		return nil, backupDir, err
+	}
+
+	if config, err := s.readConfig(); err == nil {
+		s.applyConfig(config)
+	}
+	return names, backupDir, nil
+}
+
+func (s *Storage) replaceFiles(names []string, contents map[string][]byte) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	defer s.cache.reset()
+
+	current, err := s.bundleFiles()
+	if err != nil {
+		return "", err
+	}
+	backupDir := ""
+	for _, name := range current {
+		path := filepath.Join(s.dataDir, name)
+		if backupDir == "" {
+			backupDir = filepath.Join(s.dataDir, "backup-"+time.Now().Format("2006-01-02-150405"))
+			if err := os.MkdirAll(backupDir, 0755); err != nil {
+				return "", err
+			}
+		}
+		if err := os.Rename(path, filepath.Join(backupDir, name)); err != nil {
+			return backupDir, err
+		}
+	}
+
+	for _, name := range names {
+		if err := s.writeFile(filepath.Join(s.dataDir, name), contents[name], true); err != nil {
+			return backupDir, err
+		}
+	}
+
+	return backupDir, nil
+}
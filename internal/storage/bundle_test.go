@@ -0,0 +1,82 @@
+package storage
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+
+	"github.com/adibhanna/focussessions/internal/models"
+)
+
+func TestBundle(t *testing.T) {
+	for _, secrets := range []bool{false, true} {
+		src := newTestStorage(t)
+		config := models.DefaultConfig()
+		config.SessionDuration = 45
+		config.Email = &models.EmailSettings{Host: "smtp.example.com", Password: "smtp-secret", To: []string{"me@example.com"}}
+		steps := []error{
+			src.SaveConfig(config),
+			src.SaveSession(testSession("a", testStart, 25)),
+			os.WriteFile(src.DaemonLogPath(), []byte("started\n"), 0644),
+		}
+		for _, err := range steps {
+			if err != nil {
+				t.Fatal(err)
+			}
+		}
+
+		var bundle bytes.Buffer
+		files, err := src.WriteBundle(&bundle, secrets)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := []string{"config.json", "sessions.json"}; !slices.Equal(files, want) {
+			t.Errorf("secrets %v: bundled %v, want %v", secrets, files, want)
+		}
+
+		// Restore over data the bundle doesn't carry
+		dst := newTestStorage(t)
+		steps = []error{
+			dst.SaveTasks([]models.Task{{ID: "t", Title: "Write"}}),
+			os.WriteFile(dst.DaemonLogPath(), []byte("mine\n"), 0644),
+		}
+		for _, err := range steps {
+			if err != nil {
+				t.Fatal(err)
+			}
+		}
+		restored, backupDir, err := dst.RestoreBundle(&bundle)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !slices.Equal(restored, files) {
+			t.Errorf("secrets %v: restored %v, want %v", secrets, restored, files)
+		}
+
+		if _, err := os.Stat(filepath.Join(dst.dataDir, "tasks.json")); !os.IsNotExist(err) {
+			t.Errorf("secrets %v: tasks.json left next to the restored data", secrets)
+		}
+		if _, err := os.Stat(filepath.Join(backupDir, "tasks.json")); err != nil {
+			t.Errorf("secrets %v: tasks.json not backed up: %v", secrets, err)
+		}
+		if data, err := os.ReadFile(dst.DaemonLogPath()); err != nil || string(data) != "mine\n" {
+			t.Errorf("secrets %v: daemon.log = %q, %v; want it kept", secrets, data, err)
+		}
+
+		got, err := dst.GetConfig()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got.SessionDuration != 45 || got.Email == nil || got.Email.Host != "smtp.example.com" {
+			t.Errorf("secrets %v: restored config %+v", secrets, got)
+		}
+		if password := got.Email.Password; (password == "smtp-secret") != secrets {
+			t.Errorf("secrets %v: restored password %q", secrets, password)
+		}
+		if sessions, err := dst.GetAllSessions(); err != nil || len(sessions) != 1 {
+			t.Errorf("secrets %v: %d sessions restored, %v; want 1", secrets, len(sessions), err)
+		}
+	}
+}
@@ -0,0 +1,173 @@
+package storage
+
+import (
+	"encoding/json"
+	"os"
+	"time"
+
+	"github.com/adibhanna/focussessions/internal/models"
+)
+
+type yearWeek struct {
+	year, week int
+}
+
+type yearMonth struct {
+	year, month int
+}
+
+// sessionCache keeps the parsed sessions file in memory together with
+// memoized aggregates, so opening the stats view doesn't re-read and
+// re-scan the file once per period. Everything is invalidated whenever the
+// file is written by us or changes on disk.
+type sessionCache struct {
+	loaded     bool
+	exists     bool
+	modTime    time.Time
+	size       int64
+	generation int
+	sessions   []models.Session
+
+	day   map[string]models.DayStats
+	week  map[yearWeek]models.WeekStats
+	month map[yearMonth]models.MonthStats
+	year  map[int]models.YearStats
+}
+
+func (c *sessionCache) reset() {
+	c.loaded = false
+	c.sessions = nil
+	c.invalidate()
+}
+
+// invalidate drops the memoized aggregates and bumps the generation so that
+// aggregates computed concurrently from older data are not stored.
+func (c *sessionCache) invalidate() {
+	c.generation++
+	c.day = make(map[string]models.DayStats)
+	c.week = make(map[yearWeek]models.WeekStats)
+	c.month = make(map[yearMonth]models.MonthStats)
+	c.year = make(map[int]models.YearStats)
+}
+
+func (c *sessionCache) snapshot() []models.Session {
+	sessions := make([]models.Session, len(c.sessions))
+	copy(sessions, c.sessions)
+	return sessions
+}
+
+// refreshLocked reloads the sessions file if it was modified since it was
+// last cached. The caller must hold s.mu.
+func (s *Storage) refreshLocked() error {
+	info, err := os.Stat(s.sessionsFile())
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+
+	exists := err == nil
+	if s.cache.loaded && s.cache.exists == exists &&
+		(!exists || (info.ModTime().Equal(s.cache.modTime) && info.Size() == s.cache.size)) {
+		return nil
+	}
+
+	var sessions []models.Session
+	if exists {
+		data, err := os.ReadFile(s.sessionsFile())
+		if err != nil {
+			return err
+		}
+		if err := json.Unmarshal(data, &sessions); err != nil {
+			return err
+		}
+		normalizeSessions(sessions)
+	}
+
+	s.cache.store(sessions, info)
+	return nil
+}
+
+// writeSessionsLocked writes sessions to disk and makes them the cached
+// state. The caller must hold s.mu.
+func (s *Storage) writeSessionsLocked(sessions []models.Session, durable bool) error {
+	data, err := json.MarshalIndent(sessions, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	if err := s.writeFile(s.sessionsFile(), data, durable); err != nil {
+		s.cache.reset()
+		return err
+	}
+
+	info, err := os.Stat(s.sessionsFile())
+	if err != nil {
+		s.cache.reset()
+		return nil
+	}
+
+	s.cache.store(sessions, info)
+	return nil
+}
+
+func (c *sessionCache) store(sessions []models.Session, info os.FileInfo) {
+	c.loaded = true
+	c.exists = info != nil
+	c.modTime = time.Time{}
+	c.size = 0
+	if info != nil {
+		c.modTime = info.ModTime()
+		c.size = info.Size()
+	}
+	c.sessions = sessions
+	c.invalidate()
+}
+
+// memoize returns the cached aggregate for key, computing and caching it on
+// a miss. The cache lock is not held while computing.
+func memoize[K comparable, V any](s *Storage, pick func(*sessionCache) map[K]V, key K, compute func() (V, error)) (V, error) {
+	s.mu.Lock()
+	if err := s.refreshLocked(); err != nil {
+		s.mu.Unlock()
+		var zero V
+		return zero, err
+	}
+	if v, ok := pick(&s.cache)[key]; ok {
+		s.mu.Unlock()
+		return v, nil
+	}
+	generation := s.cache.generation
+	s.mu.Unlock()
+
+	v, err := compute()
+	if err != nil {
+		return v, err
+	}
+
+	s.mu.Lock()
+	if s.cache.generation == generation {
+		pick(&s.cache)[key] = v
+	}
+	s.mu.Unlock()
+
+	return v, nil
+}
+
+func (s *Storage) GetDayStats(date string) (models.DayStats, error) {
+	return memoize(s, func(c *sessionCache) map[string]models.DayStats { return c.day }, date,
+		func() (models.DayStats, error) { return s.computeDayStats(date) })
+}
+
+func (s *Storage) GetWeekStats(year int, week int) (models.WeekStats, error) {
+	return memoize(s, func(c *sessionCache) map[yearWeek]models.WeekStats { return c.week }, yearWeek{year, week},
+		func() (models.WeekStats, error) { return s.computeWeekStats(year, week) })
+}
+
+func (s *Storage) GetMonthStats(year int, month int) (models.MonthStats, error) {
+	return memoize(s, func(c *sessionCache) map[yearMonth]models.MonthStats { return c.month }, yearMonth{year, month},
+		func() (models.MonthStats, error) { return s.computeMonthStats(year, month) })
+}
+
+func (s *Storage) GetYearStats(year int) (models.YearStats, error) {
+	return memoize(s, func(c *sessionCache) map[int]models.YearStats { return c.year }, year,
+		func() (models.YearStats, error) { return s.computeYearStats(year) })
+}
@@ -0,0 +1,51 @@
+package storage
+
+import (
+	"time"
+
+	"github.com/adibhanna/focussessions/internal/models"
+)
+
+// CompareWeek returns the change in completed sessions and minutes of the
+// given week versus the week before it.
+func (s *Storage) CompareWeek(year, week int) (models.Comparison, error) {
+	current, err := s.GetWeekStats(year, week)
+	if err != nil {
+		return models.Comparison{}, err
+	}
+
+	// The Monday of ISO week 1 is on or before January 4th. Both ISO and
+	// Sunday-based weeks contain the Monday of the ISO week with their
+	// number, so the Monday a week earlier lies in the previous week.
+	jan4 := time.Date(year, time.January, 4, 0, 0, 0, 0, time.Local)
+	monday := jan4.AddDate(0, 0, -(int(jan4.Weekday())+6)%7+(week-1)*7)
+	prevYear, prevWeek := s.WeekOf(monday.AddDate(0, 0, -7))
+
+	previous, err := s.GetWeekStats(prevYear, prevWeek)
+	if err != nil {
+		return models.Comparison{}, err
+	}
+	return models.Comparison{
+		Sessions: current.SessionsCount - previous.SessionsCount,
+		Minutes:  current.TotalMinutes - previous.TotalMinutes,
+	}, nil
+}
+
+// CompareMonth returns the change in completed sessions and minutes of the
+// given month versus the month before it.
+func (s *Storage) CompareMonth(year, month int) (models.Comparison, error) {
+	current, err := s.GetMonthStats(year, month)
+	if err != nil {
+		return models.Comparison{}, err
+	}
+
+	prev := time.Date(year, time.Month(month), 1, 0, 0, 0, 0, time.Local).AddDate(0, -1, 0)
+	previous, err := s.GetMonthStats(prev.Year(), int(prev.Month()))
+	if err != nil {
+		return models.Comparison{}, err
+	}
+	return models.Comparison{
+		Sessions: current.SessionsCount - previous.SessionsCount,
+		Minutes:  current.TotalMinutes - previous.TotalMinutes,
+	}, nil
+}
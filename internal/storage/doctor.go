@@ -0,0 +1,311 @@
+package storage
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/adibhanna/focussessions/internal/models"
+)
+
+// skewTolerance is how far a recorded time may be off before it is reported
+// as clock skew.
+const skewTolerance = 5 * time.Minute
+
+// Problem is an issue found in the data directory.
+type Problem struct {
+	File    string // sessions.json, config.json or the data directory
+	Detail  string
+	Fixable bool
+}
+
+// Diagnosis is the result of checking the data directory. It carries the
+// repaired sessions and config for Repair.
+type Diagnosis struct {
+	Problems []Problem
+
+	sessions []models.Session // repaired sessions, nil when unchanged
+	config   *models.Config   // repaired config, nil when unchanged
+}
+
+// Fixable returns how many problems Repair can fix.
+func (d *Diagnosis) Fixable() int {
+	n := 0
+	for _, p := range d.Problems {
+		if p.Fixable {
+			n++
+		}
+	}
+	return n
+}
+
+func (d *Diagnosis) report(file string, fixable bool, format string, args ...any) {
+	d.Problems = append(d.Problems, Problem{File: file, Detail: fmt.Sprintf(format, args...), Fixable: fixable})
+}
+
+// Diagnose checks the data directory's permissions, validates the sessions
+// and config files, and looks for duplicate session IDs, orphaned active
+// sessions and times that don't add up.
+func (s *Storage) Diagnose(now time.Time) (*Diagnosis, error) {
+	d := &Diagnosis{}
+	s.checkDataDir(d)
+	if err := s.checkSessions(d, now); err != nil {
+		return nil, err
+	}
+	if err := s.checkConfig(d); err != nil {
+		return nil, err
+	}
+	return d, nil
+}
+
+// Verify checks only the session history for impossible states: sessions
+// that end before they start or ran longer than planned, more than one
+// active session, and date fields that don't match the start time.
+func (s *Storage) Verify(now time.Time) (*Diagnosis, error) {
+	d := &Diagnosis{}
+	if err := s.checkSessions(d, now); err != nil {
+		return nil, err
+	}
+	return d, nil
+}
+
+// Repair writes the repaired sessions and config of d.
+func (s *Storage) Repair(d *Diagnosis) error {
+	if d.sessions != nil {
+		s.mu.Lock()
+		err := s.writeSessionsLocked(d.sessions, true)
+		s.mu.Unlock()
+		if err != nil {
+			return err
+		}
+	}
+	if d.config != nil {
+		return s.SaveConfig(*d.config)
+	}
+	return nil
+}
+
+func (s *Storage) checkDataDir(d *Diagnosis) {
+	info, err := os.Stat(s.dataDir)
+	if err != nil {
+		d.report(s.dataDir, false, "can't read the data directory: %v", err)
+		return
+	}
+	if !info.IsDir() {
+		d.report(s.dataDir, false, "the data directory is not a directory")
+		return
+	}
+
+	probe, err := os.CreateTemp(s.dataDir, ".doctor-*")
+	if err != nil {
+		d.report(s.dataDir, false, "the data directory is not writable: %v", err)
+		return
+	}
+	probe.Close()
+	os.Remove(probe.Name())
+
+	for _, name := range []string{"sessions.json", "config.json"} {
+		path := filepath.Join(s.dataDir, name)
+		f, err := os.OpenFile(path, os.O_RDWR, 0)
+		if os.IsNotExist(err) {
+			continue
+		}
+		if err != nil {
+			d.report(name, false, "can't open for writing: %v", err)
+			continue
+		}
+		f.Close()
+	}
+}
+
+func (s *Storage) checkSessions(d *Diagnosis, now time.Time) error {
+	const file = "sessions.json"
+
+	data, err := os.ReadFile(s.sessionsFile())
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		d.report(file, false, "can't read: %v", err)
+		return nil
+	}
+
+	var sessions []models.Session
+	if err := json.Unmarshal(data, &sessions); err != nil {
+		d.report(file, false, "not valid session data: %v", err)
+		return nil
+	}
+	if field := unknownField(data, &[]models.Session{}); field != "" {
+		d.report(file, false, "unknown field: %s", field)
+	}
+
+	changed := false
+	fixed := make([]models.Session, 0, len(sessions))
+	seen := make(map[string]int)
+	latestActive := -1
+
+	for _, session := range sessions {
+		label := session.StartTime.Local().Format("2006-01-02 15:04")
+
+		if session.ID == "" {
+			d.report(file, true, "session started %s has no ID", label)
+			session.ID = uuid.New().String()
+			changed = true
+		}
+		if session.StartTime.IsZero() {
+			d.report(file, false, "session %s has no start time", session.ID)
+		} else {
+			start := session.StartTime.Local()
+			_, week := start.ISOWeek()
+			if session.Date != start.Format("2006-01-02") || session.Week != week || session.Month != start.Format("2006-01") || session.Year != start.Year() {
+				d.report(file, true, "session %s: date fields don't match its start time %s", session.ID, label)
+				session.Date = start.Format("2006-01-02")
+				session.Month = start.Format("2006-01")
+				session.Year = start.Year()
+				session.Week = week
+				changed = true
+			}
+			if session.StartTime.After(now.Add(skewTolerance)) {
+				d.report(file, false, "session %s starts in the future (%s); check the clock of the machine that recorded it", session.ID, label)
+			}
+		}
+		if session.Duration <= 0 {
+			d.report(file, false, "session %s has no planned duration", session.ID)
+		}
+		if !session.EndTime.IsZero() && !session.StartTime.IsZero() {
+			span := session.EndTime.Sub(session.StartTime)
+			switch {
+			case span < 0:
+				d.report(file, false, "session %s ends before it starts; check the clock of the machine that recorded it", session.ID)
+			case !session.Active && time.Duration(session.ElapsedSeconds)*time.Second > span+skewTolerance:
+				d.report(file, true, "session %s: %s elapsed in %s between start and end",
+					session.ID, models.FormatMinutes(session.ElapsedSeconds/60), models.FormatMinutes(int(span.Minutes())))
+				session.ElapsedSeconds = int(span.Seconds())
+				changed = true
+			}
+		}
+
+		if planned := time.Duration(session.Duration) * time.Minute; session.Duration > 0 &&
+			time.Duration(session.ElapsedSeconds)*time.Second > planned+skewTolerance {
+			d.report(file, true, "session %s: %s elapsed of %s planned",
+				session.ID, models.FormatMinutes(session.ElapsedSeconds/60), models.FormatMinutes(session.Duration))
+			session.ElapsedSeconds = int(planned.Seconds())
+			changed = true
+		}
+
+		if i, ok := seen[session.ID]; ok {
+			d.report(file, true, "duplicate session ID %s", session.ID)
+			if moreRecent(session, fixed[i]) {
+				fixed[i] = session
+			}
+			changed = true
+			continue
+		}
+
+		if session.Active && !session.Completed {
+			switch {
+			case latestActive < 0:
+				latestActive = len(fixed)
+			case session.StartTime.After(fixed[latestActive].StartTime):
+				d.report(file, true, "more than one active session: %s is orphaned", fixed[latestActive].ID)
+				fixed[latestActive].Active = false
+				fixed[latestActive].Paused = false
+				latestActive = len(fixed)
+				changed = true
+			default:
+				d.report(file, true, "more than one active session: %s is orphaned", session.ID)
+				session.Active = false
+				session.Paused = false
+				changed = true
+			}
+		}
+
+		seen[session.ID] = len(fixed)
+		fixed = append(fixed, session)
+	}
+
+	if changed {
+		d.sessions = fixed
+	}
+	return nil
+}
+
+// moreRecent reports whether a is a later copy of the same session than b.
+func moreRecent(a, b models.Session) bool {
+	if !a.EndTime.Equal(b.EndTime) {
+		return a.EndTime.After(b.EndTime)
+	}
+	return a.ElapsedSeconds > b.ElapsedSeconds
+}
+
+func (s *Storage) checkConfig(d *Diagnosis) error {
+	const file = "config.json"
+
+	data, err := os.ReadFile(s.configFile())
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		d.report(file, false, "can't read: %v", err)
+		return nil
+	}
+
+	config := models.DefaultConfig()
+	if err := json.Unmarshal(data, &config); err != nil {
+		d.report(file, true, "not a valid config, the defaults will be written: %v", err)
+		defaults := models.DefaultConfig()
+		d.config = &defaults
+		return nil
+	}
+	if field := unknownField(data, &models.Config{}); field != "" {
+		d.report(file, false, "unknown option: %s", field)
+	}
+
+	defaults := models.DefaultConfig()
+	changed := false
+	check := func(ok bool, value *int, fallback int, detail string) {
+		if ok {
+			return
+		}
+		d.report(file, true, "%s is %d, resetting to %d", detail, *value, fallback)
+		*value = fallback
+		changed = true
+	}
+	check(config.SessionDuration >= 1 && config.SessionDuration <= 180, &config.SessionDuration, defaults.SessionDuration, "session_duration")
+	check(config.DailySessionGoal >= 1 && config.DailySessionGoal <= 24, &config.DailySessionGoal, defaults.DailySessionGoal, "daily_session_goal")
+	check(config.BreakDuration >= 1 && config.BreakDuration <= 60, &config.BreakDuration, defaults.BreakDuration, "break_duration")
+	check(config.WorkStartHour >= 0 && config.WorkStartHour <= 23, &config.WorkStartHour, defaults.WorkStartHour, "work_start_hour")
+	check(config.WorkEndHour >= 0 && config.WorkEndHour <= 23 && config.WorkEndHour > config.WorkStartHour,
+		&config.WorkEndHour, max(defaults.WorkEndHour, config.WorkStartHour+1), "work_end_hour")
+	if _, ok := models.ParseWeekday(config.WeekStartDay); !ok {
+		d.report(file, true, "week_start_day %q is not a weekday, resetting to %s", config.WeekStartDay, defaults.WeekStartDay)
+		config.WeekStartDay = defaults.WeekStartDay
+		changed = true
+	}
+
+	if changed {
+		d.config = &config
+	}
+	return nil
+}
+
+// unknownField returns the first field in data that v doesn't declare, or
+// an empty string when there is none.
+func unknownField(data []byte, v any) string {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.DisallowUnknownFields()
+	err := dec.Decode(v)
+	if err == nil {
+		return ""
+	}
+	const prefix = "json: unknown field "
+	if msg := err.Error(); len(msg) > len(prefix) && msg[:len(prefix)] == prefix {
+		return msg[len(prefix):]
+	}
+	return ""
+}
@@ -0,0 +1,133 @@
+package storage
+
+import (
+	"encoding/json"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/adibhanna/focussessions/internal/models"
+)
+
+func TestDoctorRepairs(t *testing.T) {
+	now := testStart.Add(8 * time.Hour)
+	sessions := func(edit func(s []models.Session) []models.Session) string {
+		base := []models.Session{
+			testSession("a", testStart, 25),
+			testSession("b", testStart.Add(time.Hour), 25),
+		}
+		data, _ := json.Marshal(edit(base))
+		return string(data)
+	}
+	unchanged := func(s []models.Session) []models.Session { return s }
+
+	tests := []struct {
+		name               string
+		sessions, config   string // File contents, none when empty
+		fixable, unfixable int
+	}{
+		{"healthy", sessions(unchanged), `{"session_duration": 45}`, 0, 0},
+		{"no ID", sessions(func(s []models.Session) []models.Session {
+			s[1].ID = ""
+			return s
+		}), "", 1, 0},
+		{"date fields", sessions(func(s []models.Session) []models.Session {
+			s[0].Date, s[0].Week = "2025-03-11", 1
+			return s
+		}), "", 1, 0},
+		{"elapsed beyond the planned time", sessions(func(s []models.Session) []models.Session {
+			s[0].ElapsedSeconds = 90 * 60
+			s[0].EndTime = s[0].StartTime.Add(90 * time.Minute)
+			return s
+		}), "", 1, 0},
+		{"elapsed beyond start to end", sessions(func(s []models.Session) []models.Session {
+			s[0].EndTime = s[0].StartTime.Add(10 * time.Minute)
+			return s
+		}), "", 1, 0},
+		{"duplicate IDs", sessions(func(s []models.Session) []models.Session {
+			s[1].ID = "a"
+			return s
+		}), "", 1, 0},
+		{"two active sessions", sessions(func(s []models.Session) []models.Session {
+			for i := range s {
+				s[i].Active, s[i].Completed, s[i].EndTime = true, false, time.Time{}
+			}
+			return s
+		}), "", 1, 0},
+		{"ends before it starts", sessions(func(s []models.Session) []models.Session {
+			s[0].EndTime = s[0].StartTime.Add(-time.Minute)
+			s[0].ElapsedSeconds = 0
+			return s
+		}), "", 0, 1},
+		{"starts in the future", sessions(func(s []models.Session) []models.Session {
+			return append(s, testSession("c", now.Add(time.Hour), 25))
+		}), "", 0, 1},
+		{"unknown session field", `[{"id": "a", "start_time": "2025-03-12T09:00:00Z", "duration": 25, "moods": 3}]`, "", 1, 1},
+		{"sessions not JSON", `[{"id": "a",`, "", 0, 1},
+		{"sessions not a list", `{"id": "a"}`, "", 0, 1},
+		{"config out of range", "", `{"session_duration": 0, "daily_session_goal": 99, "work_start_hour": 18, "work_end_hour": 9}`, 3, 0},
+		{"config week start", "", `{"week_start_day": "someday"}`, 1, 0},
+		{"config not JSON", "", `{"session_duration": `, 1, 0},
+		{"unknown option", "", `{"session_duraton": 45}`, 0, 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := newTestStorage(t)
+			if tt.sessions != "" {
+				if err := os.WriteFile(s.sessionsFile(), []byte(tt.sessions), 0644); err != nil {
+					t.Fatal(err)
+				}
+			}
+			if tt.config != "" {
+				if err := os.WriteFile(s.configFile(), []byte(tt.config), 0644); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			d, err := s.Diagnose(now)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if fixable, unfixable := d.Fixable(), len(d.Problems)-d.Fixable(); fixable != tt.fixable || unfixable != tt.unfixable {
+				t.Errorf("%d fixable and %d other problems, want %d and %d: %+v", fixable, unfixable, tt.fixable, tt.unfixable, d.Problems)
+			}
+			if err := s.Repair(d); err != nil {
+				t.Fatal(err)
+			}
+			repaired := readFiles(t, s)
+
+			// A repaired directory has nothing left to fix, and repairing
+			// it again leaves it as it is
+			again, err := s.Diagnose(now)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if again.Fixable() != 0 {
+				t.Errorf("after repair, %d fixable problems left: %+v", again.Fixable(), again.Problems)
+			}
+			if len(again.Problems) > tt.unfixable {
+				t.Errorf("after repair, %d problems, want at most %d: %+v", len(again.Problems), tt.unfixable, again.Problems)
+			}
+			if err := s.Repair(again); err != nil {
+				t.Fatal(err)
+			}
+			if after := readFiles(t, s); after != repaired {
+				t.Errorf("repairing again changed the files:\n%s\nwant\n%s", after, repaired)
+			}
+		})
+	}
+}
+
+// readFiles returns the contents of the sessions and config files of s.
+func readFiles(t *testing.T, s *Storage) string {
+	t.Helper()
+	var contents string
+	for _, path := range []string{s.sessionsFile(), s.configFile()} {
+		data, err := os.ReadFile(path)
+		if err != nil && !os.IsNotExist(err) {
+			t.Fatal(err)
+		}
+		contents += string(data) + "\n"
+	}
+	return contents
+}
@@ -0,0 +1,63 @@
+package storage
+
+import (
+	"sort"
+
+	"github.com/adibhanna/focussessions/internal/models"
+)
+
+// SetStatsFilter restricts the day, week, month and year aggregates and the
+// daily and hourly charts to the sessions matching filter, until it is
+// set back to the zero filter. Unlike the config options it isn't saved.
+func (s *Storage) SetStatsFilter(filter models.StatsFilter) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if filter != s.filter {
+		s.filter = filter
+		s.cache.invalidate()
+	}
+}
+
+// StatsFilter returns the filter set with SetStatsFilter.
+func (s *Storage) StatsFilter() models.StatsFilter {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.filter
+}
+
+// matchingFilter drops the sessions the stats filter leaves out.
+func (s *Storage) matchingFilter(sessions []models.Session) []models.Session {
+	filter := s.StatsFilter()
+	if filter.IsZero() {
+		return sessions
+	}
+
+	kept := make([]models.Session, 0, len(sessions))
+	for _, session := range sessions {
+		if filter.Matches(session) {
+			kept = append(kept, session)
+		}
+	}
+	return kept
+}
+
+// GetProjects returns every project a session was filed under, sorted by
+// name.
+func (s *Storage) GetProjects() ([]string, error) {
+	sessions, err := s.GetAllSessions()
+	if err != nil {
+		return nil, err
+	}
+
+	seen := make(map[string]bool)
+	var projects []string
+	for _, session := range sessions {
+		if session.Project != "" && !seen[session.Project] {
+			seen[session.Project] = true
+			projects = append(projects, session.Project)
+		}
+	}
+	sort.Strings(projects)
+	return projects, nil
+}
@@ -0,0 +1,55 @@
+package storage
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/adibhanna/focussessions/internal/models"
+)
+
+// HandoffTimeout is how long a running session may go without a heartbeat
+// before the machine running it is assumed to be gone. Running sessions
+// save progress far more often than this.
+const HandoffTimeout = time.Minute
+
+// ConflictError reports that a session is running on another machine.
+type ConflictError struct {
+	Host        string
+	HeartbeatAt time.Time
+}
+
+func (e *ConflictError) Error() string {
+	return fmt.Sprintf("session is running on %s", e.Host)
+}
+
+// CheckHandoff compares the stored copy of the session with id against
+// host, for data directories shared between machines. It returns a
+// *ConflictError when another machine is running the session, and nil when
+// the session is paused, finished, run by host or abandoned.
+func (s *Storage) CheckHandoff(id, host string, now time.Time) error {
+	sessions, err := s.GetAllSessions()
+	if err != nil {
+		return err
+	}
+
+	for _, stored := range sessions {
+		if stored.ID != id {
+			continue
+		}
+		if !runningElsewhere(stored, host, now) {
+			return nil
+		}
+		return &ConflictError{Host: stored.Host, HeartbeatAt: stored.HeartbeatAt}
+	}
+	return nil
+}
+
+func runningElsewhere(session models.Session, host string, now time.Time) bool {
+	if !session.Active || session.Paused || session.Completed {
+		return false
+	}
+	if session.Host == "" || session.Host == host {
+		return false
+	}
+	return now.Sub(session.HeartbeatAt) <= HandoffTimeout
+}
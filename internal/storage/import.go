@@ -0,0 +1,87 @@
+package storage
+
+import (
+	"time"
+
+	"github.com/adibhanna/focussessions/internal/models"
+)
+
+// ImportSessions adds sessions to the history, skipping any that start at
+// the same moment as a session already stored, so importing the same file
+// twice is harmless. It returns the sessions that were added.
+func (s *Storage) ImportSessions(sessions []models.Session) ([]models.Session, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.refreshLocked(); err != nil {
+		return nil, err
+	}
+	existing := s.cache.snapshot()
+
+	seen := make(map[int64]bool, len(existing))
+	for _, session := range existing {
+		seen[session.StartTime.Unix()] = true
+	}
+
+	var added []models.Session
+	for _, session := range sessions {
+		if seen[session.StartTime.Unix()] {
+			continue
+		}
+		seen[session.StartTime.Unix()] = true
+		added = append(added, session)
+	}
+	if len(added) == 0 {
+		return nil, nil
+	}
+
+	return added, s.writeSessionsLocked(append(existing, added...), true)
+}
+
+// ImportNonOverlapping adds sessions to the history, skipping any whose time
+// overlaps a session already stored or one added before it. It suits data
+// from other trackers, where the same stretch of time may have been
+// recorded slightly differently. It returns the sessions that were added.
+func (s *Storage) ImportNonOverlapping(sessions []models.Session) ([]models.Session, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.refreshLocked(); err != nil {
+		return nil, err
+	}
+	existing := s.cache.snapshot()
+	taken := append([]models.Session(nil), existing...)
+
+	var added []models.Session
+	for _, session := range sessions {
+		if overlapsAny(session, taken) {
+			continue
+		}
+		taken = append(taken, session)
+		added = append(added, session)
+	}
+	if len(added) == 0 {
+		return nil, nil
+	}
+
+	return added, s.writeSessionsLocked(append(existing, added...), true)
+}
+
+func overlapsAny(session models.Session, others []models.Session) bool {
+	start, end := session.StartTime, sessionEnd(session)
+	for _, other := range others {
+		if start.Before(sessionEnd(other)) && other.StartTime.Before(end) {
+			return true
+		}
+	}
+	return false
+}
+
+// sessionEnd is when a session stopped, or would have if it is still
+// running.
+func sessionEnd(session models.Session) time.Time {
+	if !session.EndTime.IsZero() {
+		return session.EndTime
+	}
+	return session.StartTime.Add(time.Duration(session.ElapsedSeconds) * time.Second)
+}
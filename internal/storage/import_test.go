@@ -0,0 +1,59 @@
+package storage
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/adibhanna/focussessions/internal/models"
+)
+
+func TestImportSessions(t *testing.T) {
+	stored := testSession("a", testStart, 60)
+
+	tests := []struct {
+		name        string
+		incoming    []models.Session
+		overlapping bool // Use ImportNonOverlapping
+		added       []string
+	}{
+		{"same start skipped", []models.Session{testSession("x", testStart, 25)}, false, nil},
+		{"other start added", []models.Session{testSession("x", testStart.Add(30*time.Minute), 25)}, false, []string{"x"}},
+		{"same start twice in the file", []models.Session{testSession("x", testStart.Add(2*time.Hour), 25), testSession("y", testStart.Add(2*time.Hour), 50)}, false, []string{"x"}},
+		{"overlap skipped", []models.Session{testSession("x", testStart.Add(30*time.Minute), 60)}, true, nil},
+		{"touching added", []models.Session{testSession("x", testStart.Add(time.Hour), 30)}, true, []string{"x"}},
+		{"overlap within the file", []models.Session{testSession("x", testStart.Add(2*time.Hour), 60), testSession("y", testStart.Add(150*time.Minute), 60)}, true, []string{"x"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := newTestStorage(t)
+			if err := s.SaveSession(stored); err != nil {
+				t.Fatal(err)
+			}
+
+			run := s.ImportSessions
+			if tt.overlapping {
+				run = s.ImportNonOverlapping
+			}
+			added, err := run(tt.incoming)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var ids []string
+			for _, session := range added {
+				ids = append(ids, session.ID)
+			}
+			if !slices.Equal(ids, tt.added) {
+				t.Errorf("added %v, want %v", ids, tt.added)
+			}
+
+			// Importing the same file again adds nothing
+			if again, err := run(tt.incoming); err != nil || len(again) != 0 {
+				t.Errorf("importing again added %d, %v; want none", len(again), err)
+			}
+			if sessions, _ := s.GetAllSessions(); len(sessions) != 1+len(tt.added) {
+				t.Errorf("%d sessions stored, want %d", len(sessions), 1+len(tt.added))
+			}
+		})
+	}
+}
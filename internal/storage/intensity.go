@@ -0,0 +1,31 @@
+package storage
+
+import (
+	"math"
+
+	"github.com/adibhanna/focussessions/internal/models"
+)
+
+// intensityTotals returns the weighted focus minutes of the completed
+// sessions and their actual minutes per intensity.
+func (s *Storage) intensityTotals(sessions []models.Session) (int, map[string]int) {
+	s.mu.Lock()
+	config := models.Config{IntensityWeights: s.weights}
+	s.mu.Unlock()
+
+	weighted := 0.0
+	var byIntensity map[string]int
+	for _, session := range sessions {
+		if !session.Completed {
+			continue
+		}
+		minutes := session.ActualMinutes()
+		level := session.IntensityLevel()
+		weighted += float64(minutes) * config.IntensityWeight(level)
+		if byIntensity == nil {
+			byIntensity = make(map[string]int)
+		}
+		byIntensity[level] += minutes
+	}
+	return int(math.Round(weighted)), byIntensity
+}
@@ -0,0 +1,74 @@
+package storage
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+func (s *Storage) journalFile() string {
+	return filepath.Join(s.dataDir, "journal.json")
+}
+
+// readJournal returns every journal note keyed by date (YYYY-MM-DD).
+func (s *Storage) readJournal() (map[string]string, error) {
+	notes := make(map[string]string)
+	data, err := os.ReadFile(s.journalFile())
+	if os.IsNotExist(err) {
+		return notes, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(data, &notes); err != nil {
+		return nil, err
+	}
+	return notes, nil
+}
+
+// GetJournal returns the journal note for date, empty if none was written.
+func (s *Storage) GetJournal(date string) (string, error) {
+	notes, err := s.readJournal()
+	if err != nil {
+		return "", err
+	}
+	return notes[date], nil
+}
+
+// SaveJournal stores the journal note for date. An empty note removes it.
+func (s *Storage) SaveJournal(date, note string) error {
+	notes, err := s.readJournal()
+	if err != nil {
+		return err
+	}
+
+	if note = strings.TrimSpace(note); note == "" {
+		delete(notes, date)
+	} else {
+		notes[date] = note
+	}
+
+	data, err := json.MarshalIndent(notes, "", "  ")
+	if err != nil {
+		return err
+	}
+	return s.writeFile(s.journalFile(), data, true)
+}
+
+// GetJournalRange returns the journal notes of the days from start up to
+// end, keyed by date. A zero start or end leaves that side open.
+func (s *Storage) GetJournalRange(start, end time.Time) (map[string]string, error) {
+	notes, err := s.readJournal()
+	if err != nil {
+		return nil, err
+	}
+	for date := range notes {
+		day, err := time.ParseInLocation("2006-01-02", date, time.Local)
+		if err != nil || (!start.IsZero() && day.Before(start)) || (!end.IsZero() && !day.Before(end)) {
+			delete(notes, date)
+		}
+	}
+	return notes, nil
+}
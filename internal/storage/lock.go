@@ -0,0 +1,103 @@
+package storage
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"syscall"
+)
+
+// lockFile marks the data directory as in use by a running dashboard. It
+// is hidden so bundles leave it out.
+const lockFile = ".instance.lock"
+
+// InstanceLock is held by the dashboard running on a data directory, so a
+// second one on the same machine doesn't also update the sessions.
+type InstanceLock struct {
+	path string
+}
+
+// LockedError is returned when another dashboard is running on the data
+// directory on this machine.
+type LockedError struct {
+	PID int
+}
+
+func (e *LockedError) Error() string {
+	return fmt.Sprintf("focussessions is already running in another terminal (pid %d); switch to it or quit it first", e.PID)
+}
+
+type lockOwner struct {
+	PID  int    `json:"pid"`
+	Host string `json:"host"`
+}
+
+// Lock claims the data directory for this process. It fails with a
+// LockedError while another live process on this machine holds it. A lock
+// left by a process that has exited, or by another machine sharing the
+// directory, is taken over; sessions moving between machines are handled
+// by the session handoff instead.
+func (s *Storage) Lock() (*InstanceLock, error) {
+	path := filepath.Join(s.dataDir, lockFile)
+	host, _ := os.Hostname()
+	data, err := json.Marshal(lockOwner{PID: os.Getpid(), Host: host})
+	if err != nil {
+		return nil, err
+	}
+
+	for attempt := 0; ; attempt++ {
+		f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0644)
+		if err == nil {
+			_, err = f.Write(data)
+			if closeErr := f.Close(); err == nil {
+				err = closeErr
+			}
+			if err != nil {
+				return nil, err
+			}
+			return &InstanceLock{path: path}, nil
+		}
+		if !errors.Is(err, os.ErrExist) || attempt > 0 {
+			return nil, err
+		}
+
+		var owner lockOwner
+		if existing, err := os.ReadFile(path); err == nil && json.Unmarshal(existing, &owner) == nil {
+			if owner.Host == host && owner.PID != os.Getpid() && alive(owner.PID) {
+				return nil, &LockedError{PID: owner.PID}
+			}
+		}
+		if err := os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return nil, err
+		}
+	}
+}
+
+// Release gives up the lock. Releasing a nil lock does nothing.
+func (l *InstanceLock) Release() error {
+	if l == nil {
+		return nil
+	}
+	if err := os.Remove(l.path); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+	return nil
+}
+
+// alive reports whether a process with pid is running.
+func alive(pid int) bool {
+	proc, err := os.FindProcess(pid)
+	if err != nil {
+		return false
+	}
+	if runtime.GOOS == "windows" {
+		// FindProcess fails there for processes that don't exist
+		proc.Release()
+		return true
+	}
+	err = proc.Signal(syscall.Signal(0))
+	return err == nil || errors.Is(err, syscall.EPERM)
+}
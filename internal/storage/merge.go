@@ -0,0 +1,45 @@
+package storage
+
+import (
+	"github.com/adibhanna/focussessions/internal/models"
+)
+
+// MergeSessions folds sessions from another machine into the history. A
+// session whose ID isn't stored yet is added; when both sides have the same
+// ID, the copy with the most recent EndTime wins. It returns how many
+// sessions were added and how many stored ones were replaced.
+func (s *Storage) MergeSessions(sessions []models.Session) (added, updated int, err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.refreshLocked(); err != nil {
+		return 0, 0, err
+	}
+	merged := s.cache.snapshot()
+
+	index := make(map[string]int, len(merged))
+	for i, session := range merged {
+		index[session.ID] = i
+	}
+
+	for _, session := range sessions {
+		if session.ID == "" {
+			continue
+		}
+		i, ok := index[session.ID]
+		switch {
+		case !ok:
+			index[session.ID] = len(merged)
+			merged = append(merged, session)
+			added++
+		case moreRecent(session, merged[i]):
+			merged[i] = session
+			updated++
+		}
+	}
+	if added == 0 && updated == 0 {
+		return 0, 0, nil
+	}
+
+	return added, updated, s.writeSessionsLocked(merged, true)
+}
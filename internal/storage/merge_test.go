@@ -0,0 +1,98 @@
+package storage
+
+import (
+	"testing"
+	"time"
+
+	"github.com/adibhanna/focussessions/internal/models"
+)
+
+// testSession returns a completed session of minutes starting at start,
+// with its date fields filled in.
+func testSession(id string, start time.Time, minutes int) models.Session {
+	start = start.Local()
+	_, week := start.ISOWeek()
+	return models.Session{
+		ID:             id,
+		StartTime:      start,
+		EndTime:        start.Add(time.Duration(minutes) * time.Minute),
+		Duration:       minutes,
+		ElapsedSeconds: minutes * 60,
+		Completed:      true,
+		Date:           start.Format("2006-01-02"),
+		Week:           week,
+		Month:          start.Format("2006-01"),
+		Year:           start.Year(),
+	}
+}
+
+var testStart = time.Date(2025, time.March, 12, 9, 0, 0, 0, time.Local)
+
+func TestMergeSessions(t *testing.T) {
+	stored := testSession("a", testStart, 25)
+	later := stored
+	later.EndTime = later.EndTime.Add(10 * time.Minute)
+	longer := stored
+	longer.ElapsedSeconds += 60
+
+	tests := []struct {
+		name           string
+		incoming       []models.Session
+		added, updated int
+		want           map[string]models.Session
+	}{
+		{"new ID", []models.Session{testSession("b", testStart.Add(time.Hour), 25)}, 1, 0,
+			map[string]models.Session{"a": stored, "b": testSession("b", testStart.Add(time.Hour), 25)}},
+		{"same copy", []models.Session{stored}, 0, 0, map[string]models.Session{"a": stored}},
+		{"later end wins", []models.Session{later}, 0, 1, map[string]models.Session{"a": later}},
+		{"earlier end loses", []models.Session{testSession("a", testStart, 20)}, 0, 0, map[string]models.Session{"a": stored}},
+		{"same end, more elapsed wins", []models.Session{longer}, 0, 1, map[string]models.Session{"a": longer}},
+		{"no ID skipped", []models.Session{testSession("", testStart.Add(time.Hour), 25)}, 0, 0, map[string]models.Session{"a": stored}},
+		{"duplicates in the file", []models.Session{testSession("b", testStart.Add(time.Hour), 20), testSession("b", testStart.Add(time.Hour), 25)}, 1, 1,
+			map[string]models.Session{"a": stored, "b": testSession("b", testStart.Add(time.Hour), 25)}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := newTestStorage(t)
+			if err := s.SaveSession(stored); err != nil {
+				t.Fatal(err)
+			}
+
+			added, updated, err := s.MergeSessions(tt.incoming)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if added != tt.added || updated != tt.updated {
+				t.Errorf("added %d, updated %d; want %d, %d", added, updated, tt.added, tt.updated)
+			}
+			checkSessions(t, s, tt.want)
+
+			// Merging the same file again changes nothing
+			if added, updated, err := s.MergeSessions(tt.incoming); err != nil || added != 0 || updated != 0 {
+				t.Errorf("merging again: added %d, updated %d, %v; want nothing", added, updated, err)
+			}
+		})
+	}
+}
+
+// checkSessions fails t unless s stores exactly the sessions in want, by ID.
+func checkSessions(t *testing.T, s *Storage, want map[string]models.Session) {
+	t.Helper()
+	sessions, err := s.GetAllSessions()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(sessions) != len(want) {
+		t.Errorf("%d sessions stored, want %d", len(sessions), len(want))
+	}
+	for _, got := range sessions {
+		w, ok := want[got.ID]
+		if !ok {
+			t.Errorf("unexpected session %q", got.ID)
+			continue
+		}
+		if !got.EndTime.Equal(w.EndTime) || got.ElapsedSeconds != w.ElapsedSeconds {
+			t.Errorf("session %q ends %v after %ds, want %v after %ds", got.ID, got.EndTime, got.ElapsedSeconds, w.EndTime, w.ElapsedSeconds)
+		}
+	}
+}
@@ -0,0 +1,33 @@
+package storage
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+func (s *Storage) messagesFile() string {
+	return filepath.Join(s.dataDir, "messages.txt")
+}
+
+// GetMessages returns the motivational messages listed in messages.txt, one
+// per line. Blank lines and lines starting with # are skipped.
+func (s *Storage) GetMessages() ([]string, error) {
+	data, err := os.ReadFile(s.messagesFile())
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var messages []string
+	for _, line := range strings.Split(string(data), "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		messages = append(messages, line)
+	}
+	return messages, nil
+}
@@ -0,0 +1,60 @@
+package storage
+
+import (
+	"time"
+
+	"github.com/adibhanna/focussessions/internal/models"
+)
+
+// dstTolerance is how far a recorded elapsed time may be from the
+// wall-clock-skewed span and still be treated as a DST artifact.
+const dstTolerance = 90 * time.Second
+
+// normalizeSessions repairs sessions recorded with elapsed time derived
+// from local wall-clock subtraction. When such a session crosses a DST
+// transition its elapsed time is off by the change in UTC offset: an hour
+// too long across spring-forward, an hour too short across fall-back.
+// Sessions are fixed in memory; the corrected values are written back with
+// the next save.
+func normalizeSessions(sessions []models.Session) {
+	for i := range sessions {
+		normalizeSession(&sessions[i], time.Local)
+	}
+}
+
+func normalizeSession(session *models.Session, loc *time.Location) {
+	if session.Active || session.StartTime.IsZero() || session.EndTime.IsZero() || session.ElapsedSeconds == 0 {
+		return
+	}
+
+	span := session.EndTime.Sub(session.StartTime)
+	if span <= 0 {
+		return
+	}
+
+	shift := offsetChange(session.StartTime, session.EndTime, loc)
+	if shift == 0 {
+		return
+	}
+
+	elapsed := time.Duration(session.ElapsedSeconds) * time.Second
+	skewed := span + shift
+	diff := elapsed - skewed
+	if diff < 0 {
+		diff = -diff
+	}
+
+	// Only correct values that match the skewed span, whichever way the
+	// clocks moved.
+	if diff <= dstTolerance {
+		session.ElapsedSeconds = int((elapsed - shift) / time.Second)
+	}
+}
+
+// offsetChange returns how much the UTC offset in loc changed between start
+// and end, e.g. -1h when clocks were set back during the session.
+func offsetChange(start, end time.Time, loc *time.Location) time.Duration {
+	_, startOffset := start.In(loc).Zone()
+	_, endOffset := end.In(loc).Zone()
+	return time.Duration(endOffset-startOffset) * time.Second
+}
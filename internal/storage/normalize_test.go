@@ -0,0 +1,43 @@
+package storage
+
+import (
+	"testing"
+	"time"
+
+	"github.com/adibhanna/focussessions/internal/models"
+)
+
+func TestNormalizeSession(t *testing.T) {
+	t.Parallel()
+	loc, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skipf("no time zone data: %v", err)
+	}
+
+	tests := []struct {
+		name    string
+		start   time.Time
+		elapsed int
+		want    int
+	}{
+		// Clocks jump from 02:00 to 03:00: a two-hour session reads as three.
+		{"spring forward", time.Date(2026, 3, 8, 1, 0, 0, 0, loc), 3 * 3600, 2 * 3600},
+		// Clocks fall back from 02:00 to 01:00: a two-hour session reads as one.
+		{"fall back", time.Date(2026, 11, 1, 0, 30, 0, 0, loc), 3600, 2 * 3600},
+		{"paused", time.Date(2026, 11, 1, 0, 30, 0, 0, loc), 1800, 1800},
+		{"no transition", time.Date(2026, 6, 1, 9, 0, 0, 0, loc), 3 * 3600, 3 * 3600},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			session := models.Session{
+				StartTime:      tt.start,
+				EndTime:        tt.start.Add(2 * time.Hour),
+				ElapsedSeconds: tt.elapsed,
+			}
+			normalizeSession(&session, loc)
+			if session.ElapsedSeconds != tt.want {
+				t.Errorf("elapsed %ds, want %ds", session.ElapsedSeconds, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,83 @@
+package storage
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+func (s *Storage) offDaysFile() string {
+	return filepath.Join(s.dataDir, "offdays.json")
+}
+
+// GetOffDays returns the days marked off, such as holidays and vacations,
+// keyed by date (YYYY-MM-DD) with the reason given, which may be empty.
+// Off days don't break streaks and are left out of averages.
+func (s *Storage) GetOffDays() (map[string]string, error) {
+	days := make(map[string]string)
+	data, err := os.ReadFile(s.offDaysFile())
+	if os.IsNotExist(err) {
+		return days, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(data, &days); err != nil {
+		return nil, err
+	}
+	return days, nil
+}
+
+// GetOffDaysIn reports, for each day from from's day up to but not
+// including to's day, whether it is marked off. It lines up with
+// GetDailyMinutes.
+func (s *Storage) GetOffDaysIn(from, to time.Time) ([]bool, error) {
+	days, err := s.GetOffDays()
+	if err != nil {
+		return nil, err
+	}
+
+	from = time.Date(from.Year(), from.Month(), from.Day(), 0, 0, 0, 0, from.Location())
+	to = time.Date(to.Year(), to.Month(), to.Day(), 0, 0, 0, 0, to.Location())
+	var off []bool
+	for d := from; d.Before(to); d = d.AddDate(0, 0, 1) {
+		_, ok := days[d.Format("2006-01-02")]
+		off = append(off, ok)
+	}
+	return off, nil
+}
+
+// SetOffDays marks the days from first through last as off, with an
+// optional reason such as "vacation".
+func (s *Storage) SetOffDays(first, last time.Time, reason string) error {
+	reason = strings.TrimSpace(reason)
+	return s.updateOffDays(first, last, func(days map[string]string, date string) {
+		days[date] = reason
+	})
+}
+
+// ClearOffDays removes the off mark from the days from first through last.
+func (s *Storage) ClearOffDays(first, last time.Time) error {
+	return s.updateOffDays(first, last, func(days map[string]string, date string) {
+		delete(days, date)
+	})
+}
+
+func (s *Storage) updateOffDays(first, last time.Time, update func(days map[string]string, date string)) error {
+	days, err := s.GetOffDays()
+	if err != nil {
+		return err
+	}
+	first = time.Date(first.Year(), first.Month(), first.Day(), 0, 0, 0, 0, first.Location())
+	for d := first; !d.After(last); d = d.AddDate(0, 0, 1) {
+		update(days, d.Format("2006-01-02"))
+	}
+
+	data, err := json.MarshalIndent(days, "", "  ")
+	if err != nil {
+		return err
+	}
+	return s.writeFile(s.offDaysFile(), data, true)
+}
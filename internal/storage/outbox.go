@@ -0,0 +1,103 @@
+package storage
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/adibhanna/focussessions/internal/models"
+)
+
+func (s *Storage) outboxFile() string {
+	return filepath.Join(s.dataDir, "outbox.json")
+}
+
+// GetOutbox returns the queued integration events, oldest first.
+func (s *Storage) GetOutbox() ([]models.OutboxEntry, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.readOutboxLocked()
+}
+
+func (s *Storage) readOutboxLocked() ([]models.OutboxEntry, error) {
+	var entries []models.OutboxEntry
+	data, err := os.ReadFile(s.outboxFile())
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(data, &entries); err != nil {
+		return nil, err
+	}
+	return entries, nil
+}
+
+func (s *Storage) writeOutboxLocked(entries []models.OutboxEntry) error {
+	if len(entries) == 0 {
+		if err := os.Remove(s.outboxFile()); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+		return nil
+	}
+	data, err := json.MarshalIndent(entries, "", "  ")
+	if err != nil {
+		return err
+	}
+	return s.writeFile(s.outboxFile(), data, true)
+}
+
+// updateOutbox applies fn to the queue under the storage lock, re-reading
+// the file first so entries queued by other processes are kept.
+func (s *Storage) updateOutbox(fn func([]models.OutboxEntry) []models.OutboxEntry) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	entries, err := s.readOutboxLocked()
+	if err != nil {
+		return err
+	}
+	return s.writeOutboxLocked(fn(entries))
+}
+
+// EnqueueOutbox adds entries to the end of the queue.
+func (s *Storage) EnqueueOutbox(entries ...models.OutboxEntry) error {
+	return s.updateOutbox(func(queue []models.OutboxEntry) []models.OutboxEntry {
+		return append(queue, entries...)
+	})
+}
+
+// RemoveOutbox drops the entry with id, once it was delivered.
+func (s *Storage) RemoveOutbox(id string) error {
+	return s.updateOutbox(func(queue []models.OutboxEntry) []models.OutboxEntry {
+		kept := queue[:0]
+		for _, e := range queue {
+			if e.ID != id {
+				kept = append(kept, e)
+			}
+		}
+		return kept
+	})
+}
+
+// RetryOutbox records a failed delivery of the entry with id and when to
+// try it next.
+func (s *Storage) RetryOutbox(id string, deliveryErr error, next time.Time) error {
+	return s.updateOutbox(func(queue []models.OutboxEntry) []models.OutboxEntry {
+		for i := range queue {
+			if queue[i].ID == id {
+				queue[i].Attempts++
+				queue[i].LastError = deliveryErr.Error()
+				queue[i].NextAttempt = next
+			}
+		}
+		return queue
+	})
+}
+
+// ClearOutbox drops every queued entry.
+func (s *Storage) ClearOutbox() error {
+	return s.updateOutbox(func([]models.OutboxEntry) []models.OutboxEntry { return nil })
+}
@@ -0,0 +1,63 @@
+package storage
+
+import (
+	"time"
+
+	"github.com/adibhanna/focussessions/internal/models"
+)
+
+// paceWeeks is how many past occurrences of the weekday are averaged.
+const paceWeeks = 8
+
+// GetPaceStats compares the sessions completed today up to now with the
+// average completed by the same time on the same weekday over the last
+// few weeks. Weekdays before the first recorded session are ignored so
+// that new users aren't compared against empty history.
+func (s *Storage) GetPaceStats(now time.Time) (models.PaceStats, error) {
+	sessions, err := s.GetAllSessions()
+	if err != nil {
+		return models.PaceStats{}, err
+	}
+
+	pace := models.PaceStats{At: now}
+	if len(sessions) == 0 {
+		return pace, nil
+	}
+
+	first := now
+	for _, session := range sessions {
+		if !session.StartTime.IsZero() && session.StartTime.Before(first) {
+			first = session.StartTime
+		}
+	}
+	firstDay := time.Date(first.Year(), first.Month(), first.Day(), 0, 0, 0, 0, now.Location())
+
+	completedBy := func(day time.Time) int {
+		date := day.Format("2006-01-02")
+		cutoff := time.Date(day.Year(), day.Month(), day.Day(), now.Hour(), now.Minute(), now.Second(), 0, now.Location())
+		count := 0
+		for _, session := range sessions {
+			if session.Completed && session.Date == date && !session.EndTime.After(cutoff) {
+				count++
+			}
+		}
+		return count
+	}
+
+	pace.Today = completedBy(now)
+
+	total := 0
+	for week := 1; week <= paceWeeks; week++ {
+		day := now.AddDate(0, 0, -7*week)
+		if day.Before(firstDay) {
+			break
+		}
+		total += completedBy(day)
+		pace.Samples++
+	}
+	if pace.Samples > 0 {
+		pace.Typical = float64(total) / float64(pace.Samples)
+	}
+
+	return pace, nil
+}
@@ -0,0 +1,110 @@
+package storage
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+
+	"github.com/adibhanna/focussessions/internal/models"
+)
+
+func (s *Storage) plansFile() string {
+	return filepath.Join(s.dataDir, "plans.json")
+}
+
+func (s *Storage) readPlans() (map[string]models.DayPlan, error) {
+	plans := make(map[string]models.DayPlan)
+	data, err := os.ReadFile(s.plansFile())
+	if os.IsNotExist(err) {
+		return plans, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(data, &plans); err != nil {
+		return nil, err
+	}
+	return plans, nil
+}
+
+// GetDayPlan returns the plan for date, which is empty if none was made.
+func (s *Storage) GetDayPlan(date string) (models.DayPlan, error) {
+	plans, err := s.readPlans()
+	if err != nil {
+		return models.DayPlan{Date: date}, err
+	}
+	plan, ok := plans[date]
+	if !ok {
+		plan = models.DayPlan{Date: date}
+	}
+	return plan, nil
+}
+
+// SaveDayPlan stores plan, replacing any earlier plan for the same day. A
+// plan without blocks is removed.
+func (s *Storage) SaveDayPlan(plan models.DayPlan) error {
+	plans, err := s.readPlans()
+	if err != nil {
+		return err
+	}
+
+	if len(plan.Blocks) == 0 {
+		delete(plans, plan.Date)
+	} else {
+		plans[plan.Date] = plan
+	}
+
+	data, err := json.MarshalIndent(plans, "", "  ")
+	if err != nil {
+		return err
+	}
+	return s.writeFile(s.plansFile(), data, true)
+}
+
+// GetPlanProgress matches the completed sessions of date against its plan.
+// Each session fills the first open block with the same tag, falling back
+// to the first open untagged block.
+func (s *Storage) GetPlanProgress(date string) (models.PlanProgress, error) {
+	plan, err := s.GetDayPlan(date)
+	if err != nil {
+		return models.PlanProgress{}, err
+	}
+	sessions, err := s.GetSessionsByDate(date)
+	if err != nil {
+		return models.PlanProgress{}, err
+	}
+
+	progress := models.PlanProgress{
+		Plan: plan,
+		Done: make([]bool, len(plan.Blocks)),
+	}
+	for _, block := range plan.Blocks {
+		progress.PlannedMinutes += block.Duration
+	}
+
+	for _, session := range sessions {
+		if !session.Completed {
+			continue
+		}
+		progress.ActualMinutes += session.ActualMinutes()
+
+		match := -1
+		for i, block := range plan.Blocks {
+			if progress.Done[i] {
+				continue
+			}
+			if block.Tag == session.Tag && block.Tag != "" {
+				match = i
+				break
+			}
+			if block.Tag == "" && match == -1 {
+				match = i
+			}
+		}
+		if match >= 0 {
+			progress.Done[match] = true
+		}
+	}
+
+	return progress, nil
+}
@@ -0,0 +1,109 @@
+package storage
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// profilesDir holds one data directory per named profile, inside the
+// default profile's data directory.
+const profilesDir = "profiles"
+
+// DefaultProfile names the profile kept at the top of the data directory.
+const DefaultProfile = "default"
+
+// ValidateProfile checks that name can be used as a profile directory.
+func ValidateProfile(name string) error {
+	if name == "" || name == DefaultProfile {
+		return nil
+	}
+	if strings.ContainsAny(name, `/\`) || strings.HasPrefix(name, ".") {
+		return fmt.Errorf("invalid profile name %q", name)
+	}
+	return nil
+}
+
+// NewProfile returns the Storage of the named profile, which has its own
+// config and sessions in a directory under the data directory. The empty
+// name and DefaultProfile are the default profile.
+func NewProfile(name string) (*Storage, error) {
+	if name == "" || name == DefaultProfile {
+		return New()
+	}
+	if err := ValidateProfile(name); err != nil {
+		return nil, err
+	}
+
+	homeDir, err := os.UserHomeDir()
+	if err != nil {
+		return nil, err
+	}
+	s, err := Open(filepath.Join(homeDir, ".focussessions", profilesDir, name))
+	if err != nil {
+		return nil, err
+	}
+	s.profile = name
+	return s, nil
+}
+
+// Profile returns the name of the profile s stores, DefaultProfile for the
+// default one.
+func (s *Storage) Profile() string {
+	if s.profile == "" {
+		return DefaultProfile
+	}
+	return s.profile
+}
+
+// Profiles lists every profile, the default one first.
+func Profiles() ([]string, error) {
+	homeDir, err := os.UserHomeDir()
+	if err != nil {
+		return nil, err
+	}
+
+	entries, err := os.ReadDir(filepath.Join(homeDir, ".focussessions", profilesDir))
+	if err != nil && !os.IsNotExist(err) {
+		return nil, err
+	}
+
+	var names []string
+	for _, entry := range entries {
+		if entry.IsDir() && entry.Name() != DefaultProfile && ValidateProfile(entry.Name()) == nil {
+			names = append(names, entry.Name())
+		}
+	}
+	sort.Strings(names)
+	return append([]string{DefaultProfile}, names...), nil
+}
+
+// HooksDir returns the directory holding the profile's hook scripts.
+func (s *Storage) HooksDir() string {
+	return filepath.Join(s.dataDir, "hooks")
+}
+
+// PluginsDir returns the directory holding the profile's plugins.
+func (s *Storage) PluginsDir() string {
+	return filepath.Join(s.dataDir, "plugins")
+}
+
+// ScriptsDir returns the directory holding the profile's Lua scripts.
+func (s *Storage) ScriptsDir() string {
+	return filepath.Join(s.dataDir, "scripts")
+}
+
+// DaemonLogPath returns the file the profile's background service writes
+// its output to, under launchd.
+func (s *Storage) DaemonLogPath() string {
+	return filepath.Join(s.dataDir, "daemon.log")
+}
+
+// BlockStatePath returns the file marking that the profile's block
+// command is in effect. It is hidden like .instance.lock, so bundles leave
+// out what only holds on this machine.
+func (s *Storage) BlockStatePath() string {
+	return filepath.Join(s.dataDir, ".blocking")
+}
@@ -0,0 +1,64 @@
+package storage
+
+import (
+	"sort"
+	"time"
+
+	"github.com/adibhanna/focussessions/internal/models"
+)
+
+// GetRangeStats aggregates the sessions started from first's day through
+// last's day, for ranges that aren't a calendar day, week, month or year.
+// Unlike those it isn't cached, as ranges are rarely asked for twice.
+func (s *Storage) GetRangeStats(first, last time.Time) (models.RangeStats, error) {
+	from := time.Date(first.Year(), first.Month(), first.Day(), 0, 0, 0, 0, first.Location())
+	to := time.Date(last.Year(), last.Month(), last.Day(), 0, 0, 0, 0, last.Location()).AddDate(0, 0, 1)
+
+	stats := models.RangeStats{First: from, Last: to.AddDate(0, 0, -1)}
+	for d := from; d.Before(to); d = d.AddDate(0, 0, 1) {
+		stats.Days++
+	}
+
+	all, err := s.GetSessionsInRange(from, to)
+	if err != nil {
+		return stats, err
+	}
+	sessions, falseStarts := s.withoutFalseStarts(s.matchingFilter(all))
+
+	byDate := make(map[string]*models.DayStats)
+	for _, session := range sessions {
+		if !session.Completed {
+			continue
+		}
+		minutes := session.ActualMinutes()
+		stats.SessionsCount++
+		stats.TotalMinutes += minutes
+		stats.Distractions += session.Distractions
+
+		day, ok := byDate[session.Date]
+		if !ok {
+			day = &models.DayStats{Date: session.Date}
+			byDate[session.Date] = day
+		}
+		day.SessionsCount++
+		day.TotalMinutes += minutes
+		day.Distractions += session.Distractions
+		day.Sessions = append(day.Sessions, session)
+	}
+
+	stats.AverageFocus = models.AverageFocus(sessions)
+	stats.FalseStarts = falseStarts
+	stats.PartialSessions, stats.PartialMinutes = s.partialCredit(sessions)
+	stats.TotalMinutes += stats.PartialMinutes
+	stats.WeightedMinutes, stats.IntensityMinutes = s.intensityTotals(sessions)
+
+	for _, day := range byDate {
+		day.AverageFocus = models.AverageFocus(day.Sessions)
+		stats.DailyStats = append(stats.DailyStats, *day)
+	}
+	sort.Slice(stats.DailyStats, func(i, j int) bool {
+		return stats.DailyStats[i].Date < stats.DailyStats[j].Date
+	})
+	stats.ActiveDays = len(stats.DailyStats)
+	return stats, nil
+}
@@ -0,0 +1,31 @@
+package storage
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+func (s *Storage) reportSentFile() string {
+	return filepath.Join(s.dataDir, "report_sent")
+}
+
+// GetReportSent returns when the weekly report was last queued for
+// sending, the zero time if it never was.
+func (s *Storage) GetReportSent() (time.Time, error) {
+	data, err := os.ReadFile(s.reportSentFile())
+	if os.IsNotExist(err) {
+		return time.Time{}, nil
+	}
+	if err != nil {
+		return time.Time{}, err
+	}
+	return time.Parse(time.RFC3339, strings.TrimSpace(string(data)))
+}
+
+// SetReportSent records that the weekly report was queued at at, so it
+// goes out once a week even across daemon restarts.
+func (s *Storage) SetReportSent(at time.Time) error {
+	return s.writeFile(s.reportSentFile(), []byte(at.Format(time.RFC3339)+"\n"), true)
+}
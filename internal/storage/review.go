@@ -0,0 +1,64 @@
+package storage
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+func (s *Storage) reviewsFile() string {
+	return filepath.Join(s.dataDir, "reviews.json")
+}
+
+func reviewKey(year, week int) string {
+	return fmt.Sprintf("%d-W%02d", year, week)
+}
+
+func (s *Storage) readReviews() (map[string]string, error) {
+	reviews := make(map[string]string)
+	data, err := os.ReadFile(s.reviewsFile())
+	if os.IsNotExist(err) {
+		return reviews, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(data, &reviews); err != nil {
+		return nil, err
+	}
+	return reviews, nil
+}
+
+// GetWeekReview returns the retrospective note written for a week, empty
+// if the week wasn't reviewed.
+func (s *Storage) GetWeekReview(year, week int) (string, error) {
+	reviews, err := s.readReviews()
+	if err != nil {
+		return "", err
+	}
+	return reviews[reviewKey(year, week)], nil
+}
+
+// SaveWeekReview stores the retrospective note for a week. An empty note
+// removes it.
+func (s *Storage) SaveWeekReview(year, week int, note string) error {
+	reviews, err := s.readReviews()
+	if err != nil {
+		return err
+	}
+
+	key := reviewKey(year, week)
+	if note = strings.TrimSpace(note); note == "" {
+		delete(reviews, key)
+	} else {
+		reviews[key] = note
+	}
+
+	data, err := json.MarshalIndent(reviews, "", "  ")
+	if err != nil {
+		return err
+	}
+	return s.writeFile(s.reviewsFile(), data, true)
+}
@@ -0,0 +1,77 @@
+package storage
+
+import (
+	"time"
+
+	"github.com/adibhanna/focussessions/internal/models"
+)
+
+// CleanupStale settles the active session when its planned end is more
+// than the configured grace period ago, completing or cancelling it per
+// config.StalePolicy, so an old timer isn't resumed. It returns the settled
+// session, or nil when there was nothing to do. Sessions another machine
+// still sends heartbeats for are left alone.
+func (s *Storage) CleanupStale(config models.Config, now time.Time) (*models.Session, error) {
+	policy := config.StalePolicy()
+	if policy == models.StaleResume {
+		return nil, nil
+	}
+
+	session, err := s.GetActiveSession()
+	if err != nil || session == nil {
+		return nil, err
+	}
+	if !now.After(config.StaleAfter(*session)) {
+		return nil, nil
+	}
+	if !session.Paused && !session.HeartbeatAt.IsZero() && now.Sub(session.HeartbeatAt) <= HandoffTimeout {
+		return nil, nil
+	}
+
+	planned := session.Duration * 60
+	elapsed := min(session.ElapsedAt(now), planned)
+	switch {
+	case session.Paused && !session.HeartbeatAt.IsZero():
+		session.EndTime = session.HeartbeatAt
+	case session.Paused:
+		session.EndTime = session.StartTime.Add(time.Duration(elapsed) * time.Second)
+	default:
+		// A running session ended when its time was up
+		session.EndTime = now.Add(-time.Duration(session.ElapsedAt(now)-elapsed) * time.Second)
+	}
+
+	session.ElapsedSeconds = elapsed
+	session.Completed = policy == models.StaleComplete
+	session.Active = false
+	session.Paused = false
+	if err := s.SaveSession(*session); err != nil {
+		return nil, err
+	}
+	return session, nil
+}
+
+// ExpirePaused abandons the active session when it is paused and has used
+// up its pause budget or stayed paused for longer than config.PauseExpiry
+// hours. It returns the abandoned session and the reason, or nil when there
+// was nothing to do.
+func (s *Storage) ExpirePaused(config models.Config, now time.Time) (*models.Session, string, error) {
+	session, err := s.GetActiveSession()
+	if err != nil || session == nil || !session.Paused {
+		return nil, "", err
+	}
+	reason := config.PauseExceeded(*session, now)
+	if reason == "" {
+		return nil, "", nil
+	}
+
+	deadline, _ := config.PauseDeadline(*session)
+	session.Unpause(deadline)
+	session.EndTime = deadline
+	session.Active = false
+	session.Completed = false
+	session.Abandoned = true
+	if err := s.SaveSession(*session); err != nil {
+		return nil, "", err
+	}
+	return session, reason, nil
+}
@@ -3,8 +3,12 @@ package storage
 import (
 	"encoding/json"
 	"fmt"
+	"maps"
 	"os"
 	"path/filepath"
+	"runtime"
+	"sort"
+	"sync"
 	"time"
 
 	"github.com/adibhanna/focussessions/internal/models"
@@ -12,6 +16,17 @@ import (
 
 type Storage struct {
 	dataDir string
+	profile string // named profile, empty for the default one
+	fsync   bool   // fsync critical writes, mirrors Config.FsyncCriticalWrites
+
+	weekStart  time.Weekday       // first day of the week, mirrors Config.WeekStartDay
+	weights    map[string]float64 // intensity weights, mirrors Config.IntensityWeights
+	minMinutes int                // shortest session counted in stats, mirrors Config.MinSessionMinutes
+	partial    int                // shortest stopped session credited, mirrors Config.PartialCredit
+	filter     models.StatsFilter // sessions counted in stats, see SetStatsFilter
+
+	mu    sync.Mutex
+	cache sessionCache
 }
 
 func New() (*Storage, error) {
@@ -20,12 +35,28 @@ func New() (*Storage, error) {
 		return nil, err
 	}
 
-	dataDir := filepath.Join(homeDir, ".focussessions")
+	return Open(filepath.Join(homeDir, ".focussessions"))
+}
+
+// Open returns a Storage reading and writing the data directory dataDir,
+// creating it when missing.
+func Open(dataDir string) (*Storage, error) {
 	if err := os.MkdirAll(dataDir, 0755); err != nil {
 		return nil, err
 	}
 
-	return &Storage{dataDir: dataDir}, nil
+	s := &Storage{dataDir: dataDir}
+	// Config files written before it held credentials were readable by all
+	if info, err := os.Stat(s.configFile()); err == nil && info.Mode().Perm()&0077 != 0 {
+		os.Chmod(s.configFile(), 0600)
+	}
+	s.cache.reset()
+	s.applyConfig(models.DefaultConfig())
+	if config, err := s.readConfig(); err == nil {
+		s.applyConfig(config)
+	}
+
+	return s, nil
 }
 
 func (s *Storage) sessionsFile() string {
@@ -36,11 +67,99 @@ func (s *Storage) configFile() string {
 	return filepath.Join(s.dataDir, "config.json")
 }
 
+// writeFile replaces the file at path with data. It writes a temporary
+// file next to it and renames it over path, so a crash mid-write leaves
+// either the old contents or the new, never a truncated file. When durable
+// is set and fsync is enabled, the file and then its directory are flushed
+// to stable storage before returning, so the rename survives a power loss.
+func (s *Storage) writeFile(path string, data []byte, durable bool) error {
+	dir := filepath.Dir(path)
+	// Hidden, so bundles and resets skip one left behind by a crash
+	f, err := os.CreateTemp(dir, "."+filepath.Base(path)+".*.tmp")
+	if err != nil {
+		return err
+	}
+	tmp := f.Name()
+	fail := func(err error) error {
+		f.Close()
+		os.Remove(tmp)
+		return err
+	}
+
+	if _, err := f.Write(data); err != nil {
+		return fail(err)
+	}
+	if err := f.Chmod(s.fileMode(path)); err != nil {
+		return fail(err)
+	}
+	sync := durable && s.fsync
+	if sync {
+		if err := f.Sync(); err != nil {
+			return fail(err)
+		}
+	}
+	if err := f.Close(); err != nil {
+		os.Remove(tmp)
+		return err
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		os.Remove(tmp)
+		return err
+	}
+
+	if sync {
+		return syncDir(dir)
+	}
+	return nil
+}
+
+// fileMode returns the permissions path is written with. The config holds
+// the passwords and tokens of the integrations, so only the user may read
+// it; the other files are readable by all like before.
+func (s *Storage) fileMode(path string) os.FileMode {
+	if path == s.configFile() {
+		return 0600
+	}
+	return 0644
+}
+
+// syncDir flushes the entries of dir, such as a file just renamed into it.
+// Windows can't open a directory for that, and its renames need no help.
+func syncDir(dir string) error {
+	if runtime.GOOS == "windows" {
+		return nil
+	}
+	d, err := os.Open(dir)
+	if err != nil {
+		return err
+	}
+	if err := d.Sync(); err != nil {
+		d.Close()
+		return err
+	}
+	return d.Close()
+}
+
+// SaveSession persists a session durably. Use it for state transitions
+// such as start, pause, completion and cancellation.
 func (s *Storage) SaveSession(session models.Session) error {
-	sessions, err := s.GetAllSessions()
-	if err != nil && !os.IsNotExist(err) {
+	return s.saveSession(session, true)
+}
+
+// SaveSessionProgress persists a session without forcing it to disk. It is
+// meant for the periodic elapsed-time saves made while a timer is ticking.
+func (s *Storage) SaveSessionProgress(session models.Session) error {
+	return s.saveSession(session, false)
+}
+
+func (s *Storage) saveSession(session models.Session, durable bool) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.refreshLocked(); err != nil {
 		return err
 	}
+	sessions := s.cache.snapshot()
 
 	// Check if this is an update to an existing session
 	found := false
@@ -56,12 +175,7 @@ func (s *Storage) SaveSession(session models.Session) error {
 		sessions = append(sessions, session)
 	}
 
-	data, err := json.MarshalIndent(sessions, "", "  ")
-	if err != nil {
-		return err
-	}
-
-	return os.WriteFile(s.sessionsFile(), data, 0644)
+	return s.writeSessionsLocked(sessions, durable)
 }
 
 func (s *Storage) GetActiveSession() (*models.Session, error) {
@@ -80,38 +194,32 @@ func (s *Storage) GetActiveSession() (*models.Session, error) {
 }
 
 func (s *Storage) DeactivateAllSessions() error {
-	sessions, err := s.GetAllSessions()
-	if err != nil {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.refreshLocked(); err != nil {
 		return err
 	}
+	sessions := s.cache.snapshot()
 
 	for i := range sessions {
 		sessions[i].Active = false
 	}
 
-	data, err := json.MarshalIndent(sessions, "", "  ")
-	if err != nil {
-		return err
-	}
-
-	return os.WriteFile(s.sessionsFile(), data, 0644)
+	return s.writeSessionsLocked(sessions, true)
 }
 
+// GetAllSessions returns a copy of every stored session. The sessions file
+// is only re-read when it changed on disk since the last call.
 func (s *Storage) GetAllSessions() ([]models.Session, error) {
-	data, err := os.ReadFile(s.sessionsFile())
-	if err != nil {
-		if os.IsNotExist(err) {
-			return []models.Session{}, nil
-		}
-		return nil, err
-	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
 
-	var sessions []models.Session
-	if err := json.Unmarshal(data, &sessions); err != nil {
+	if err := s.refreshLocked(); err != nil {
 		return nil, err
 	}
 
-	return sessions, nil
+	return s.cache.snapshot(), nil
 }
 
 func (s *Storage) GetTodaySessions() ([]models.Session, error) {
@@ -143,7 +251,7 @@ func (s *Storage) GetWeekSessions(year int, week int) ([]models.Session, error)
 
 	var sessions []models.Session
 	for _, session := range allSessions {
-		if session.Year == year && session.Week == week {
+		if y, w := s.sessionWeek(session); y == year && w == week {
 			sessions = append(sessions, session)
 		}
 	}
@@ -184,8 +292,64 @@ func (s *Storage) GetYearSessions(year int) ([]models.Session, error) {
 	return sessions, nil
 }
 
+// continueWindow bounds how old unfinished work can be and still be
+// suggested when starting a new session.
+const continueWindow = 7 * 24 * time.Hour
+
+// GetContinueSuggestion returns the most recent labeled session that was
+// stopped before completing, unless work with the same label has been
+// completed since. It returns nil when there is nothing to suggest.
+func (s *Storage) GetContinueSuggestion() (*models.Session, error) {
+	sessions, err := s.GetAllSessions()
+	if err != nil {
+		return nil, err
+	}
+
+	var latest *models.Session
+	for i := range sessions {
+		session := sessions[i]
+		if session.Active || session.Completed || session.Label() == "" {
+			continue
+		}
+		if time.Since(session.StartTime) > continueWindow {
+			continue
+		}
+		if latest == nil || session.StartTime.After(latest.StartTime) {
+			latest = &sessions[i]
+		}
+	}
+	if latest == nil {
+		return nil, nil
+	}
+
+	for _, session := range sessions {
+		if session.Completed && session.Label() == latest.Label() && session.StartTime.After(latest.StartTime) {
+			return nil, nil
+		}
+	}
+
+	return latest, nil
+}
+
+// GetSessionsInRange returns sessions that started within [from, to).
+func (s *Storage) GetSessionsInRange(from, to time.Time) ([]models.Session, error) {
+	allSessions, err := s.GetAllSessions()
+	if err != nil {
+		return nil, err
+	}
+
+	var sessions []models.Session
+	for _, session := range allSessions {
+		if !session.StartTime.Before(from) && session.StartTime.Before(to) {
+			sessions = append(sessions, session)
+		}
+	}
+
+	return sessions, nil
+}
+
 func (s *Storage) GetConfig() (models.Config, error) {
-	data, err := os.ReadFile(s.configFile())
+	config, err := s.readConfig()
 	if err != nil {
 		if os.IsNotExist(err) {
 			config := models.DefaultConfig()
@@ -197,7 +361,104 @@ func (s *Storage) GetConfig() (models.Config, error) {
 		return models.Config{}, err
 	}
 
-	var config models.Config
+	s.applyConfig(config)
+	return config, nil
+}
+
+// applyConfig mirrors the config options that affect how storage reads and
+// writes data. Changing the week start invalidates cached aggregates.
+func (s *Storage) applyConfig(config models.Config) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.fsync = config.FsyncCriticalWrites
+	if weekStart := config.FirstWeekday(); weekStart != s.weekStart {
+		s.weekStart = weekStart
+		s.cache.invalidate()
+	}
+	if config.MinSessionMinutes != s.minMinutes {
+		s.minMinutes = config.MinSessionMinutes
+		s.cache.invalidate()
+	}
+	if config.PartialCredit != s.partial {
+		s.partial = config.PartialCredit
+		s.cache.invalidate()
+	}
+	if !maps.Equal(config.IntensityWeights, s.weights) {
+		s.weights = maps.Clone(config.IntensityWeights)
+		s.cache.invalidate()
+	}
+}
+
+// withoutFalseStarts drops the sessions too short to count toward stats and
+// returns how many it dropped.
+func (s *Storage) withoutFalseStarts(sessions []models.Session) ([]models.Session, int) {
+	s.mu.Lock()
+	min := s.minMinutes
+	s.mu.Unlock()
+	if min <= 0 {
+		return sessions, 0
+	}
+
+	kept := make([]models.Session, 0, len(sessions))
+	for _, session := range sessions {
+		if !session.IsFalseStart(min) {
+			kept = append(kept, session)
+		}
+	}
+	return kept, len(sessions) - len(kept)
+}
+
+// partialCredit totals the stopped sessions that ran long enough to count
+// toward focus time.
+func (s *Storage) partialCredit(sessions []models.Session) (count, minutes int) {
+	s.mu.Lock()
+	min := s.partial
+	s.mu.Unlock()
+
+	for _, session := range sessions {
+		if session.EarnsPartialCredit(min) {
+			count++
+			minutes += session.ActualMinutes()
+		}
+	}
+	return count, minutes
+}
+
+// WeekOf returns the year and week number containing t, honoring the
+// configured first day of the week.
+func (s *Storage) WeekOf(t time.Time) (year, week int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return models.WeekNumber(t, s.weekStart)
+}
+
+// FirstWeekday returns the configured first day of the week.
+func (s *Storage) FirstWeekday() time.Weekday {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.weekStart
+}
+
+// sessionWeek returns the week a session belongs to, based on the local
+// date it was started on.
+func (s *Storage) sessionWeek(session models.Session) (year, week int) {
+	day, err := time.ParseInLocation("2006-01-02", session.Date, time.Local)
+	if err != nil {
+		day = session.StartTime
+	}
+	return s.WeekOf(day)
+}
+
+// readConfig loads the config file on top of the defaults so that options
+// added after the file was written keep their default values.
+func (s *Storage) readConfig() (models.Config, error) {
+	data, err := os.ReadFile(s.configFile())
+	if err != nil {
+		return models.Config{}, err
+	}
+
+	config := models.DefaultConfig()
 	if err := json.Unmarshal(data, &config); err != nil {
 		return models.Config{}, err
 	}
@@ -205,70 +466,78 @@ func (s *Storage) GetConfig() (models.Config, error) {
 	return config, nil
 }
 
+// SaveConfig writes config. The goal history is kept by storage: when the
+// daily session goal changes, the old one is recorded as in effect until
+// today.
 func (s *Storage) SaveConfig(config models.Config) error {
+	if previous, err := s.readConfig(); err == nil {
+		config.PastGoals = previous.PastGoals
+		if previous.DailySessionGoal != config.DailySessionGoal {
+			config.RecordGoalChange(previous.DailySessionGoal, time.Now().Format("2006-01-02"))
+		}
+	}
+
 	data, err := json.MarshalIndent(config, "", "  ")
 	if err != nil {
 		return err
 	}
 
-	return os.WriteFile(s.configFile(), data, 0644)
+	s.applyConfig(config)
+	return s.writeFile(s.configFile(), data, true)
 }
 
-func (s *Storage) GetDayStats(date string) (models.DayStats, error) {
-	sessions, err := s.GetSessionsByDate(date)
+func (s *Storage) computeDayStats(date string) (models.DayStats, error) {
+	all, err := s.GetSessionsByDate(date)
 	if err != nil {
 		return models.DayStats{}, err
 	}
+	all = s.matchingFilter(all)
+	sessions, falseStarts := s.withoutFalseStarts(all)
 
 	completedCount := 0
 	totalMinutes := 0
+	distractions := 0
 	for _, session := range sessions {
 		if session.Completed {
 			completedCount++
-			// Use actual time spent, fallback to planned duration
-			actualMinutes := session.ElapsedSeconds / 60
-			if actualMinutes == 0 && !session.EndTime.IsZero() && !session.StartTime.IsZero() {
-				actualMinutes = int(session.EndTime.Sub(session.StartTime).Minutes())
-			}
-			if actualMinutes == 0 {
-				actualMinutes = session.Duration
-			}
-			totalMinutes += actualMinutes
+			distractions += session.Distractions
+			totalMinutes += session.ActualMinutes()
 		}
 	}
 
 	stats := models.DayStats{
 		Date:          date,
 		SessionsCount: completedCount,
-		Sessions:      sessions,
+		Sessions:      all,
 		TotalMinutes:  totalMinutes,
+		AverageFocus:  models.AverageFocus(sessions),
+		FalseStarts:   falseStarts,
+		Distractions:  distractions,
 	}
+	stats.PartialSessions, stats.PartialMinutes = s.partialCredit(sessions)
+	stats.TotalMinutes += stats.PartialMinutes
+	stats.WeightedMinutes, stats.IntensityMinutes = s.intensityTotals(sessions)
 
 	return stats, nil
 }
 
-func (s *Storage) GetWeekStats(year int, week int) (models.WeekStats, error) {
+func (s *Storage) computeWeekStats(year int, week int) (models.WeekStats, error) {
 	sessions, err := s.GetWeekSessions(year, week)
 	if err != nil {
 		return models.WeekStats{}, err
 	}
+	sessions, falseStarts := s.withoutFalseStarts(s.matchingFilter(sessions))
 
 	completedCount := 0
 	totalMinutes := 0
+	distractions := 0
 	dateMap := make(map[string][]models.Session)
 
 	for _, session := range sessions {
 		if session.Completed {
 			completedCount++
-			// Use actual time spent
-			actualMinutes := session.ElapsedSeconds / 60
-			if actualMinutes == 0 && !session.EndTime.IsZero() && !session.StartTime.IsZero() {
-				actualMinutes = int(session.EndTime.Sub(session.StartTime).Minutes())
-			}
-			if actualMinutes == 0 {
-				actualMinutes = session.Duration
-			}
-			totalMinutes += actualMinutes
+			distractions += session.Distractions
+			totalMinutes += session.ActualMinutes()
 			dateMap[session.Date] = append(dateMap[session.Date], session)
 		}
 	}
@@ -278,36 +547,40 @@ func (s *Storage) GetWeekStats(year int, week int) (models.WeekStats, error) {
 		Year:          year,
 		SessionsCount: completedCount,
 		TotalMinutes:  totalMinutes,
+		AverageFocus:  models.AverageFocus(sessions),
+		Distractions:  distractions,
+		FalseStarts:   falseStarts,
 	}
+	stats.PartialSessions, stats.PartialMinutes = s.partialCredit(sessions)
+	stats.TotalMinutes += stats.PartialMinutes
+	stats.WeightedMinutes, stats.IntensityMinutes = s.intensityTotals(sessions)
 
 	for date, dateSessions := range dateMap {
 		dayStats := models.DayStats{
 			Date:          date,
 			SessionsCount: len(dateSessions),
 			Sessions:      dateSessions,
+			AverageFocus:  models.AverageFocus(dateSessions),
 		}
 		for _, s := range dateSessions {
-			// Use actual time spent for daily stats too
-			actualMinutes := s.ElapsedSeconds / 60
-			if actualMinutes == 0 && !s.EndTime.IsZero() && !s.StartTime.IsZero() {
-				actualMinutes = int(s.EndTime.Sub(s.StartTime).Minutes())
-			}
-			if actualMinutes == 0 {
-				actualMinutes = s.Duration
-			}
-			dayStats.TotalMinutes += actualMinutes
+			dayStats.TotalMinutes += s.ActualMinutes()
+			dayStats.Distractions += s.Distractions
 		}
 		stats.DailyStats = append(stats.DailyStats, dayStats)
 	}
+	sort.Slice(stats.DailyStats, func(i, j int) bool {
+		return stats.DailyStats[i].Date < stats.DailyStats[j].Date
+	})
 
 	return stats, nil
 }
 
-func (s *Storage) GetMonthStats(year int, month int) (models.MonthStats, error) {
+func (s *Storage) computeMonthStats(year int, month int) (models.MonthStats, error) {
 	sessions, err := s.GetMonthSessions(year, month)
 	if err != nil {
 		return models.MonthStats{}, err
 	}
+	sessions, falseStarts := s.withoutFalseStarts(s.matchingFilter(sessions))
 
 	monthStr := fmt.Sprintf("%04d-%02d", year, month)
 	completedCount := 0
@@ -317,16 +590,9 @@ func (s *Storage) GetMonthStats(year int, month int) (models.MonthStats, error)
 	for _, session := range sessions {
 		if session.Completed {
 			completedCount++
-			// Use actual time spent
-			actualMinutes := session.ElapsedSeconds / 60
-			if actualMinutes == 0 && !session.EndTime.IsZero() && !session.StartTime.IsZero() {
-				actualMinutes = int(session.EndTime.Sub(session.StartTime).Minutes())
-			}
-			if actualMinutes == 0 {
-				actualMinutes = session.Duration
-			}
-			totalMinutes += actualMinutes
-			weekMap[session.Week] = append(weekMap[session.Week], session)
+			totalMinutes += session.ActualMinutes()
+			_, week := s.sessionWeek(session)
+			weekMap[week] = append(weekMap[week], session)
 		}
 	}
 
@@ -335,36 +601,38 @@ func (s *Storage) GetMonthStats(year int, month int) (models.MonthStats, error)
 		Year:          year,
 		SessionsCount: completedCount,
 		TotalMinutes:  totalMinutes,
+		AverageFocus:  models.AverageFocus(sessions),
+		FalseStarts:   falseStarts,
 	}
+	stats.PartialSessions, stats.PartialMinutes = s.partialCredit(sessions)
+	stats.TotalMinutes += stats.PartialMinutes
+	stats.WeightedMinutes, stats.IntensityMinutes = s.intensityTotals(sessions)
 
 	for week, weekSessions := range weekMap {
 		weekStats := models.WeekStats{
 			Week:          week,
 			Year:          year,
 			SessionsCount: len(weekSessions),
+			AverageFocus:  models.AverageFocus(weekSessions),
 		}
 		for _, s := range weekSessions {
-			// Use actual time spent for weekly stats in month view too
-			actualMinutes := s.ElapsedSeconds / 60
-			if actualMinutes == 0 && !s.EndTime.IsZero() && !s.StartTime.IsZero() {
-				actualMinutes = int(s.EndTime.Sub(s.StartTime).Minutes())
-			}
-			if actualMinutes == 0 {
-				actualMinutes = s.Duration
-			}
-			weekStats.TotalMinutes += actualMinutes
+			weekStats.TotalMinutes += s.ActualMinutes()
 		}
 		stats.WeeklyStats = append(stats.WeeklyStats, weekStats)
 	}
+	sort.Slice(stats.WeeklyStats, func(i, j int) bool {
+		return stats.WeeklyStats[i].Week < stats.WeeklyStats[j].Week
+	})
 
 	return stats, nil
 }
 
-func (s *Storage) GetYearStats(year int) (models.YearStats, error) {
+func (s *Storage) computeYearStats(year int) (models.YearStats, error) {
 	sessions, err := s.GetYearSessions(year)
 	if err != nil {
 		return models.YearStats{}, err
 	}
+	sessions, falseStarts := s.withoutFalseStarts(s.matchingFilter(sessions))
 
 	completedCount := 0
 	totalMinutes := 0
@@ -373,19 +641,11 @@ func (s *Storage) GetYearStats(year int) (models.YearStats, error) {
 	for _, session := range sessions {
 		if session.Completed {
 			completedCount++
-			// Use actual time spent
-			actualMinutes := session.ElapsedSeconds / 60
-			if actualMinutes == 0 && !session.EndTime.IsZero() && !session.StartTime.IsZero() {
-				actualMinutes = int(session.EndTime.Sub(session.StartTime).Minutes())
-			}
-			if actualMinutes == 0 {
-				actualMinutes = session.Duration
-			}
-			totalMinutes += actualMinutes
+			totalMinutes += session.ActualMinutes()
 
 			// Extract month from session.Month (YYYY-MM format)
-			var month int
-			fmt.Sscanf(session.Month, "%4d-%02d", &year, &month)
+			var sessionYear, month int
+			fmt.Sscanf(session.Month, "%4d-%02d", &sessionYear, &month)
 			monthMap[month] = append(monthMap[month], session)
 		}
 	}
@@ -394,7 +654,12 @@ func (s *Storage) GetYearStats(year int) (models.YearStats, error) {
 		Year:          year,
 		SessionsCount: completedCount,
 		TotalMinutes:  totalMinutes,
+		AverageFocus:  models.AverageFocus(sessions),
+		FalseStarts:   falseStarts,
 	}
+	stats.PartialSessions, stats.PartialMinutes = s.partialCredit(sessions)
+	stats.TotalMinutes += stats.PartialMinutes
+	stats.WeightedMinutes, stats.IntensityMinutes = s.intensityTotals(sessions)
 
 	// Generate monthly stats for each month that has sessions
 	for month := range monthMap {
@@ -404,21 +669,53 @@ func (s *Storage) GetYearStats(year int) (models.YearStats, error) {
 		}
 		stats.MonthlyStats = append(stats.MonthlyStats, monthStats)
 	}
+	sort.Slice(stats.MonthlyStats, func(i, j int) bool {
+		return stats.MonthlyStats[i].Month < stats.MonthlyStats[j].Month
+	})
 
 	return stats, nil
 }
 
+// ResetAllData deletes every data store of the profile: sessions, config,
+// journal, plans, tasks and the rest, leaving it as on a first launch.
+// What the user wrote or set up themselves is kept: messages.txt, hooks,
+// plugins and scripts, as are logs and backups.
 func (s *Storage) ResetAllData() error {
-	// Remove sessions file
-	if err := os.Remove(s.sessionsFile()); err != nil && !os.IsNotExist(err) {
+	if err := s.removeDataFiles(); err != nil {
 		return err
 	}
+	s.applyConfig(models.DefaultConfig())
+	return nil
+}
 
-	// Remove config file
-	if err := os.Remove(s.configFile()); err != nil && !os.IsNotExist(err) {
-		return err
+// dataFiles lists the files the profile's data is stored in. A new data
+// store must be added here to be reset.
+func (s *Storage) dataFiles() []string {
+	return []string{
+		s.sessionsFile(),
+		s.configFile(),
+		s.achievementsFile(),
+		s.journalFile(),
+		s.offDaysFile(),
+		s.outboxFile(),
+		s.plansFile(),
+		s.reportSentFile(),
+		s.reviewsFile(),
+		s.tasksFile(),
 	}
+}
 
+// removeDataFiles deletes the files of dataFiles.
+func (s *Storage) removeDataFiles() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.cache.reset()
+
+	for _, path := range s.dataFiles() {
+		if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -450,14 +747,7 @@ func (s *Storage) ExportAllStats() (string, error) {
 		totalSessions++
 		if session.Completed {
 			completedSessions++
-			actualMinutes := session.ElapsedSeconds / 60
-			if actualMinutes == 0 && !session.EndTime.IsZero() && !session.StartTime.IsZero() {
-				actualMinutes = int(session.EndTime.Sub(session.StartTime).Minutes())
-			}
-			if actualMinutes == 0 {
-				actualMinutes = session.Duration
-			}
-			totalMinutes += actualMinutes
+			totalMinutes += session.ActualMinutes()
 		}
 	}
 
@@ -526,8 +816,8 @@ func (s *Storage) ExportAllStats() (string, error) {
 	}
 
 	// Recent Week Statistics
-	_, currentWeek := now.ISOWeek()
-	weekStats, err := s.GetWeekStats(now.Year(), currentWeek)
+	weekYear, currentWeek := s.WeekOf(now)
+	weekStats, err := s.GetWeekStats(weekYear, currentWeek)
 	if err == nil && weekStats.SessionsCount > 0 {
 		report += fmt.Sprintf("CURRENT WEEK (Week %d, %d)\n", weekStats.Week, weekStats.Year)
 		report += fmt.Sprintf("------------------------\n")
@@ -584,5 +874,20 @@ func (s *Storage) ExportAllStats() (string, error) {
 		}
 	}
 
+	// Journal notes, oldest first
+	if notes, err := s.readJournal(); err == nil && len(notes) > 0 {
+		dates := make([]string, 0, len(notes))
+		for date := range notes {
+			dates = append(dates, date)
+		}
+		sort.Strings(dates)
+
+		report += fmt.Sprintf("\nJOURNAL\n")
+		report += fmt.Sprintf("-------\n")
+		for _, date := range dates {
+			report += fmt.Sprintf("%s: %s\n", date, notes[date])
+		}
+	}
+
 	return report, nil
 }
@@ -0,0 +1,163 @@
+package storage
+
+import (
+	"os"
+	"path/filepath"
+	"runtime"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/adibhanna/focussessions/internal/models"
+)
+
+// newTestStorage returns a Storage over a fresh data directory.
+func newTestStorage(t *testing.T) *Storage {
+	t.Helper()
+	s, err := Open(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	return s
+}
+
+func TestResetAllData(t *testing.T) {
+	s := newTestStorage(t)
+	now := time.Date(2025, time.March, 12, 15, 4, 0, 0, time.UTC)
+
+	config := models.DefaultConfig()
+	config.SessionDuration = 45
+	steps := []error{
+		s.SaveConfig(config),
+		s.SaveSession(models.Session{ID: "a", StartTime: now, Date: "2025-03-12", Duration: 25, Completed: true}),
+		s.SaveJournal("2025-03-12", "Shipped it"),
+		s.SaveTasks([]models.Task{{ID: "t", Title: "Write"}}),
+		s.SetOffDays(now, now, "holiday"),
+		s.SetReportSent(now),
+		s.SaveWeekReview(2025, 11, "Good week"),
+		s.SaveDayPlan(models.DayPlan{Date: "2025-03-12", Blocks: []models.PlannedBlock{{}}}),
+		s.UnlockAchievements(now, "first-step"),
+		s.EnqueueOutbox(models.OutboxEntry{ID: "o", Kind: "webhook", CreatedAt: now}),
+		os.WriteFile(s.messagesFile(), []byte("Keep going\n"), 0644),
+		os.WriteFile(s.DaemonLogPath(), []byte("started\n"), 0644),
+		os.MkdirAll(s.HooksDir(), 0755),
+	}
+	for _, err := range steps {
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := s.ResetAllData(); err != nil {
+		t.Fatal(err)
+	}
+
+	entries, err := os.ReadDir(s.dataDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// What the user wrote and the log are kept
+	var kept []string
+	for _, entry := range entries {
+		if entry.Type().IsRegular() {
+			kept = append(kept, entry.Name())
+		}
+	}
+	if want := []string{"daemon.log", "messages.txt"}; !slices.Equal(kept, want) {
+		t.Errorf("files after reset %v, want %v", kept, want)
+	}
+	if _, err := os.Stat(s.HooksDir()); err != nil {
+		t.Errorf("hooks directory removed: %v", err)
+	}
+	if sessions, err := s.GetAllSessions(); err != nil || len(sessions) != 0 {
+		t.Errorf("sessions after reset = %d, %v; want none", len(sessions), err)
+	}
+	if !s.IsFirstTime() {
+		t.Errorf("config still there after reset")
+	}
+}
+
+func TestWriteFileReplaces(t *testing.T) {
+	for _, fsync := range []bool{false, true} {
+		s := newTestStorage(t)
+		s.fsync = fsync
+		path := filepath.Join(s.dataDir, "sessions.json")
+		for _, data := range []string{`["a long first version"]`, `[]`} {
+			if err := s.writeFile(path, []byte(data), true); err != nil {
+				t.Fatal(err)
+			}
+			got, err := os.ReadFile(path)
+			if err != nil || string(got) != data {
+				t.Errorf("fsync %v: read %q, %v; want %q", fsync, got, err, data)
+			}
+		}
+
+		entries, err := os.ReadDir(s.dataDir)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(entries) != 1 {
+			t.Errorf("fsync %v: %d files in the data directory, want only sessions.json", fsync, len(entries))
+		}
+		if info, err := os.Stat(path); err == nil && info.Mode().Perm() != 0644 {
+			t.Errorf("fsync %v: mode %v, want 0644", fsync, info.Mode().Perm())
+		}
+	}
+}
+
+func TestConfigPrivate(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("no Unix permissions")
+	}
+	dir := t.TempDir()
+	path := filepath.Join(dir, "config.json")
+	// Written readable by all before it held credentials
+	if err := os.WriteFile(path, []byte(`{"session_duration": 45}`), 0644); err != nil {
+		t.Fatal(err)
+	}
+	s, err := Open(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	check := func(when string) {
+		t.Helper()
+		info, err := os.Stat(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if info.Mode().Perm() != 0600 {
+			t.Errorf("%s: config mode %v, want 0600", when, info.Mode().Perm())
+		}
+	}
+	check("after opening")
+
+	config := models.DefaultConfig()
+	config.MQTT = &models.MQTTSettings{Broker: "tcp://localhost:1883", Password: "secret"}
+	if err := s.SaveConfig(config); err != nil {
+		t.Fatal(err)
+	}
+	check("after saving")
+}
+
+func TestYearStatsAcrossWeekYear(t *testing.T) {
+	s := newTestStorage(t)
+	// December 29, 2025 falls in ISO week 1 of 2026
+	start := time.Date(2025, time.December, 29, 9, 0, 0, 0, time.UTC)
+	sessions := []models.Session{
+		{ID: "b", StartTime: start.AddDate(0, 0, 7), Date: "2026-01-05", Month: "2026-01", Year: 2026, Week: 2, Duration: 50, ElapsedSeconds: 50 * 60, Completed: true},
+		{ID: "a", StartTime: start, Date: "2025-12-29", Month: "2025-12", Year: 2026, Week: 1, Duration: 25, ElapsedSeconds: 25 * 60, Completed: true},
+	}
+	for _, session := range sessions {
+		if err := s.SaveSession(session); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	stats, err := s.GetYearStats(2026)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if stats.Year != 2026 || stats.TotalMinutes != 75 {
+		t.Errorf("year %d with %d minutes, want 2026 with 75", stats.Year, stats.TotalMinutes)
+	}
+}
@@ -0,0 +1,67 @@
+package storage
+
+import (
+	"sort"
+
+	"github.com/adibhanna/focussessions/internal/models"
+)
+
+// TagUsage describes a tag seen in the session history or configured in
+// the tag settings.
+type TagUsage struct {
+	Tag      string
+	Sessions int
+	Settings models.TagSettings
+}
+
+// GetTags returns every tag used by a session or configured in the config,
+// sorted by name.
+func (s *Storage) GetTags() ([]TagUsage, error) {
+	config, err := s.GetConfig()
+	if err != nil {
+		return nil, err
+	}
+	sessions, err := s.GetAllSessions()
+	if err != nil {
+		return nil, err
+	}
+
+	counts := make(map[string]int)
+	for _, session := range sessions {
+		if session.Tag != "" {
+			counts[session.Tag]++
+		}
+	}
+	for tag := range config.Tags {
+		if _, ok := counts[tag]; !ok {
+			counts[tag] = 0
+		}
+	}
+
+	tags := make([]TagUsage, 0, len(counts))
+	for tag, n := range counts {
+		tags = append(tags, TagUsage{Tag: tag, Sessions: n, Settings: config.Tags[tag]})
+	}
+	sort.Slice(tags, func(i, j int) bool {
+		return tags[i].Tag < tags[j].Tag
+	})
+	return tags, nil
+}
+
+// SetTagSettings stores settings for tag. Empty settings remove the entry.
+func (s *Storage) SetTagSettings(tag string, settings models.TagSettings) error {
+	config, err := s.GetConfig()
+	if err != nil {
+		return err
+	}
+
+	if settings == (models.TagSettings{}) {
+		delete(config.Tags, tag)
+	} else {
+		if config.Tags == nil {
+			config.Tags = make(map[string]models.TagSettings)
+		}
+		config.Tags[tag] = settings
+	}
+	return s.SaveConfig(config)
+}
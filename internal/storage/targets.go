@@ -0,0 +1,70 @@
+package storage
+
+import (
+	"sort"
+	"time"
+
+	"github.com/adibhanna/focussessions/internal/models"
+)
+
+// GetProjectBurndown returns progress towards every weekly project target for
+// the week containing now, sorted by project name.
+func (s *Storage) GetProjectBurndown(now time.Time) ([]models.ProjectBurndown, error) {
+	config, err := s.GetConfig()
+	if err != nil {
+		return nil, err
+	}
+	if len(config.ProjectTargets) == 0 {
+		return nil, nil
+	}
+
+	start := models.WeekStart(now, config.FirstWeekday())
+	end := start.AddDate(0, 0, 7)
+	sessions, err := s.GetSessionsInRange(start, end)
+	if err != nil {
+		return nil, err
+	}
+
+	minutes := make(map[string]int)
+	for _, session := range sessions {
+		if session.Completed && session.Project != "" {
+			minutes[session.Project] += session.ActualMinutes()
+		}
+	}
+
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+	daysLeft := int(end.Sub(today).Hours()/24 + 0.5)
+
+	burndown := make([]models.ProjectBurndown, 0, len(config.ProjectTargets))
+	for project, target := range config.ProjectTargets {
+		burndown = append(burndown, models.ProjectBurndown{
+			Project:  project,
+			Target:   target,
+			Minutes:  minutes[project],
+			DaysLeft: daysLeft,
+		})
+	}
+	sort.Slice(burndown, func(i, j int) bool {
+		return burndown[i].Project < burndown[j].Project
+	})
+	return burndown, nil
+}
+
+// SetProjectTarget sets the weekly target for project in minutes. A target of
+// zero removes it.
+func (s *Storage) SetProjectTarget(project string, minutes int) error {
+	config, err := s.GetConfig()
+	if err != nil {
+		return err
+	}
+
+	if minutes <= 0 {
+		delete(config.ProjectTargets, project)
+	} else {
+		if config.ProjectTargets == nil {
+			config.ProjectTargets = make(map[string]int)
+		}
+		config.ProjectTargets[project] = minutes
+	}
+	return s.SaveConfig(config)
+}
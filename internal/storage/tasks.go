@@ -0,0 +1,70 @@
+package storage
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+
+	"github.com/adibhanna/focussessions/internal/models"
+)
+
+func (s *Storage) tasksFile() string {
+	return filepath.Join(s.dataDir, "tasks.json")
+}
+
+// GetTasks returns the task list in the order the tasks were added.
+func (s *Storage) GetTasks() ([]models.Task, error) {
+	var tasks []models.Task
+	data, err := os.ReadFile(s.tasksFile())
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(data, &tasks); err != nil {
+		return nil, err
+	}
+	return tasks, nil
+}
+
+// SaveTasks replaces the task list.
+func (s *Storage) SaveTasks(tasks []models.Task) error {
+	if tasks == nil {
+		tasks = []models.Task{}
+	}
+	data, err := json.MarshalIndent(tasks, "", "  ")
+	if err != nil {
+		return err
+	}
+	return s.writeFile(s.tasksFile(), data, true)
+}
+
+// GetTaskProgress returns every task with the completed sessions started
+// against it and the time they took.
+func (s *Storage) GetTaskProgress() ([]models.TaskProgress, error) {
+	tasks, err := s.GetTasks()
+	if err != nil || len(tasks) == 0 {
+		return nil, err
+	}
+	sessions, err := s.GetAllSessions()
+	if err != nil {
+		return nil, err
+	}
+
+	progress := make([]models.TaskProgress, len(tasks))
+	index := make(map[string]int, len(tasks))
+	for i, task := range tasks {
+		progress[i].Task = task
+		index[task.ID] = i
+	}
+	for _, session := range sessions {
+		i, ok := index[session.TaskID]
+		if !ok || !session.Completed {
+			continue
+		}
+		progress[i].Pomodoros++
+		progress[i].FocusMinutes += session.ActualMinutes()
+	}
+	return progress, nil
+}
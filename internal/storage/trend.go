@@ -0,0 +1,74 @@
+package storage
+
+import (
+	"time"
+
+	"github.com/adibhanna/focussessions/internal/models"
+)
+
+// GetDailyMinutes returns the completed focus minutes of each day from
+// from's day up to, but not including, to's day.
+func (s *Storage) GetDailyMinutes(from, to time.Time) ([]int, error) {
+	return s.dailyTotals(from, to, models.Session.ActualMinutes)
+}
+
+// GetDailySessionCounts returns the number of completed sessions of each
+// day from from's day up to, but not including, to's day.
+func (s *Storage) GetDailySessionCounts(from, to time.Time) ([]int, error) {
+	return s.dailyTotals(from, to, func(models.Session) int { return 1 })
+}
+
+// dailyTotals sums value over the completed sessions of each day in the
+// range.
+func (s *Storage) dailyTotals(from, to time.Time, value func(models.Session) int) ([]int, error) {
+	from = time.Date(from.Year(), from.Month(), from.Day(), 0, 0, 0, 0, from.Location())
+	to = time.Date(to.Year(), to.Month(), to.Day(), 0, 0, 0, 0, to.Location())
+
+	sessions, err := s.GetSessionsInRange(from, to)
+	if err != nil {
+		return nil, err
+	}
+	sessions, _ = s.withoutFalseStarts(s.matchingFilter(sessions))
+
+	index := make(map[string]int)
+	var totals []int
+	for d := from; d.Before(to); d = d.AddDate(0, 0, 1) {
+		index[d.Format("2006-01-02")] = len(totals)
+		totals = append(totals, 0)
+	}
+	for _, session := range sessions {
+		if !session.Completed {
+			continue
+		}
+		if i, ok := index[session.StartTime.In(from.Location()).Format("2006-01-02")]; ok {
+			totals[i] += value(session)
+		}
+	}
+	return totals, nil
+}
+
+// GetHourlyMinutes returns the completed focus minutes spent in each hour
+// of the day by sessions started from from's day up to, but not including,
+// to's day. Sessions spanning several hours count toward each of them.
+func (s *Storage) GetHourlyMinutes(from, to time.Time) ([24]int, error) {
+	var hours [24]int
+
+	from = time.Date(from.Year(), from.Month(), from.Day(), 0, 0, 0, 0, from.Location())
+	to = time.Date(to.Year(), to.Month(), to.Day(), 0, 0, 0, 0, to.Location())
+	sessions, err := s.GetSessionsInRange(from, to)
+	if err != nil {
+		return hours, err
+	}
+	sessions = s.matchingFilter(sessions)
+
+	for _, session := range sessions {
+		if !session.Completed {
+			continue
+		}
+		start := session.StartTime.In(from.Location())
+		for minute := range session.ActualMinutes() {
+			hours[start.Add(time.Duration(minute)*time.Minute).Hour()]++
+		}
+	}
+	return hours, nil
+}
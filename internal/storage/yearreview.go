@@ -0,0 +1,102 @@
+package storage
+
+import (
+	"sort"
+	"time"
+
+	"github.com/adibhanna/focussessions/internal/models"
+)
+
+// yearReviewTags is how many tags the year in review lists.
+const yearReviewTags = 3
+
+// GetYearInReview sums up the completed sessions started in year: the
+// totals, the busiest month and day, the longest streak, the favorite hour
+// and the top tags.
+func (s *Storage) GetYearInReview(year int) (models.YearInReview, error) {
+	review := models.YearInReview{Year: year, FavoriteHour: -1}
+
+	from := time.Date(year, time.January, 1, 0, 0, 0, 0, time.Local)
+	to := from.AddDate(1, 0, 0)
+	daily, err := s.GetDailyMinutes(from, to)
+	if err != nil {
+		return review, err
+	}
+	off, err := s.GetOffDaysIn(from, to)
+	if err != nil {
+		return review, err
+	}
+	hours, err := s.GetHourlyMinutes(from, to)
+	if err != nil {
+		return review, err
+	}
+	sessions, err := s.GetSessionsInRange(from, to)
+	if err != nil {
+		return review, err
+	}
+	sessions, _ = s.withoutFalseStarts(s.matchingFilter(sessions))
+
+	tags := make(map[string]int)
+	for _, session := range sessions {
+		if !session.Completed {
+			continue
+		}
+		review.Sessions++
+		if session.Tag != "" {
+			tags[session.Tag] += session.ActualMinutes()
+		}
+	}
+
+	var months [13]int
+	run := 0
+	var runStart time.Time
+	for i, minutes := range daily {
+		day := from.AddDate(0, 0, i)
+		review.TotalMinutes += minutes
+		months[day.Month()] += minutes
+		if minutes > review.BestDayMinutes {
+			review.BestDay, review.BestDayMinutes = day, minutes
+		}
+
+		// Days off neither extend nor break a streak
+		if minutes == 0 {
+			if !off[i] {
+				run = 0
+			}
+			continue
+		}
+		review.ActiveDays++
+		if run == 0 {
+			runStart = day
+		}
+		run++
+		if run > review.LongestStreak {
+			review.LongestStreak, review.StreakStart, review.StreakEnd = run, runStart, day
+		}
+	}
+
+	for month := time.January; month <= time.December; month++ {
+		if months[month] > review.BusiestMonthMinutes {
+			review.BusiestMonth, review.BusiestMonthMinutes = month, months[month]
+		}
+	}
+	for hour, minutes := range hours {
+		if minutes > review.FavoriteHourMinutes {
+			review.FavoriteHour, review.FavoriteHourMinutes = hour, minutes
+		}
+	}
+
+	for tag, minutes := range tags {
+		review.TopTags = append(review.TopTags, models.TagMinutes{Tag: tag, Minutes: minutes})
+	}
+	sort.Slice(review.TopTags, func(i, j int) bool {
+		if review.TopTags[i].Minutes != review.TopTags[j].Minutes {
+			return review.TopTags[i].Minutes > review.TopTags[j].Minutes
+		}
+		return review.TopTags[i].Tag < review.TopTags[j].Tag
+	})
+	if len(review.TopTags) > yearReviewTags {
+		review.TopTags = review.TopTags[:yearReviewTags]
+	}
+	return review, nil
+}
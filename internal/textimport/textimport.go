@@ -0,0 +1,232 @@
+// Package textimport reads focus sessions out of plain-text or Markdown
+// notes, for people who tracked their focus time by hand before using
+// focussessions. It is deliberately forgiving: it understands lines like
+//
+//	2024-06-01 09:00-10:30 deep work
+//	- 2024/06/01 9am to 10:30am #writing chapter two
+//	2024-06-01 14:00 for 45m review
+//
+// and date headings ("## 2024-06-01") followed by lines holding only times.
+// Anything else is ignored.
+package textimport
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/adibhanna/focussessions/internal/models"
+)
+
+var (
+	datePattern = regexp.MustCompile(`(\d{4})[-/.](\d{1,2})[-/.](\d{1,2})`)
+
+	clock        = `(\d{1,2})(?::(\d{2}))?\s*(am|pm|a\.m\.|p\.m\.)?`
+	rangePattern = regexp.MustCompile(`(?i)\b` + clock + `\s*(?:-|–|—|to|until)\s*` + clock)
+
+	startPattern    = regexp.MustCompile(`(?i)\b(\d{1,2}):(\d{2})\s*(am|pm)?`)
+	durationPattern = regexp.MustCompile(`(?i)\b(?:for\s+)?(\d+(?:\.\d+)?)\s*(h|hr|hrs|hours?|m|min|mins|minutes?)\b`)
+
+	tagPattern    = regexp.MustCompile(`#([\p{L}\p{N}_-]+)`)
+	bulletPattern = regexp.MustCompile(`^\s*(?:[-*+>|]|\d+[.)]|\[[ xX]\])\s*`)
+)
+
+// Skipped is a line that looked like a session but couldn't be read.
+type Skipped struct {
+	Line   int
+	Text   string
+	Reason string
+}
+
+// Result holds what Parse found.
+type Result struct {
+	Sessions []models.Session
+	Skipped  []Skipped
+}
+
+// Parse reads sessions from r. Times are interpreted in loc.
+func Parse(r io.Reader, loc *time.Location) (Result, error) {
+	var result Result
+	var current time.Time // date set by the most recent date heading
+
+	scanner := bufio.NewScanner(r)
+	lineNo := 0
+	for scanner.Scan() {
+		lineNo++
+		line := scanner.Text()
+		rest := line
+
+		day, hasDate := time.Time{}, false
+		if m := datePattern.FindStringSubmatchIndex(rest); m != nil {
+			d, err := parseDate(rest[m[2]:m[3]], rest[m[4]:m[5]], rest[m[6]:m[7]], loc)
+			if err != nil {
+				result.Skipped = append(result.Skipped, Skipped{lineNo, line, err.Error()})
+				continue
+			}
+			day, hasDate = d, true
+			rest = rest[:m[0]] + " " + rest[m[1]:]
+		}
+
+		start, end, text, ok := parseTimes(rest)
+		if !ok {
+			if hasDate && isHeading(rest) {
+				current = day
+			} else if hasDate {
+				result.Skipped = append(result.Skipped, Skipped{lineNo, line, "no time range"})
+			}
+			continue
+		}
+
+		if hasDate {
+			current = day
+		} else {
+			if current.IsZero() {
+				result.Skipped = append(result.Skipped, Skipped{lineNo, line, "no date"})
+				continue
+			}
+			day = current
+		}
+
+		startTime := day.Add(start)
+		endTime := day.Add(end)
+		if !endTime.After(startTime) {
+			// Ranges like 23:00-01:00 end on the next day
+			endTime = endTime.AddDate(0, 0, 1)
+		}
+		minutes := int(endTime.Sub(startTime).Minutes())
+		if minutes <= 0 || minutes > 24*60 {
+			result.Skipped = append(result.Skipped, Skipped{lineNo, line, "implausible duration"})
+			continue
+		}
+
+		result.Sessions = append(result.Sessions, newSession(startTime, endTime, text))
+	}
+
+	return result, scanner.Err()
+}
+
+func parseDate(year, month, day string, loc *time.Location) (time.Time, error) {
+	y, _ := strconv.Atoi(year)
+	m, _ := strconv.Atoi(month)
+	d, _ := strconv.Atoi(day)
+	t := time.Date(y, time.Month(m), d, 0, 0, 0, 0, loc)
+	if t.Year() != y || int(t.Month()) != m || t.Day() != d {
+		return time.Time{}, fmt.Errorf("invalid date %s-%s-%s", year, month, day)
+	}
+	return t, nil
+}
+
+// parseTimes finds a time range, or a start time followed by a duration, and
+// returns both as offsets from midnight along with the remaining text.
+func parseTimes(s string) (start, end time.Duration, text string, ok bool) {
+	if m := rangePattern.FindStringSubmatchIndex(s); m != nil {
+		sub := func(i int) string {
+			if m[2*i] < 0 {
+				return ""
+			}
+			return s[m[2*i]:m[2*i+1]]
+		}
+		startMeridiem, endMeridiem := sub(3), sub(6)
+		if startMeridiem == "" {
+			// "9-10:30am" shares the meridiem of the end time
+			startMeridiem = endMeridiem
+		}
+		// Bare numbers on both sides ("3-4") are too ambiguous to be times
+		if sub(2) == "" && sub(5) == "" && sub(3) == "" && sub(6) == "" {
+			return 0, 0, "", false
+		}
+		var okStart, okEnd bool
+		start, okStart = clockOffset(sub(1), sub(2), startMeridiem)
+		end, okEnd = clockOffset(sub(4), sub(5), endMeridiem)
+		if okStart && okEnd {
+			return start, end, cleanText(s[:m[0]] + " " + s[m[1]:]), true
+		}
+	}
+
+	if m := startPattern.FindStringSubmatchIndex(s); m != nil {
+		meridiem := ""
+		if m[6] >= 0 {
+			meridiem = s[m[6]:m[7]]
+		}
+		var okStart bool
+		start, okStart = clockOffset(s[m[2]:m[3]], s[m[4]:m[5]], meridiem)
+		rest := s[:m[0]] + " " + s[m[1]:]
+		if d := durationPattern.FindStringSubmatchIndex(rest); okStart && d != nil {
+			amount, _ := strconv.ParseFloat(rest[d[2]:d[3]], 64)
+			unit := time.Minute
+			if strings.HasPrefix(strings.ToLower(rest[d[4]:d[5]]), "h") {
+				unit = time.Hour
+			}
+			end = start + time.Duration(amount*float64(unit))
+			return start, end, cleanText(rest[:d[0]] + " " + rest[d[1]:]), true
+		}
+	}
+
+	return 0, 0, "", false
+}
+
+func clockOffset(hour, minute, meridiem string) (time.Duration, bool) {
+	h, err := strconv.Atoi(hour)
+	if err != nil {
+		return 0, false
+	}
+	m := 0
+	if minute != "" {
+		m, _ = strconv.Atoi(minute)
+	}
+
+	switch strings.ToLower(strings.ReplaceAll(meridiem, ".", "")) {
+	case "am":
+		if h == 12 {
+			h = 0
+		}
+	case "pm":
+		if h < 12 {
+			h += 12
+		}
+	}
+
+	if h > 24 || m > 59 || (h == 24 && m > 0) {
+		return 0, false
+	}
+	return time.Duration(h)*time.Hour + time.Duration(m)*time.Minute, true
+}
+
+// isHeading reports whether what's left of a line after removing its date
+// is just Markdown heading or list decoration.
+func isHeading(rest string) bool {
+	return strings.Trim(rest, " \t#*-_:|[]()=") == "" || strings.HasPrefix(strings.TrimSpace(rest), "#")
+}
+
+func cleanText(s string) string {
+	s = bulletPattern.ReplaceAllString(s, "")
+	return strings.Trim(strings.Join(strings.Fields(s), " "), " -–—:|,;")
+}
+
+func newSession(start, end time.Time, text string) models.Session {
+	session := models.Session{
+		ID:             uuid.New().String(),
+		StartTime:      start,
+		EndTime:        end,
+		Duration:       int(end.Sub(start).Minutes()),
+		Completed:      true,
+		Date:           start.Format("2006-01-02"),
+		Month:          start.Format("2006-01"),
+		Year:           start.Year(),
+		ElapsedSeconds: int(end.Sub(start).Seconds()),
+	}
+	_, session.Week = start.ISOWeek()
+
+	if m := tagPattern.FindStringSubmatchIndex(text); m != nil {
+		session.Tag = text[m[2]:m[3]]
+		text = cleanText(text[:m[0]] + " " + text[m[1]:])
+	}
+	session.Intention = text
+	return session
+}
@@ -0,0 +1,77 @@
+package textimport
+
+import (
+	"slices"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestParse(t *testing.T) {
+	type session struct {
+		start          string // In UTC
+		minutes        int
+		tag, intention string
+	}
+	tests := []struct {
+		name     string
+		input    string
+		sessions []session
+		skipped  []int // Lines skipped
+	}{
+		{"one per line", "2024-06-01 09:00-10:30 deep work\n" +
+			"- 2024/06/01 9am to 10:30am #writing chapter two\n" +
+			"2024-06-01 14:00 for 45m review\n",
+			[]session{
+				{"2024-06-01T09:00:00Z", 90, "", "deep work"},
+				{"2024-06-01T09:00:00Z", 90, "writing", "chapter two"},
+				{"2024-06-01T14:00:00Z", 45, "", "review"},
+			}, nil},
+		{"date headings", "## 2024-06-01\n" +
+			"- 9-10:30am outline\n" +
+			"- 23:00-01:00 late night\n" +
+			"\n" +
+			"# 2024-06-02\n" +
+			"* 1:15pm for 1.5h #reading\n",
+			[]session{
+				{"2024-06-01T09:00:00Z", 90, "", "outline"},
+				{"2024-06-01T23:00:00Z", 120, "", "late night"},
+				{"2024-06-02T13:15:00Z", 90, "reading", ""},
+			}, nil},
+		{"malformed lines", "09:00-10:00 before any date\n" +
+			"2024-02-30 09:00-10:00 no such day\n" +
+			"2024-06-01 went for a walk\n" +
+			"2024-06-01 25:00-26:00 past midnight twice\n" +
+			"2024-13-01 09:00-10:00 no such month\n" +
+			"2024-06-01 10:00-11:00 fine\n",
+			[]session{{"2024-06-01T10:00:00Z", 60, "", "fine"}},
+			[]int{1, 2, 3, 4, 5}},
+		{"prose ignored", "Met with the team, 3-4 people.\nNothing else today.\n", nil, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := Parse(strings.NewReader(tt.input), time.UTC)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var got []session
+			for _, s := range result.Sessions {
+				got = append(got, session{s.StartTime.UTC().Format(time.RFC3339), s.Duration, s.Tag, s.Intention})
+				if s.ID == "" || !s.Completed || s.Date != s.StartTime.Format("2006-01-02") {
+					t.Errorf("session %+v isn't a completed session with an ID and date", s)
+				}
+			}
+			if !slices.Equal(got, tt.sessions) {
+				t.Errorf("sessions %+v, want %+v", got, tt.sessions)
+			}
+			var skipped []int
+			for _, s := range result.Skipped {
+				skipped = append(skipped, s.Line)
+			}
+			if !slices.Equal(skipped, tt.skipped) {
+				t.Errorf("skipped lines %v (%+v), want %v", skipped, result.Skipped, tt.skipped)
+			}
+		})
+	}
+}
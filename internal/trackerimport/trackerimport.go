@@ -0,0 +1,257 @@
+// Package trackerimport reads sessions exported from other time trackers:
+//
+//   - toggl: the Toggl Track "detailed report" CSV, with "Start date",
+//     "Start time", "End date", "End time", "Project", "Description" and
+//     "Tags" columns
+//   - pomofocus: the Pomofocus report CSV, with "Date", "Project", "Task"
+//     and "Minutes" columns. It has no start times, so each day's rows are
+//     laid end to end from the start of the work day
+//   - csv: a generic CSV with a "start" column and either "end" or
+//     "duration", plus optional "tag", "project" and "intention" (or
+//     "description") columns
+//
+// Column names are matched case-insensitively.
+package trackerimport
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/adibhanna/focussessions/internal/models"
+)
+
+// Supported formats.
+const (
+	FormatToggl     = "toggl"
+	FormatPomofocus = "pomofocus"
+	FormatCSV       = "csv"
+)
+
+// Formats lists the supported formats.
+var Formats = []string{FormatToggl, FormatPomofocus, FormatCSV}
+
+// Skipped is a row that couldn't be read.
+type Skipped struct {
+	Row    int
+	Reason string
+}
+
+// Result holds what Parse found.
+type Result struct {
+	Sessions []models.Session
+	Skipped  []Skipped
+}
+
+// Options tune how rows are read.
+type Options struct {
+	// Location is the zone times without an offset are read in.
+	Location *time.Location
+	// DayStartHour is where rows without a start time (pomofocus) begin.
+	DayStartHour int
+}
+
+// Parse reads sessions in format from r.
+func Parse(format string, r io.Reader, opts Options) (Result, error) {
+	if opts.Location == nil {
+		opts.Location = time.Local
+	}
+
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+	header, err := reader.Read()
+	if err == io.EOF {
+		return Result{}, nil
+	}
+	if err != nil {
+		return Result{}, err
+	}
+	cols := newColumns(header)
+
+	var row func(rec record) (models.Session, error)
+	switch format {
+	case FormatToggl:
+		row = togglRow(opts)
+	case FormatPomofocus:
+		row = pomofocusRow(opts)
+	case FormatCSV:
+		row = csvRow(opts)
+	default:
+		return Result{}, fmt.Errorf("unknown format %q (want %s)", format, strings.Join(Formats, ", "))
+	}
+
+	var result Result
+	rowNo := 1
+	for {
+		fields, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		rowNo++
+		if err != nil {
+			result.Skipped = append(result.Skipped, Skipped{rowNo, err.Error()})
+			continue
+		}
+
+		session, err := row(record{cols, fields})
+		if err != nil {
+			result.Skipped = append(result.Skipped, Skipped{rowNo, err.Error()})
+			continue
+		}
+		result.Sessions = append(result.Sessions, session)
+	}
+	return result, nil
+}
+
+// columns maps lower-cased header names to their index.
+type columns map[string]int
+
+func newColumns(header []string) columns {
+	cols := make(columns, len(header))
+	for i, name := range header {
+		name = strings.ToLower(strings.TrimSpace(strings.TrimPrefix(name, "\ufeff")))
+		if _, ok := cols[name]; !ok {
+			cols[name] = i
+		}
+	}
+	return cols
+}
+
+type record struct {
+	cols   columns
+	fields []string
+}
+
+// get returns the first of the named columns that has a value.
+func (r record) get(names ...string) string {
+	for _, name := range names {
+		if i, ok := r.cols[name]; ok && i < len(r.fields) {
+			if v := strings.TrimSpace(r.fields[i]); v != "" {
+				return v
+			}
+		}
+	}
+	return ""
+}
+
+func togglRow(opts Options) func(record) (models.Session, error) {
+	return func(rec record) (models.Session, error) {
+		start, err := parseTime(rec.get("start date")+" "+rec.get("start time"), opts.Location)
+		if err != nil {
+			return models.Session{}, err
+		}
+		end, err := parseTime(rec.get("end date")+" "+rec.get("end time"), opts.Location)
+		if err != nil {
+			return models.Session{}, err
+		}
+		tag, _, _ := strings.Cut(rec.get("tags"), ",")
+		return newSession(start, end, strings.TrimSpace(tag), rec.get("project"), rec.get("description"))
+	}
+}
+
+func pomofocusRow(opts Options) func(record) (models.Session, error) {
+	next := make(map[string]time.Time) // where the next row of a day starts
+	return func(rec record) (models.Session, error) {
+		date := rec.get("date")
+		day, err := time.ParseInLocation("2006-01-02", date, opts.Location)
+		if err != nil {
+			return models.Session{}, fmt.Errorf("invalid date %q", date)
+		}
+		minutes, err := strconv.ParseFloat(rec.get("minutes", "minute", "duration"), 64)
+		if err != nil || minutes <= 0 {
+			return models.Session{}, errors.New("no minutes")
+		}
+
+		start, ok := next[date]
+		if !ok {
+			start = day.Add(time.Duration(opts.DayStartHour) * time.Hour)
+		}
+		end := start.Add(time.Duration(minutes * float64(time.Minute)))
+		next[date] = end
+		return newSession(start, end, "", rec.get("project"), rec.get("task"))
+	}
+}
+
+func csvRow(opts Options) func(record) (models.Session, error) {
+	return func(rec record) (models.Session, error) {
+		start, err := parseTime(rec.get("start", "start_time", "started"), opts.Location)
+		if err != nil {
+			return models.Session{}, err
+		}
+
+		var end time.Time
+		if v := rec.get("end", "end_time", "ended"); v != "" {
+			if end, err = parseTime(v, opts.Location); err != nil {
+				return models.Session{}, err
+			}
+		} else {
+			d, err := parseDuration(rec.get("duration", "minutes"))
+			if err != nil {
+				return models.Session{}, err
+			}
+			end = start.Add(d)
+		}
+		return newSession(start, end, rec.get("tag", "tags"), rec.get("project"), rec.get("intention", "description", "task"))
+	}
+}
+
+var timeLayouts = []string{
+	time.RFC3339,
+	"2006-01-02T15:04:05",
+	"2006-01-02T15:04",
+	"2006-01-02 15:04:05",
+	"2006-01-02 15:04",
+}
+
+func parseTime(s string, loc *time.Location) (time.Time, error) {
+	s = strings.TrimSpace(s)
+	for _, layout := range timeLayouts {
+		if t, err := time.ParseInLocation(layout, s, loc); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("invalid time %q", s)
+}
+
+// parseDuration reads plain minutes ("45") or a Go duration ("1h30m").
+func parseDuration(s string) (time.Duration, error) {
+	if minutes, err := strconv.ParseFloat(s, 64); err == nil {
+		return time.Duration(minutes * float64(time.Minute)), nil
+	}
+	d, err := time.ParseDuration(s)
+	if err != nil {
+		return 0, fmt.Errorf("invalid duration %q", s)
+	}
+	return d, nil
+}
+
+func newSession(start, end time.Time, tag, project, intention string) (models.Session, error) {
+	span := end.Sub(start)
+	if span < time.Minute || span > 24*time.Hour {
+		return models.Session{}, errors.New("implausible duration")
+	}
+
+	session := models.Session{
+		ID:             uuid.New().String(),
+		StartTime:      start,
+		EndTime:        end,
+		Duration:       int(span.Minutes()),
+		Completed:      true,
+		Date:           start.Format("2006-01-02"),
+		Month:          start.Format("2006-01"),
+		Year:           start.Year(),
+		ElapsedSeconds: int(span.Seconds()),
+		Tag:            tag,
+		Project:        project,
+		Intention:      intention,
+	}
+	_, session.Week = start.ISOWeek()
+	return session, nil
+}
@@ -0,0 +1,90 @@
+package trackerimport
+
+import (
+	"slices"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name, format, input string
+		starts              []string // Start times of the sessions read, in UTC
+		minutes             []int
+		skipped             []int // Rows skipped
+	}{
+		{"toggl", FormatToggl,
+			"Project,Description,Start date,Start time,End date,End time,Tags\n" +
+				"Book,Chapter 3,2025-03-12,09:00:00,2025-03-12,10:30:00,\"writing, deep\"\n" +
+				"Book,Bad time,2025-03-12,9am,2025-03-12,10:00:00,\n" +
+				"Book,Too short,2025-03-12,11:00:00,2025-03-12,11:00:30,\n" +
+				"Book,Backwards,2025-03-12,12:00:00,2025-03-12,11:00:00,\n",
+			[]string{"2025-03-12T09:00:00Z"}, []int{90}, []int{3, 4, 5}},
+		{"pomofocus", FormatPomofocus,
+			"Date,Project,Task,Minutes\n" +
+				"2025-03-12,Book,Outline,25\n" +
+				"2025-03-12,Book,Draft,50\n" +
+				"2025-03-13,Book,Edit,25\n" +
+				"12/03/2025,Book,Wrong date,25\n" +
+				"2025-03-13,Book,No minutes,\n" +
+				"2025-03-13,Book,Negative,-5\n",
+			[]string{"2025-03-12T09:00:00Z", "2025-03-12T09:25:00Z", "2025-03-13T09:00:00Z"}, []int{25, 50, 25}, []int{5, 6, 7}},
+		{"csv with end", FormatCSV,
+			"\ufeffStart,End,Tag\n" +
+				"2025-03-12T09:00:00Z,2025-03-12T09:45:00Z,writing\n" +
+				"2025-03-12 14:00,2025-03-12 14:25,\n" +
+				"yesterday,2025-03-12 14:25,\n" +
+				"2025-03-12 14:00,2025-03-14 14:00,\n",
+			[]string{"2025-03-12T09:00:00Z", "2025-03-12T14:00:00Z"}, []int{45, 25}, []int{4, 5}},
+		{"csv with duration", FormatCSV,
+			"start,duration\n" +
+				"2025-03-12 09:00,45\n" +
+				"2025-03-12 10:00,1h30m\n" +
+				"2025-03-12 12:00,a while\n" +
+				"2025-03-12 13:00,0\n" +
+				"2025-03-12 14:00\n",
+			[]string{"2025-03-12T09:00:00Z", "2025-03-12T10:00:00Z"}, []int{45, 90}, []int{4, 5, 6}},
+		{"unbalanced quotes", FormatCSV,
+			"start,duration\n" +
+				"2025-03-12 09:00,45\n" +
+				"\"2025-03-12 10:00,45\n",
+			[]string{"2025-03-12T09:00:00Z"}, []int{45}, []int{3}},
+		{"header only", FormatCSV, "start,end\n", nil, nil, nil},
+		{"empty", FormatToggl, "", nil, nil, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := Parse(tt.format, strings.NewReader(tt.input), Options{Location: time.UTC, DayStartHour: 9})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var starts []string
+			var minutes []int
+			for _, session := range result.Sessions {
+				starts = append(starts, session.StartTime.UTC().Format(time.RFC3339))
+				minutes = append(minutes, session.Duration)
+				if session.ID == "" || !session.Completed || session.Date != session.StartTime.Format("2006-01-02") {
+					t.Errorf("session %+v isn't a completed session with an ID and date", session)
+				}
+			}
+			var skipped []int
+			for _, s := range result.Skipped {
+				skipped = append(skipped, s.Row)
+			}
+			if !slices.Equal(starts, tt.starts) || !slices.Equal(minutes, tt.minutes) {
+				t.Errorf("sessions at %v of %v minutes, want %v of %v", starts, minutes, tt.starts, tt.minutes)
+			}
+			if !slices.Equal(skipped, tt.skipped) {
+				t.Errorf("skipped rows %v (%+v), want %v", skipped, result.Skipped, tt.skipped)
+			}
+		})
+	}
+}
+
+func TestParseUnknownFormat(t *testing.T) {
+	if _, err := Parse("clockify", strings.NewReader("start,end\n"), Options{}); err == nil {
+		t.Error("unknown format accepted")
+	}
+}
@@ -0,0 +1,71 @@
+// Package chart draws small text charts from block characters.
+package chart
+
+import (
+	"strings"
+)
+
+// levels are the eighth blocks used to draw partial bar heights.
+var levels = []rune{' ', '▁', '▂', '▃', '▄', '▅', '▆', '▇', '█'}
+
+// Resample fits values into at most width columns, averaging the values
+// that share a column.
+func Resample(values []float64, width int) []float64 {
+	if width <= 0 || len(values) <= width {
+		return values
+	}
+	out := make([]float64, width)
+	for col := range out {
+		from := col * len(values) / width
+		to := (col + 1) * len(values) / width
+		sum := 0.0
+		for _, v := range values[from:to] {
+			sum += v
+		}
+		out[col] = sum / float64(to-from)
+	}
+	return out
+}
+
+// Bars renders values as a bar chart height rows tall, one column per value
+// after resampling to width. The tallest value fills the chart; an all-zero
+// series renders as a baseline.
+func Bars(values []float64, width, height int) []string {
+	values = Resample(values, width)
+	height = max(height, 1)
+
+	peak := 0.0
+	for _, v := range values {
+		peak = max(peak, v)
+	}
+
+	rows := make([]strings.Builder, height)
+	for _, v := range values {
+		eighths := 0
+		if peak > 0 {
+			eighths = int(v / peak * float64(height*8))
+		}
+		if v > 0 && eighths == 0 {
+			eighths = 1 // keep small values visible
+		}
+		for r := range rows {
+			// rows[0] is the top row
+			fill := eighths - (height-1-r)*8
+			rows[r].WriteRune(levels[max(0, min(fill, 8))])
+		}
+	}
+
+	out := make([]string, height)
+	for r := range rows {
+		out[r] = rows[r].String()
+	}
+	if peak == 0 {
+		out[height-1] = strings.Repeat("▁", len(values))
+	}
+	return out
+}
+
+// Sparkline renders values as a single row of bars.
+func Sparkline(values []float64) string {
+	return Bars(values, len(values), 1)[0]
+}
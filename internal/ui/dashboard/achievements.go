@@ -0,0 +1,109 @@
+package dashboard
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/adibhanna/focussessions/internal/achievements"
+	"github.com/adibhanna/focussessions/internal/ui/theme"
+)
+
+// toastDuration is how long a toast, such as an unlocked achievement, is
+// shown for.
+const toastDuration = 5 * time.Second
+
+type clearToastMsg struct{}
+
+// checkAchievements unlocks newly earned badges and announces them in a
+// toast that clears itself.
+func (m *Model) checkAchievements() tea.Cmd {
+	fresh, err := achievements.Check(m.storage, m.now())
+	if err != nil || len(fresh) == 0 {
+		return nil
+	}
+
+	names := make([]string, len(fresh))
+	for i, badge := range fresh {
+		names[i] = badge.Icon + " " + badge.Name
+	}
+	m.toast = "🏆 Achievement unlocked: " + strings.Join(names, ", ")
+	return clearToastAfter()
+}
+
+// clearToastAfter clears the toast once it has been shown long enough.
+func clearToastAfter() tea.Cmd {
+	return tea.Tick(toastDuration, func(time.Time) tea.Msg {
+		return clearToastMsg{}
+	})
+}
+
+func (m Model) renderToast() string {
+	toast := m.toast
+	if toast == "" {
+		toast = m.undoNote()
+	}
+	if toast == "" {
+		return ""
+	}
+	return lipgloss.NewStyle().
+		Foreground(theme.Highlight).
+		Bold(true).
+		Align(lipgloss.Center).
+		Render(toast)
+}
+
+func (m *Model) loadAchievements() {
+	unlocked, err := m.storage.GetAchievements()
+	if err != nil {
+		unlocked = nil
+	}
+	m.unlocked = unlocked
+}
+
+func (m Model) renderAchievementsView() string {
+	containerStyle := lipgloss.NewStyle().
+		Width(m.width).
+		Height(m.height).
+		Padding(2)
+
+	titleStyle := lipgloss.NewStyle().
+		Bold(true).
+		Foreground(theme.Accent).
+		MarginBottom(1).
+		Align(lipgloss.Center)
+
+	unlockedStyle := lipgloss.NewStyle().
+		Foreground(theme.Highlight)
+
+	lockedStyle := lipgloss.NewStyle().
+		Foreground(theme.Dim)
+
+	detailStyle := lipgloss.NewStyle().
+		Foreground(theme.Muted).
+		PaddingLeft(5)
+
+	title := titleStyle.Render(fmt.Sprintf("🏆 Achievements - %d of %d unlocked", len(m.unlocked), len(achievements.Badges)))
+
+	parts := []string{title}
+	for _, badge := range achievements.Badges {
+		at, ok := m.unlocked[badge.ID]
+		if !ok {
+			parts = append(parts,
+				lockedStyle.Render("  🔒 "+badge.Name),
+				detailStyle.Render(badge.Description),
+			)
+			continue
+		}
+		parts = append(parts,
+			unlockedStyle.Render(fmt.Sprintf("  %s %s", badge.Icon, badge.Name)),
+			detailStyle.Render(fmt.Sprintf("%s • %s", badge.Description, at.Format("Jan 2, 2006"))),
+		)
+	}
+	parts = append(parts, m.renderHelp())
+
+	return containerStyle.Render(lipgloss.JoinVertical(lipgloss.Left, parts...))
+}
@@ -0,0 +1,95 @@
+package dashboard
+
+import (
+	"time"
+
+	"github.com/adibhanna/focussessions/internal/models"
+)
+
+// averagePerDay spreads count over the days from from up to to that have
+// elapsed, so that early in a period the average isn't diluted by the days
+// still to come. Days marked off are left out, and depending on the
+// average_days option so are days without sessions or weekends.
+func (m Model) averagePerDay(count int, from, to time.Time) float64 {
+	now := m.now()
+	if tomorrow := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location()).AddDate(0, 0, 1); to.After(tomorrow) {
+		to = tomorrow
+	}
+
+	basis := m.config.AverageBasis()
+	var sessions []int
+	if basis == models.AverageActive {
+		counts, err := m.storage.GetDailySessionCounts(from, to)
+		if err != nil {
+			return 0
+		}
+		sessions = counts
+	}
+
+	days := 0
+	for i, d := 0, from; d.Before(to); i, d = i+1, d.AddDate(0, 0, 1) {
+		if _, off := m.offDays[d.Format("2006-01-02")]; off {
+			continue
+		}
+		switch basis {
+		case models.AverageActive:
+			if i >= len(sessions) || sessions[i] == 0 {
+				continue
+			}
+		case models.AverageWorkdays:
+			if d.Weekday() == time.Saturday || d.Weekday() == time.Sunday {
+				continue
+			}
+		}
+		days++
+	}
+	if days == 0 {
+		return 0
+	}
+	return float64(count) / float64(days)
+}
+
+// averageDayName names the days averages are taken over, as in "per day".
+func (m Model) averageDayName() string {
+	switch m.config.AverageBasis() {
+	case models.AverageActive:
+		return "active day"
+	case models.AverageWorkdays:
+		return "workday"
+	}
+	return "day"
+}
+
+// monthsElapsed returns how many months of the year in the stats view have
+// started.
+func (m Model) monthsElapsed() int {
+	if now := m.now(); m.yearStats.Year == now.Year() {
+		return int(now.Month())
+	}
+	return 12
+}
+
+// weekRange returns the first day of the week in the stats view and the
+// first day of the week after.
+func (m Model) weekRange() (time.Time, time.Time) {
+	from := models.WeekStart(m.statsDay(), m.storage.FirstWeekday())
+	return from, from.AddDate(0, 0, 7)
+}
+
+// monthRange returns the first day of the month in the stats view and the
+// first day of the month after.
+func (m Model) monthRange() (time.Time, time.Time) {
+	month, err := time.ParseInLocation("2006-01", m.monthStats.Month, time.Local)
+	if err != nil {
+		now := m.now()
+		month = time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.Local)
+	}
+	return month, month.AddDate(0, 1, 0)
+}
+
+// yearRange returns January 1st of the year in the stats view and of the
+// year after.
+func (m Model) yearRange() (time.Time, time.Time) {
+	from := time.Date(m.yearStats.Year, time.January, 1, 0, 0, 0, 0, time.Local)
+	return from, from.AddDate(1, 0, 0)
+}
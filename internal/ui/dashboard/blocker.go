@@ -0,0 +1,45 @@
+package dashboard
+
+import (
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/adibhanna/focussessions/internal/blocker"
+)
+
+// blockFailedMsg reports that sites couldn't be blocked or unblocked.
+type blockFailedMsg struct{ err error }
+
+func (m Model) blocker() blocker.Blocker {
+	return blocker.New(m.config, m.storage.BlockStatePath())
+}
+
+// blockSites blocks the configured sites while a session runs.
+func (m Model) blockSites() tea.Cmd {
+	b := m.blocker()
+	if !b.Enabled() {
+		return nil
+	}
+	return func() tea.Msg {
+		if err := b.Block(); err != nil {
+			return blockFailedMsg{err: err}
+		}
+		return nil
+	}
+}
+
+// unblockSites lifts the block once a session ends. It runs even with
+// blocking switched off, to clean up after an earlier configuration.
+func (m Model) unblockSites() tea.Cmd {
+	b := m.blocker()
+	return func() tea.Msg {
+		if err := b.Unblock(); err != nil {
+			return blockFailedMsg{err: err}
+		}
+		return nil
+	}
+}
+
+func (m Model) updateBlockFailed(msg blockFailedMsg) (tea.Model, tea.Cmd) {
+	m.toast = "🚫 " + msg.err.Error()
+	return m, clearToastAfter()
+}
@@ -0,0 +1,43 @@
+package dashboard
+
+import (
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/adibhanna/focussessions/internal/models"
+	"github.com/adibhanna/focussessions/internal/notify"
+	"github.com/adibhanna/focussessions/internal/ui/theme"
+)
+
+// breakPrompt returns the activity suggested for the running break, or ""
+// when prompts are off. Each break suggests the next one.
+func (m Model) breakPrompt() string {
+	if !m.onBreak || m.breaksTaken == 0 {
+		return ""
+	}
+	return m.config.BreakPrompt(m.breaksTaken - 1)
+}
+
+// notifyBreakPrompt sends the break's activity as a desktop notification
+// when break_reminders is set to notify, outside the quiet hours.
+func (m Model) notifyBreakPrompt() tea.Cmd {
+	prompt := m.breakPrompt()
+	if prompt == "" || m.config.BreakReminderMode() != models.BreakRemindersNotify || !m.config.NotifyAt(m.now()) {
+		return nil
+	}
+	return func() tea.Msg {
+		notify.Send("Break time", prompt)
+		return nil
+	}
+}
+
+func (m Model) renderBreakPrompt() string {
+	prompt := m.breakPrompt()
+	if prompt == "" {
+		return ""
+	}
+	return lipgloss.NewStyle().
+		Foreground(theme.Calm).
+		Align(lipgloss.Center).
+		Render("🌿 " + prompt)
+}
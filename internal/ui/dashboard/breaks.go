@@ -0,0 +1,116 @@
+package dashboard
+
+import (
+	"fmt"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/adibhanna/focussessions/internal/hooks"
+	"github.com/adibhanna/focussessions/internal/models"
+	"github.com/adibhanna/focussessions/internal/outbox"
+	"github.com/adibhanna/focussessions/internal/ui/theme"
+)
+
+// Pending automatic transitions when auto-continue is enabled.
+const (
+	chainNone = iota
+	chainBreak
+	chainSession
+)
+
+// chainTickMsg counts down a pending transition. id ties it to the
+// transition that scheduled it so stale ticks are ignored.
+type chainTickMsg struct{ id int }
+
+func chainTickCmd(id int) tea.Cmd {
+	return tea.Tick(time.Second, func(time.Time) tea.Msg {
+		return chainTickMsg{id: id}
+	})
+}
+
+// startBreak runs a break on the session timer. Breaks aren't stored as
+// sessions and don't count towards any stats.
+func (m Model) startBreak() (tea.Model, tea.Cmd) {
+	m.chainNext = chainNone
+	m.onBreak = true
+	m.timerRunning = true
+	m.timerPaused = false
+	m.timerElapsed = 0
+	m.timerDuration = m.config.BreakDuration * 60
+	m.breaksTaken++
+	m.startRun()
+
+	hook := m.startHook(hooks.Payload{Event: hooks.OnBreakStart, BreakMinutes: m.config.BreakDuration})
+	return m, tea.Batch(tickCmd(), hook, m.controlMusic(false), m.notifyBreakPrompt())
+}
+
+// finishBreak ends the running break. A break that ran to the end chains
+// into the next session when auto-continue is on.
+func (m Model) finishBreak(completed bool) (tea.Model, tea.Cmd) {
+	m.onBreak = false
+	m.timerRunning = false
+	m.timerPaused = false
+	m.timerElapsed = 0
+
+	if !completed {
+		return m, nil
+	}
+	outbox.PublishBreak(m.storage, m.config, m.now())
+	spoken := m.speak(models.SpeechBreakOver, "Break over")
+	if m.config.AutoContinue {
+		next, cmd := m.scheduleChain(chainSession)
+		return next, tea.Batch(cmd, spoken)
+	}
+	return m, tea.Batch(tea.Printf("*** Break over! Ready for the next session? ***"), spoken)
+}
+
+// scheduleChain starts the confirm countdown before the next transition.
+func (m Model) scheduleChain(next int) (tea.Model, tea.Cmd) {
+	m.chainNext = next
+	m.chainCountdown = m.config.AutoContinueDelay
+	m.chainID++
+	if m.chainCountdown <= 0 {
+		return m.runChain()
+	}
+	return m, chainTickCmd(m.chainID)
+}
+
+func (m Model) updateChain(msg chainTickMsg) (tea.Model, tea.Cmd) {
+	if msg.id != m.chainID || m.chainNext == chainNone {
+		return m, nil
+	}
+	m.chainCountdown--
+	if m.chainCountdown <= 0 {
+		return m.runChain()
+	}
+	return m, chainTickCmd(m.chainID)
+}
+
+func (m Model) runChain() (tea.Model, tea.Cmd) {
+	next := m.chainNext
+	m.chainNext = chainNone
+	switch next {
+	case chainBreak:
+		return m.startBreak()
+	case chainSession:
+		return m.startNewSession(m.lastLabels)
+	}
+	return m, nil
+}
+
+func (m Model) renderChainNotice() string {
+	if m.chainNext == chainNone {
+		return ""
+	}
+
+	what := "Break"
+	if m.chainNext == chainSession {
+		what = "Next session"
+	}
+	return lipgloss.NewStyle().
+		Foreground(theme.Highlight).
+		Align(lipgloss.Center).
+		Render(fmt.Sprintf("%s starts in %ds • enter: start now • esc: stay", what, m.chainCountdown))
+}
@@ -0,0 +1,75 @@
+package dashboard
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/adibhanna/focussessions/internal/ui/theme"
+)
+
+// Box breathing: four equal phases of breathing in, holding, breathing out
+// and holding again.
+const breathPhaseSeconds = 4
+
+var breathPhases = []string{"Breathe in", "Hold", "Breathe out", "Hold"}
+
+// breathPhase returns the current phase and how many seconds into it the
+// break is.
+func breathPhase(elapsed int) (phase, second int) {
+	cycle := elapsed % (breathPhaseSeconds * len(breathPhases))
+	return cycle / breathPhaseSeconds, cycle % breathPhaseSeconds
+}
+
+// breathSize is the size of the breathing box, from 1 (empty lungs) to
+// breathPhaseSeconds+1 (full).
+func breathSize(phase, second int) int {
+	switch phase {
+	case 0:
+		return second + 2
+	case 1:
+		return breathPhaseSeconds + 1
+	case 2:
+		return breathPhaseSeconds - second
+	}
+	return 1
+}
+
+// renderBreathing draws a box that expands while breathing in and contracts
+// while breathing out, with the phase and a countdown underneath. The box
+// always takes the same space so the layout doesn't jump.
+func (m Model) renderBreathing() string {
+	phase, second := breathPhase(m.timerElapsed)
+	size := breathSize(phase, second)
+	full := breathPhaseSeconds + 1
+
+	boxStyle := lipgloss.NewStyle().Foreground(theme.Calm)
+	row := strings.Repeat("██", size)
+
+	var rows []string
+	for i := 0; i < full; i++ {
+		if i >= (full-size)/2 && i < (full-size)/2+size {
+			rows = append(rows, boxStyle.Render(row))
+		} else {
+			rows = append(rows, "")
+		}
+	}
+	box := lipgloss.NewStyle().
+		Width(full * 2).
+		Align(lipgloss.Center).
+		Render(lipgloss.JoinVertical(lipgloss.Center, rows...))
+
+	label := lipgloss.NewStyle().
+		Foreground(theme.Muted).
+		MarginBottom(1).
+		Render(fmt.Sprintf("%s… %d", breathPhases[phase], breathPhaseSeconds-second))
+
+	return lipgloss.JoinVertical(lipgloss.Center, box, label)
+}
+
+// breathingText is the one-line version of the guide for the compact view.
+func (m Model) breathingText() string {
+	phase, second := breathPhase(m.timerElapsed)
+	return fmt.Sprintf("🫁 %s… %d", breathPhases[phase], breathPhaseSeconds-second)
+}
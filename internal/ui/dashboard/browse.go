@@ -0,0 +1,121 @@
+package dashboard
+
+import (
+	"time"
+
+	"github.com/adibhanna/focussessions/internal/models"
+)
+
+// isDetailView reports whether state is one of the daily, weekly, monthly
+// or yearly details that ←/→ step through.
+func isDetailView(state ViewState) bool {
+	switch state {
+	case StatsDetailDaily, StatsDetailWeekly, StatsDetailMonthly, StatsDetailYearly:
+		return true
+	}
+	return false
+}
+
+// statsDay returns the day the stats detail views are showing: today, unless
+// ← has stepped back to an earlier day, week, month or year.
+func (m Model) statsDay() time.Time {
+	if !m.statsAt.IsZero() {
+		return m.statsAt
+	}
+	now := m.now()
+	return time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.Local)
+}
+
+// stepStats moves the detail view back (step -1) or forward (step 1) by a
+// day, week, month or year, stopping at the current one.
+func (m *Model) stepStats(step int) {
+	day := m.statsDay()
+	var next time.Time
+	switch m.viewState {
+	case StatsDetailDaily:
+		next = day.AddDate(0, 0, step)
+	case StatsDetailWeekly:
+		next = models.WeekStart(day, m.storage.FirstWeekday()).AddDate(0, 0, 7*step)
+	case StatsDetailMonthly:
+		next = time.Date(day.Year(), day.Month()+time.Month(step), 1, 0, 0, 0, 0, time.Local)
+	case StatsDetailYearly:
+		next = time.Date(day.Year()+step, time.January, 1, 0, 0, 0, 0, time.Local)
+	default:
+		return
+	}
+
+	// next starts its period, so it is after today only past the current one
+	now := m.now()
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.Local)
+	if next.After(today) {
+		return
+	}
+	m.statsAt = next
+	if m.periodStart(today).Equal(next) {
+		m.statsAt = time.Time{}
+	}
+	m.loadStatsDay()
+}
+
+// periodStart returns the first day of the day, week, month or year in the
+// current detail view that day falls in.
+func (m Model) periodStart(day time.Time) time.Time {
+	switch m.viewState {
+	case StatsDetailWeekly:
+		return models.WeekStart(day, m.storage.FirstWeekday())
+	case StatsDetailMonthly:
+		return time.Date(day.Year(), day.Month(), 1, 0, 0, 0, 0, time.Local)
+	case StatsDetailYearly:
+		return time.Date(day.Year(), time.January, 1, 0, 0, 0, 0, time.Local)
+	}
+	return day
+}
+
+// loadStatsDay loads the period shown in the current detail view, and what
+// goes with it, for the day returned by statsDay.
+func (m *Model) loadStatsDay() {
+	day := m.statsDay()
+	switch m.viewState {
+	case StatsDetailDaily:
+		if stats, err := m.storage.GetDayStats(day.Format("2006-01-02")); err == nil {
+			m.todayStats = stats
+		}
+		m.refreshJournal()
+
+	case StatsDetailWeekly:
+		weekYear, week := m.storage.WeekOf(day)
+		if stats, err := m.storage.GetWeekStats(weekYear, week); err == nil {
+			m.weekStats = stats
+		}
+		m.refreshComparisons()
+		// Targets are for the current week only
+		m.burndown = nil
+		if m.statsAt.IsZero() {
+			m.refreshBurndown()
+		}
+
+	case StatsDetailMonthly:
+		if stats, err := m.storage.GetMonthStats(day.Year(), int(day.Month())); err == nil {
+			m.monthStats = stats
+		}
+		m.refreshComparisons()
+		m.loadMonthTrend()
+
+	case StatsDetailYearly:
+		if stats, err := m.storage.GetYearStats(day.Year()); err == nil {
+			m.yearStats = stats
+		}
+		m.loadYearTrend()
+	}
+}
+
+// resetStatsDay brings the stats back to the current period after browsing
+// earlier ones.
+func (m *Model) resetStatsDay() {
+	if m.statsAt.IsZero() {
+		return
+	}
+	m.statsAt = time.Time{}
+	m.refreshStats()
+	m.refreshBurndown()
+}
@@ -0,0 +1,95 @@
+package dashboard
+
+import (
+	"fmt"
+
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/adibhanna/focussessions/internal/buddy"
+	"github.com/adibhanna/focussessions/internal/ui/theme"
+)
+
+// toggleBuddy shows or hides the co-working buddy and remembers the choice.
+func (m *Model) toggleBuddy() {
+	m.config.Buddy = !m.config.Buddy
+	m.storage.SaveConfig(m.config)
+	m.refreshBuddy()
+}
+
+// refreshBuddy updates the buddy's timer. A friend whose directory can't be
+// read shows as offline.
+func (m *Model) refreshBuddy() {
+	if !m.config.Buddy {
+		return
+	}
+	if m.config.BuddyDir == "" {
+		m.buddy = buddy.Virtual(m.config, m.now())
+		return
+	}
+	status, err := buddy.Friend(m.config.BuddyDir, m.now())
+	if err != nil {
+		status = buddy.Status{Name: "Friend"}
+	}
+	m.buddy = status
+}
+
+func buddyState(status buddy.Status) string {
+	switch {
+	case !status.Online:
+		return "offline"
+	case status.Focusing:
+		return "focusing"
+	default:
+		return "on a break"
+	}
+}
+
+func buddyClock(status buddy.Status) string {
+	if !status.Online {
+		return "--:--"
+	}
+	return fmt.Sprintf("%02d:%02d", status.Remaining/60, status.Remaining%60)
+}
+
+// renderBuddyPanel draws the buddy's countdown as a box to sit beside the
+// big clock.
+func (m Model) renderBuddyPanel() string {
+	panelStyle := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(theme.Success).
+		Padding(1, 2).
+		MarginLeft(2).
+		MarginBottom(3).
+		Align(lipgloss.Center)
+
+	nameStyle := lipgloss.NewStyle().
+		Foreground(theme.Success)
+
+	clockStyle := lipgloss.NewStyle().
+		Bold(true).
+		Foreground(theme.Text).
+		MarginTop(1).
+		MarginBottom(1)
+
+	stateStyle := lipgloss.NewStyle().
+		Foreground(theme.Muted)
+
+	return panelStyle.Render(lipgloss.JoinVertical(
+		lipgloss.Center,
+		nameStyle.Render("🧑 "+m.buddy.Name),
+		clockStyle.Render(buddyClock(m.buddy)),
+		stateStyle.Render(buddyState(m.buddy)),
+	))
+}
+
+// buddyLine is the single-line buddy status used where the panel doesn't
+// fit.
+func (m Model) buddyLine() string {
+	lineStyle := lipgloss.NewStyle().
+		Foreground(theme.Success)
+
+	if !m.buddy.Online {
+		return lineStyle.Render(fmt.Sprintf("🧑 %s: offline", m.buddy.Name))
+	}
+	return lineStyle.Render(fmt.Sprintf("🧑 %s: %s %s", m.buddy.Name, buddyClock(m.buddy), buddyState(m.buddy)))
+}
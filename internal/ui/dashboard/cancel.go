@@ -0,0 +1,41 @@
+package dashboard
+
+import (
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/adibhanna/focussessions/internal/ui/theme"
+)
+
+// openCancelConfirm asks before cancelling the running session, so a stray
+// keypress doesn't throw away the time spent. The timer keeps running while
+// the prompt is open.
+func (m Model) openCancelConfirm() (tea.Model, tea.Cmd) {
+	m.confirmingCancel = true
+	return m, nil
+}
+
+// updateCancelConfirm cancels the session on c or y. Any other key keeps
+// it running.
+func (m Model) updateCancelConfirm(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	m.confirmingCancel = false
+	switch msg.String() {
+	case "c", "y":
+		return m.cancelSession()
+	}
+	return m, nil
+}
+
+func (m Model) renderCancelConfirm() string {
+	questionStyle := lipgloss.NewStyle().
+		Foreground(theme.Highlight)
+
+	helpStyle := lipgloss.NewStyle().
+		Foreground(theme.Faint).
+		MarginTop(1)
+
+	return lipgloss.NewStyle().MarginTop(2).Render(lipgloss.JoinVertical(lipgloss.Left,
+		questionStyle.Render("Cancel this session? Press c again or y to confirm."),
+		helpStyle.Render("any other key: keep focusing"),
+	))
+}
@@ -0,0 +1,35 @@
+package dashboard
+
+import (
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/adibhanna/focussessions/internal/models"
+	"github.com/adibhanna/focussessions/internal/sound"
+)
+
+// celebrate returns the completion announcement for session. A non-empty
+// message (such as the daily goal being reached) takes precedence over the
+// message configured for the session's tag, which takes precedence over a
+// random motivational message; the tag's sound plays either way, unless
+// sounds are off or it is the quiet hours.
+func (m Model) celebrate(session *models.Session, message string) tea.Cmd {
+	var settings models.TagSettings
+	if session != nil && session.Tag != "" {
+		settings = m.config.Tags[session.Tag]
+	}
+	if message == "" {
+		message = settings.Message
+	}
+	if message == "" {
+		message = m.motivation("Session completed! Great job!")
+	}
+
+	announce := tea.Printf("*** %s ***", message)
+	if settings.Sound == "" || !m.config.SoundAt(m.now()) {
+		return announce
+	}
+	return tea.Batch(announce, func() tea.Msg {
+		sound.Play(settings.Sound)
+		return nil
+	})
+}
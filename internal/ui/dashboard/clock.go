@@ -0,0 +1,33 @@
+package dashboard
+
+import "time"
+
+// progressSaveInterval is how many seconds of progress may accumulate
+// between periodic saves of the running session.
+const progressSaveInterval = 10
+
+// now returns the current time of the dashboard's clock.
+func (m *Model) now() time.Time {
+	return m.clock.Now()
+}
+
+// startRun marks the timer as running from now. Elapsed time is then
+// measured on the monotonic clock instead of by counting ticks, so
+// wall-clock changes (DST, NTP corrections, manual adjustments) and slow or
+// dropped ticks don't skew it. Time asleep stops the monotonic clock; the
+// sleep prompt asks whether to count it.
+func (m *Model) startRun() {
+	m.runStartedAt = m.clock.Monotonic()
+	m.runStarted = true
+	m.runBaseElapsed = m.timerElapsed
+	m.lastSavedElapsed = m.timerElapsed
+	m.lastTickAt, m.lastTickMono = m.now(), m.runStartedAt
+}
+
+// syncElapsed brings timerElapsed up to date while the timer is running.
+func (m *Model) syncElapsed() {
+	if !m.timerRunning || m.timerPaused || !m.runStarted {
+		return
+	}
+	m.timerElapsed = m.runBaseElapsed + int((m.clock.Monotonic()-m.runStartedAt)/time.Second)
+}
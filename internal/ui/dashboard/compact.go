@@ -0,0 +1,114 @@
+package dashboard
+
+import (
+	"fmt"
+
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/adibhanna/focussessions/internal/ui/layout"
+	"github.com/adibhanna/focussessions/internal/ui/theme"
+)
+
+// renderCompactHomeView is used below layout.CompactHeight: it drops the big
+// clock and padding in favor of a few single-line rows.
+func (m Model) renderCompactHomeView() string {
+	containerStyle := lipgloss.NewStyle().
+		Width(m.width).
+		Height(m.height).
+		Align(lipgloss.Center, lipgloss.Center)
+
+	timerStyle := lipgloss.NewStyle().
+		Bold(true).
+		Foreground(theme.OnPrimary).
+		Background(theme.Primary).
+		Padding(0, 1)
+
+	statusStyle := lipgloss.NewStyle().
+		Foreground(theme.Muted)
+
+	todayStyle := lipgloss.NewStyle().
+		Foreground(theme.Highlight)
+
+	var timerLine string
+	if m.timerRunning {
+		remaining := m.timerDuration - m.timerElapsed
+		percent := float64(m.timerElapsed) / float64(m.timerDuration)
+
+		icon := "🎯"
+		if m.timerPaused {
+			icon = "⏸️ "
+		} else if m.onBreak {
+			icon = "☕"
+		}
+
+		barWidth := layout.Fit(40, m.width-30, 10)
+		timerLine = fmt.Sprintf("%s %s %s %3d%%",
+			icon,
+			m.flash(timerStyle).Render(fmt.Sprintf("%02d:%02d", remaining/60, remaining%60)),
+			layout.Bar(percent, barWidth, "█", "░"),
+			int(percent*100),
+		)
+	} else {
+		timerLine = timerStyle.Render("Ready to Focus")
+	}
+
+	rows := []string{timerLine}
+	if m.timerRunning && m.activeSession != nil {
+		if label := m.activeSession.Label(); label != "" {
+			rows = append(rows, statusStyle.Render("🏷  "+label))
+		}
+	}
+	if m.onBreak && m.config.BreathingGuide {
+		rows = append(rows, statusStyle.Render(m.breathingText()))
+	}
+	if prompt := m.renderBreakPrompt(); prompt != "" {
+		rows = append(rows, prompt)
+	}
+	if m.timerRunning && m.config.Buddy {
+		rows = append(rows, m.buddyLine())
+	}
+	if notice := m.renderChainNotice(); notice != "" {
+		rows = append(rows, notice)
+	}
+	if offer := m.renderScheduledOffer(); offer != "" {
+		rows = append(rows, offer)
+	}
+	rows = append(rows, todayStyle.Render(fmt.Sprintf(
+		"Today: %d/%d sessions • %dm",
+		m.todayStats.SessionsCount,
+		m.config.DailySessionGoal,
+		m.todayStats.TotalMinutes,
+	)))
+
+	if toast := m.renderToast(); toast != "" {
+		rows = append(rows, toast)
+	}
+
+	if m.editingLabels {
+		rows = append(rows, m.renderLabelEditor())
+	} else if m.promptingIntention {
+		rows = append(rows, m.renderIntentionPrompt())
+	} else if m.reflection != nil {
+		rows = append(rows, m.renderReflection())
+	} else if m.loggingInterruption {
+		rows = append(rows, m.renderInterruptionLog())
+	} else if m.cancellingStrict {
+		rows = append(rows, m.renderStrictCancel())
+	} else if m.confirmingCancel {
+		rows = append(rows, m.renderCancelConfirm())
+	} else if m.sleep != nil {
+		rows = append(rows, m.renderSleep())
+	} else if m.resuming {
+		rows = append(rows, m.renderResume())
+	} else if m.pickingMethod {
+		rows = append(rows, m.renderMethodPicker())
+	} else if m.pickingProfile {
+		rows = append(rows, m.renderProfilePicker())
+	} else if m.conflict != nil {
+		rows = append(rows, m.renderHandoff())
+	} else if m.height >= 6 {
+		rows = append(rows, m.shortHelp(m.width))
+	}
+
+	return containerStyle.Render(lipgloss.JoinVertical(lipgloss.Center, rows...))
+}
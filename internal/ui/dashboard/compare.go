@@ -0,0 +1,34 @@
+package dashboard
+
+import (
+	"fmt"
+
+	"github.com/adibhanna/focussessions/internal/models"
+)
+
+func (m *Model) refreshComparisons() {
+	now := m.statsDay()
+	weekYear, week := m.storage.WeekOf(now)
+	if delta, err := m.storage.CompareWeek(weekYear, week); err == nil {
+		m.weekDelta = delta
+	}
+	if delta, err := m.storage.CompareMonth(now.Year(), int(now.Month())); err == nil {
+		m.monthDelta = delta
+	}
+}
+
+// comparisonText describes a change versus the previous period, e.g.
+// "+3 sessions, +2h 10m vs last week".
+func comparisonText(delta models.Comparison, period string) string {
+	sessions := fmt.Sprintf("%+d sessions", delta.Sessions)
+	if delta.Sessions == 1 || delta.Sessions == -1 {
+		sessions = fmt.Sprintf("%+d session", delta.Sessions)
+	}
+
+	minutes := "+" + models.FormatMinutes(delta.Minutes)
+	if delta.Minutes < 0 {
+		minutes = "-" + models.FormatMinutes(-delta.Minutes)
+	}
+
+	return fmt.Sprintf("%s, %s vs last %s", sessions, minutes, period)
+}
@@ -2,26 +2,30 @@ package dashboard
 
 import (
 	"fmt"
-	"os"
-	"path/filepath"
+	"strings"
 	"time"
 
+	"github.com/charmbracelet/bubbles/help"
 	"github.com/charmbracelet/bubbles/key"
 	"github.com/charmbracelet/bubbles/progress"
+	"github.com/charmbracelet/bubbles/textinput"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
 	"github.com/google/uuid"
 
+	"github.com/adibhanna/focussessions/internal/buddy"
+	"github.com/adibhanna/focussessions/internal/clock"
+	"github.com/adibhanna/focussessions/internal/hooks"
+	"github.com/adibhanna/focussessions/internal/insights"
 	"github.com/adibhanna/focussessions/internal/models"
+	"github.com/adibhanna/focussessions/internal/outbox"
 	"github.com/adibhanna/focussessions/internal/storage"
-	"github.com/adibhanna/focussessions/internal/ui/help"
+	"github.com/adibhanna/focussessions/internal/ui/exportwizard"
+	"github.com/adibhanna/focussessions/internal/ui/layout"
+	"github.com/adibhanna/focussessions/internal/ui/theme"
 )
 
 type tickMsg time.Time
-type exportResultMsg struct {
-	success bool
-	message string
-}
 type clearExportMsg struct{}
 
 type ViewState int
@@ -33,11 +37,20 @@ const (
 	StatsDetailWeekly
 	StatsDetailMonthly
 	StatsDetailYearly
-	HelpView
+	InsightsView
+	PlannerView
+	HoursView
+	AchievementsView
+	TasksView
+	ReviewView
+	YearReviewView
+	RangeView
+	EstimatesView
 )
 
 type Model struct {
-	storage       *storage.Storage
+	storage       Store
+	clock         clock.Clock // Tells the time, for dates, stats and the running timer
 	config        models.Config
 	todayStats    models.DayStats
 	weekStats     models.WeekStats
@@ -55,39 +68,258 @@ type Model struct {
 	timerDuration int
 	timerProgress progress.Model
 
-	// Sub-models
-	helpModel help.Model
+	// Monotonic run accounting: while running, elapsed time is
+	// runBaseElapsed plus the monotonic time since runStartedAt, a reading
+	// of the clock's monotonic clock taken once runStarted.
+	runStartedAt     time.Duration
+	runStarted       bool
+	runBaseElapsed   int
+	lastSavedElapsed int
+
+	// Sub-models. The help bar's ShowAll opens the help overlay.
+	helpBar help.Model
 
 	// Export state
+	exportWizard  exportwizard.Model
+	exporting     bool
 	exportMessage string
 	showExportMsg bool
 
+	// Insights computed when the insights view is opened
+	insightSections []insights.Section
+	insightDays     int
+
+	// Today's pace against the usual one for this weekday
+	pace models.PaceStats
+
+	// Progress towards weekly project targets
+	burndown []models.ProjectBurndown
+
+	// Window in which focus quality peaks, suggested for tomorrow's
+	// hardest session once the day is done
+	peak    insights.Window
+	hasPeak bool
+
+	// Most recent unfinished work, offered as a one-key restart
+	suggestion *models.Session
+
+	// Label editor for the running session
+	labelInputs   []textinput.Model
+	labelFocus    int
+	editingLabels bool
+
+	// Intention asked for before a session starts, when enabled
+	intentionInput     textinput.Model
+	promptingIntention bool
+
+	// Completed sessions per day over the last two weeks, today last
+	recentCounts []int
+
+	// Days in a row with focus time, for the streak widget
+	streak int
+
+	// Command palette and the command under its cursor
+	paletteInput  textinput.Model
+	paletteOpen   bool
+	paletteCursor int
+
+	// Change of this week and month versus the previous ones
+	weekDelta  models.Comparison
+	monthDelta models.Comparison
+
+	// Method preset picker and the preset under its cursor
+	pickingMethod bool
+	methodCursor  int
+
+	// Focus minutes per hour of day over the range at hourRanges[hourRange]
+	hours     [24]int
+	hourRange int
+
+	// Estimated and actual pomodoros of finished tasks
+	estimates models.EstimateReport
+
+	// Highlights of the year shown in the year in review
+	yearReview models.YearInReview
+
+	// Aggregates of a custom date range, and the picker choosing it
+	rangeStats   models.RangeStats
+	rangeInputs  []textinput.Model
+	rangeFocus   int
+	rangeErr     string
+	pickingRange bool
+
+	// Tag or project filter picker for the stats, the filters listed in it
+	// and the one under its cursor
+	pickingFilter bool
+	statsFilters  []models.StatsFilter
+	filterCursor  int
+
+	// Day the stats detail views were stepped back to with ←, zero while
+	// they show the current period
+	statsAt time.Time
+
+	// Days marked off, keyed by date, left out of averages
+	offDays map[string]string
+
+	// Achievement unlock announcement and the unlocked achievements
+	// listed in the achievements view
+	toast    string
+	unlocked map[string]time.Time
+
+	// Motivational messages to pick from, and the one shown under the
+	// running session
+	messages []string
+	status   string
+
+	// Co-working buddy's timer, refreshed every tick while shown
+	buddy buddy.Status
+
+	// Minutes per day charted in the monthly and yearly details, starting
+	// at trendFrom
+	trend     []int
+	trendFrom time.Time
+
+	// Machine name stamped on running sessions, and the session's other
+	// machine while both try to run it
+	host     string
+	conflict *storage.ConflictError
+
+	// Interruption being logged for the running session
+	interruptionInput   textinput.Model
+	interruptedAt       time.Time
+	loggingInterruption bool
+
+	// Intensity new sessions start at, cycled with 'l'
+	nextIntensity string
+
+	// Post-session reflection form, open while reflection is set
+	reflection    *models.Session
+	reflectRating int
+	reflectNotes  textinput.Model
+
+	// Session history filter (daily details), matched against metadata
+	filterInput   textinput.Model
+	filtering     bool
+	historyFilter string
+
+	// Journal note of the day in the daily details, and its editor
+	journal        string
+	journalInput   textinput.Model
+	editingJournal bool
+
+	// Weekly review wizard step and its retrospective note
+	reviewStep  int
+	reviewInput textinput.Model
+
+	// Today's plan and how much of it is done
+	plan           models.PlanProgress
+	planCursor     int
+	planTagInput   textinput.Model
+	editingPlanTag bool
+
+	// Task list, the selected task and the title of a task being added
+	tasks      []models.TaskProgress
+	taskCursor int
+	taskInput  textinput.Model
+	addingTask bool
+
+	// Distraction-free home view showing only the countdown
+	zen bool
+
+	// Breaks and auto-continue between sessions and breaks, and how many
+	// breaks were taken, which picks the break's activity prompt
+	onBreak        bool
+	breaksTaken    int
+	chainNext      int
+	chainCountdown int
+	chainID        int
+	lastLabels     *models.Session
+
+	// Strict mode for the next session and the prompt asking for the
+	// confirmation word before a strict session is cancelled
+	nextStrict       bool
+	cancellingStrict bool
+	strictMismatch   bool
+	strictInput      textinput.Model
+
+	// Confirmation before cancelling a session, and the recent cancels and
+	// completions that can still be undone
+	confirmingCancel bool
+	undoStack        []undoEntry
+	undoSeq          int
+
+	// Set while the countdown flashes for a milestone
+	flashing bool
+
+	// Wall-clock time and monotonic reading of the last tick, and a gap in
+	// the ticks while the machine slept that the user is asked about
+	lastTickAt   time.Time
+	lastTickMono time.Duration
+	sleep        *sleepGap
+
+	// Set while asking what to do with a session left open at startup
+	resuming bool
+
+	// Session to start as soon as the dashboard opens, from --start
+	launch *launchMsg
+
+	// Step of the onboarding tour shown on a first launch
+	tour tourStep
+
+	// Scheduled session that came due and is offered to start
+	scheduled *models.ScheduledStart
+
+	// Terminal window title, taskbar progress sequence and MQTT state last
+	// set, and whether publishing to MQTT failed last time
+	title      string
+	progress   string
+	published  string
+	mqttFailed bool
+
+	// Profile picker, the profiles listed in it and the one to switch to
+	// once the dashboard quits
+	pickingProfile bool
+	profiles       []string
+	profileCursor  int
+	switchProfile  string
+
 	shouldQuit   bool
 	openSettings bool
 }
 
-func New(storage *storage.Storage) (Model, error) {
+// New returns the dashboard over storage, telling the time by clk:
+// clock.System in the app, and a clock.Manual in tests to render views at
+// a fixed date and move sessions on without waiting.
+func New(storage Store, clk clock.Clock) (Model, error) {
 	config, err := storage.GetConfig()
 	if err != nil {
 		return Model{}, err
 	}
 
-	todayStats, err := storage.GetDayStats(time.Now().Format("2006-01-02"))
+	// A filter left over from the stats of a previous dashboard
+	storage.SetStatsFilter(models.StatsFilter{})
+
+	now := clk.Now()
+	staleNote := expirePaused(storage, config, now)
+	if note := cleanupStale(storage, config, now); note != "" {
+		staleNote = note
+	}
+
+	todayStats, err := storage.GetDayStats(now.Format("2006-01-02"))
 	if err != nil {
 		todayStats = models.DayStats{
-			Date:          time.Now().Format("2006-01-02"),
+			Date:          now.Format("2006-01-02"),
 			SessionsCount: 0,
 			TotalMinutes:  0,
 		}
 	}
 
-	now := time.Now()
-	_, week := now.ISOWeek()
-	weekStats, err := storage.GetWeekStats(now.Year(), week)
+	weekYear, week := storage.WeekOf(now)
+	weekStats, err := storage.GetWeekStats(weekYear, week)
 	if err != nil {
 		weekStats = models.WeekStats{
 			Week:          week,
-			Year:          now.Year(),
+			Year:          weekYear,
 			SessionsCount: 0,
 			TotalMinutes:  0,
 		}
@@ -117,22 +349,61 @@ func New(storage *storage.Storage) (Model, error) {
 		activeSession = nil
 	}
 
-	prog := progress.New(progress.WithScaledGradient("#FF7CCB", "#FDFF8C"))
+	suggestion, err := storage.GetContinueSuggestion()
+	if err != nil {
+		suggestion = nil
+	}
+
+	prog := progress.New(theme.Progress()...)
 	prog.Width = 40
 
+	filterInput := textinput.New()
+	filterInput.Placeholder = "host, repo, tmux session..."
+	filterInput.Prompt = "Filter: "
+	filterInput.CharLimit = 64
+	filterInput.Width = 30
+
 	m := Model{
-		storage:       storage,
-		config:        config,
-		todayStats:    todayStats,
-		weekStats:     weekStats,
-		monthStats:    monthStats,
-		yearStats:     yearStats,
-		activeSession: activeSession,
-		viewState:     HomeView,
-		timerProgress: prog,
-		timerDuration: config.SessionDuration * 60,
-		helpModel:     help.New(),
+		storage:           storage,
+		clock:             clk,
+		config:            config,
+		todayStats:        todayStats,
+		weekStats:         weekStats,
+		monthStats:        monthStats,
+		yearStats:         yearStats,
+		activeSession:     activeSession,
+		viewState:         HomeView,
+		timerProgress:     prog,
+		timerDuration:     config.SessionDuration * 60,
+		helpBar:           newHelpBar(),
+		filterInput:       filterInput,
+		journalInput:      newJournalInput(),
+		reviewInput:       newReviewInput(),
+		labelInputs:       newLabelInputs(),
+		rangeInputs:       newRangeInputs(),
+		planTagInput:      newPlanTagInput(),
+		taskInput:         newTaskInput(),
+		intentionInput:    newIntentionInput(),
+		paletteInput:      newPaletteInput(),
+		reflectNotes:      newReflectNotes(),
+		nextIntensity:     models.IntensityNormal,
+		interruptionInput: newInterruptionInput(),
+		suggestion:        suggestion,
+		host:              hostname(),
+		hourRange:         defaultHourRange,
+		toast:             staleNote,
+		nextStrict:        config.Strict,
+		strictInput:       newStrictInput(),
 	}
+	m.refreshPace()
+	m.refreshPlan()
+	m.refreshSchedule()
+	m.refreshSparkline()
+	m.refreshStreak()
+	m.refreshBuddy()
+	m.loadMessages()
+	m.status = m.motivation("Stay Focused!")
+	m.refreshComparisons()
 
 	// If there's an active session, set up timer state
 	if activeSession != nil {
@@ -140,19 +411,16 @@ func New(storage *storage.Storage) (Model, error) {
 		m.timerPaused = activeSession.Paused
 		m.timerDuration = activeSession.Duration * 60
 
-		// Calculate elapsed time including time passed while app was closed
-		if !activeSession.Paused {
-			// If session wasn't paused, add the time that passed since last save
-			timeSinceStart := time.Since(activeSession.StartTime)
-			m.timerElapsed = int(timeSinceStart.Seconds())
+		// Calculate elapsed time including time passed while app was closed,
+		// which the resume prompt may take back
+		m.timerElapsed = min(activeSession.ElapsedAt(m.now()), m.timerDuration)
+		m.startRun()
 
-			// Ensure we don't exceed the duration
-			if m.timerElapsed > m.timerDuration {
-				m.timerElapsed = m.timerDuration
-			}
-		} else {
-			// If paused, use the saved elapsed time
-			m.timerElapsed = activeSession.ElapsedSeconds
+		// Hold the timer if another machine is still running the session,
+		// and otherwise ask what to do with it. A session started with
+		// --no-ui kept running on its own and is simply picked up.
+		if !m.checkHandoff() && !headless(activeSession) {
+			m.openResume()
 		}
 	}
 
@@ -170,6 +438,27 @@ func (m Model) Init() tea.Cmd {
 	// Start progress bar animation
 	cmds = append(cmds, m.timerProgress.Init())
 
+	if m.toast != "" {
+		cmds = append(cmds, clearToastAfter())
+	}
+	if m.launch != nil {
+		launch := *m.launch
+		cmds = append(cmds, func() tea.Msg { return launch })
+	}
+	cmds = append(cmds, m.watchSchedule())
+	if m.timerPaused {
+		cmds = append(cmds, m.watchPause())
+	}
+	cmds = append(cmds, tea.SetWindowTitle(m.windowTitle()))
+
+	// Keep sites blocked for a running session, and unblock them when a
+	// session ended without doing so
+	if m.activeSession != nil {
+		cmds = append(cmds, m.blockSites())
+	} else {
+		cmds = append(cmds, m.unblockSites())
+	}
+
 	return tea.Batch(cmds...)
 }
 
@@ -179,73 +468,127 @@ func tickCmd() tea.Cmd {
 	})
 }
 
-func (m Model) exportStats() tea.Cmd {
-	return func() tea.Msg {
-		report, err := m.storage.ExportAllStats()
-		if err != nil {
-			return exportResultMsg{success: false, message: fmt.Sprintf("Export failed: %v", err)}
-		}
-
-		// Save to file
-		homeDir, err := os.UserHomeDir()
-		if err != nil {
-			return exportResultMsg{success: false, message: fmt.Sprintf("Failed to get home directory: %v", err)}
-		}
-
-		timestamp := time.Now().Format("2006-01-02-150405")
-		filename := fmt.Sprintf("focussessions-stats-%s.txt", timestamp)
-		filePath := filepath.Join(homeDir, "Downloads", filename)
-
-		err = os.WriteFile(filePath, []byte(report), 0644)
-		if err != nil {
-			// Try alternative location if Downloads doesn't exist
-			filePath = filepath.Join(homeDir, filename)
-			err = os.WriteFile(filePath, []byte(report), 0644)
-			if err != nil {
-				return exportResultMsg{success: false, message: fmt.Sprintf("Failed to save file: %v", err)}
-			}
-		}
-
-		return exportResultMsg{success: true, message: fmt.Sprintf("[OK] Exported to %s", filePath)}
-	}
-}
-
 func (m Model) clearExportMsgAfterDelay() tea.Cmd {
 	return tea.Tick(time.Second*3, func(t time.Time) tea.Msg {
 		return clearExportMsg{}
 	})
 }
 
-func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+func (m Model) update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	if m.exporting {
+		switch msg.(type) {
+		case tickMsg, progress.FrameMsg, clearExportMsg, clearToastMsg, undoExpiredMsg, clearFlashMsg, blockFailedMsg, mqttResultMsg, scheduledMsg, environmentMsg, tea.WindowSizeMsg:
+			// The timer keeps running while the wizard is open
+		default:
+			return m.updateExportWizard(msg)
+		}
+	}
+
 	switch msg := msg.(type) {
 	case tea.WindowSizeMsg:
 		m.width = msg.Width
 		m.height = msg.Height
 		m.timerProgress.Width = min(msg.Width/3-10, 40)
-		if m.viewState == HelpView {
-			helpModel, _ := m.helpModel.Update(msg)
-			m.helpModel = helpModel.(help.Model)
-		}
 		return m, nil
 
 	case tea.KeyMsg:
-		// Handle help view specially
-		if m.viewState == HelpView {
-			helpModel, _ := m.helpModel.Update(msg)
-			m.helpModel = helpModel.(help.Model)
-			if m.helpModel.ShouldQuit() {
-				m.viewState = HomeView
+		if m.helpBar.ShowAll {
+			if next, cmd, handled := m.updateKeyHelp(msg); handled {
+				return next, cmd
+			}
+		}
+		if m.paletteOpen {
+			return m.updatePalette(msg)
+		}
+
+		if m.filtering {
+			return m.updateFilter(msg)
+		}
+		if m.editingJournal {
+			return m.updateJournal(msg)
+		}
+		if m.viewState == ReviewView {
+			return m.updateReview(msg)
+		}
+		if m.pickingRange {
+			return m.updateRangePicker(msg)
+		}
+		if m.pickingFilter {
+			return m.updateStatsFilter(msg)
+		}
+		if m.editingLabels {
+			return m.updateLabelEditor(msg)
+		}
+		if m.promptingIntention {
+			return m.updateIntentionPrompt(msg)
+		}
+		if m.reflection != nil {
+			return m.updateReflection(msg)
+		}
+		if m.loggingInterruption {
+			return m.updateInterruptionLog(msg)
+		}
+		if m.cancellingStrict {
+			return m.updateStrictCancel(msg)
+		}
+		if m.confirmingCancel {
+			return m.updateCancelConfirm(msg)
+		}
+		if m.sleep != nil {
+			return m.updateSleep(msg)
+		}
+		if m.resuming {
+			return m.updateResume(msg)
+		}
+		if m.pickingMethod {
+			return m.updateMethodPicker(msg)
+		}
+		if m.pickingProfile {
+			return m.updateProfilePicker(msg)
+		}
+		if m.conflict != nil && m.viewState == HomeView {
+			if next, cmd, handled := m.updateHandoff(msg); handled {
+				return next, cmd
+			}
+		}
+		if m.viewState == PlannerView {
+			if planner, cmd, handled := m.updatePlanner(msg); handled {
+				return planner, cmd
+			}
+		}
+		if m.viewState == TasksView {
+			if next, cmd, handled := m.updateTasks(msg); handled {
+				return next, cmd
+			}
+		}
+		if m.scheduled != nil && !m.timerRunning && m.viewState == HomeView {
+			if next, cmd, handled := m.updateScheduledOffer(msg); handled {
+				return next, cmd
+			}
+		}
+		if m.chainNext != chainNone {
+			switch msg.String() {
+			case "enter":
+				return m.runChain()
+			case "esc":
+				m.chainNext = chainNone
+				return m, nil
+			}
+		}
+		if m.tour != tourNone {
+			if next, cmd, handled := m.updateTour(msg); handled {
+				return next, cmd
 			}
-			// Don't process other keys when in help view, but don't break tick chain
-			return m, nil
 		}
 
 		switch {
 		case key.Matches(msg, keys.Quit):
-			if m.timerRunning && m.activeSession != nil {
+			if m.timerRunning && m.activeSession != nil && m.conflict == nil {
 				// Save state when quitting
+				m.syncElapsed()
 				m.activeSession.ElapsedSeconds = m.timerElapsed
 				m.activeSession.Paused = m.timerPaused
+				m.heartbeat()
 				m.storage.SaveSession(*m.activeSession)
 			}
 			m.shouldQuit = true
@@ -253,109 +596,219 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 
 		case key.Matches(msg, keys.Home):
 			m.viewState = HomeView
+			m.resetStatsDay()
+			m.clearStatsFilter()
+			return m, nil
+
+		case key.Matches(msg, keys.Back) && m.zen && m.viewState == HomeView:
+			m.zen = false
 			return m, nil
 
 		case key.Matches(msg, keys.Back):
 			switch m.viewState {
-			case StatsDetailDaily, StatsDetailWeekly, StatsDetailMonthly, StatsDetailYearly:
+			case StatsDetailDaily, StatsDetailWeekly, StatsDetailMonthly, StatsDetailYearly, InsightsView, HoursView, AchievementsView, YearReviewView, RangeView, EstimatesView:
 				// From detail views, go back to stats overview
 				m.viewState = StatsView
+				m.resetStatsDay()
 			case StatsView:
 				// From stats overview, go back to home
 				m.viewState = HomeView
-			case HelpView:
-				// From help view, go back to home
-				m.viewState = HomeView
+				m.clearStatsFilter()
 			default:
 				// From home or other views, do nothing (already at top level)
 			}
 			return m, nil
 
 		case key.Matches(msg, keys.Help):
-			m.viewState = HelpView
-			// Ensure help model gets window size
-			if m.width > 0 && m.height > 0 {
-				sizeMsg := tea.WindowSizeMsg{Width: m.width, Height: m.height}
-				helpModel, _ := m.helpModel.Update(sizeMsg)
-				m.helpModel = helpModel.(help.Model)
-			}
+			m.helpBar.ShowAll = true
 			return m, nil
 
+		case key.Matches(msg, keys.Palette):
+			return m.openPalette()
+
 		case key.Matches(msg, keys.Stats):
 			if m.viewState == StatsView {
 				// Toggle back to home if already in stats view
 				m.viewState = HomeView
+				m.clearStatsFilter()
 			} else {
-				m.viewState = StatsView
-				// Refresh all stats
-				now := time.Now()
-
-				// Refresh daily stats
-				todayStats, err := m.storage.GetDayStats(now.Format("2006-01-02"))
-				if err == nil {
-					m.todayStats = todayStats
-				}
-
-				// Refresh weekly stats
-				_, week := now.ISOWeek()
-				weekStats, err := m.storage.GetWeekStats(now.Year(), week)
-				if err == nil {
-					m.weekStats = weekStats
-				}
-
-				// Refresh monthly stats
-				monthStats, err := m.storage.GetMonthStats(now.Year(), int(now.Month()))
-				if err == nil {
-					m.monthStats = monthStats
-				}
-
-				// Refresh yearly stats
-				yearStats, err := m.storage.GetYearStats(now.Year())
-				if err == nil {
-					m.yearStats = yearStats
-				}
+				m.openStats()
 			}
 			return m, nil
 
 		// Drill-down navigation (only available in stats view)
 		case key.Matches(msg, keys.Daily) && m.viewState == StatsView:
 			m.viewState = StatsDetailDaily
+			m.refreshJournal()
 			return m, nil
 
 		case key.Matches(msg, keys.Weekly) && m.viewState == StatsView:
 			m.viewState = StatsDetailWeekly
+			m.refreshBurndown()
 			return m, nil
 
 		case key.Matches(msg, keys.Monthly) && m.viewState == StatsView:
 			m.viewState = StatsDetailMonthly
+			m.loadMonthTrend()
 			return m, nil
 
 		case key.Matches(msg, keys.Yearly) && m.viewState == StatsView:
 			m.viewState = StatsDetailYearly
+			m.loadYearTrend()
+			return m, nil
+
+		case key.Matches(msg, keys.Insights) && m.viewState == StatsView:
+			m.viewState = InsightsView
+			m.loadInsights()
+			return m, nil
+
+		case key.Matches(msg, keys.Hours) && m.viewState == StatsView:
+			m.viewState = HoursView
+			m.loadHours()
+			return m, nil
+
+		case key.Matches(msg, keys.Review) && m.viewState == StatsView:
+			return m.openReview()
+
+		case key.Matches(msg, keys.Achievements) && m.viewState == StatsView:
+			m.viewState = AchievementsView
+			m.loadAchievements()
+			return m, nil
+
+		case key.Matches(msg, keys.Estimates) && m.viewState == StatsView:
+			m.viewState = EstimatesView
+			m.loadEstimates()
+			return m, nil
+
+		case key.Matches(msg, keys.YearReview) && m.viewState == StatsView:
+			m.viewState = YearReviewView
+			m.loadYearReview(m.now().Year())
+			return m, nil
+
+		case key.Matches(msg, keys.StatsFilter) && m.viewState == StatsView:
+			return m.openStatsFilter()
+
+		case key.Matches(msg, keys.DateRange) && (m.viewState == StatsView || m.viewState == RangeView):
+			return m.openRangePicker()
+
+		case key.Matches(msg, keys.Prev) && m.viewState == YearReviewView:
+			m.cycleYearReview(-1)
 			return m, nil
 
+		case key.Matches(msg, keys.Next) && m.viewState == YearReviewView:
+			m.cycleYearReview(1)
+			return m, nil
+
+		case key.Matches(msg, keys.Export) && m.viewState == YearReviewView:
+			return m.exportYearReview()
+
+		case key.Matches(msg, keys.Prev) && isDetailView(m.viewState):
+			m.stepStats(-1)
+			return m, nil
+
+		case key.Matches(msg, keys.Next) && isDetailView(m.viewState):
+			m.stepStats(1)
+			return m, nil
+
+		case key.Matches(msg, keys.Prev) && m.viewState == HoursView:
+			m.cycleHourRange(-1)
+			return m, nil
+
+		case key.Matches(msg, keys.Next) && m.viewState == HoursView:
+			m.cycleHourRange(1)
+			return m, nil
+
+		case key.Matches(msg, keys.Filter) && m.viewState == StatsDetailDaily:
+			m.filtering = true
+			m.filterInput.SetValue(m.historyFilter)
+			m.filterInput.CursorEnd()
+			return m, m.filterInput.Focus()
+
+		case key.Matches(msg, keys.Journal) && m.viewState == StatsDetailDaily:
+			return m.openJournal()
+
+		case key.Matches(msg, keys.DayOff) && m.viewState == StatsDetailDaily:
+			return m.toggleOffDay()
+
 		case key.Matches(msg, keys.Start) && !m.timerRunning:
-			return m.startNewSession()
+			if m.config.PromptIntention {
+				m.viewState = HomeView
+				return m.promptIntention()
+			}
+			return m.startNewSession(nil)
+
+		case key.Matches(msg, keys.Continue) && !m.timerRunning && m.viewState == HomeView && m.suggestion != nil:
+			return m.startNewSession(m.suggestion)
+
+		case key.Matches(msg, keys.Pause) && m.strict():
+			return m, m.refusePause()
 
 		case key.Matches(msg, keys.Pause) && m.timerRunning && !m.timerPaused:
-			m.timerPaused = true
-			if m.activeSession != nil {
-				m.activeSession.Paused = true
-				m.activeSession.ElapsedSeconds = m.timerElapsed
-				m.storage.SaveSession(*m.activeSession)
-			}
-			return m, nil
+			return m.pause()
 
 		case key.Matches(msg, keys.Resume) && m.timerRunning && m.timerPaused:
-			m.timerPaused = false
-			if m.activeSession != nil {
-				m.activeSession.Paused = false
-				m.storage.SaveSession(*m.activeSession)
-			}
-			return m, tickCmd()
+			return m.resume()
+
+		case key.Matches(msg, keys.Cancel) && m.timerRunning && m.onBreak:
+			return m.finishBreak(false)
+
+		case key.Matches(msg, keys.Cancel) && m.strict():
+			return m.openStrictCancel()
 
 		case key.Matches(msg, keys.Cancel) && m.timerRunning:
-			return m.cancelSession()
+			return m.openCancelConfirm()
+
+		case key.Matches(msg, keys.Extend) && m.timerRunning && !m.onBreak && m.viewState == HomeView:
+			return m.extend(extendStep)
+
+		case key.Matches(msg, keys.Shorten) && m.timerRunning && !m.onBreak && m.viewState == HomeView:
+			return m.extend(-extendStep)
+
+		case key.Matches(msg, keys.Undo) && m.viewState == HomeView:
+			return m.undo()
+
+		case key.Matches(msg, keys.Strict) && m.viewState == HomeView && !m.zen:
+			return m, m.toggleStrict()
+
+		case key.Matches(msg, keys.Break) && !m.timerRunning && m.viewState == HomeView:
+			return m.startBreak()
+
+		case key.Matches(msg, keys.Plan) && m.viewState == HomeView:
+			return m.openPlanner()
+
+		case key.Matches(msg, keys.Tasks) && m.viewState == HomeView && !m.zen:
+			return m.openTasks()
+
+		case key.Matches(msg, keys.Zen) && m.viewState == HomeView:
+			m.zen = !m.zen
+			return m, nil
+
+		case key.Matches(msg, keys.Buddy) && m.viewState == HomeView:
+			m.toggleBuddy()
+			return m, nil
+
+		case key.Matches(msg, keys.Method) && m.viewState == HomeView && !m.zen:
+			return m.openMethodPicker()
+
+		case key.Matches(msg, keys.Profile) && m.viewState == HomeView && !m.zen && !m.timerRunning:
+			return m.openProfilePicker()
+
+		case key.Matches(msg, keys.Label) && m.timerRunning && m.viewState == HomeView && !m.zen:
+			return m.openLabelEditor()
+
+		case key.Matches(msg, keys.Level) && m.viewState == HomeView && !m.onBreak:
+			m.cycleIntensity()
+			return m, nil
+
+		case key.Matches(msg, keys.Interrupt) && m.timerRunning && m.activeSession != nil && m.viewState == HomeView && !m.zen:
+			return m.openInterruptionLog()
+
+		case key.Matches(msg, keys.Distract) && m.timerRunning && m.activeSession != nil:
+			m.syncElapsed()
+			m.activeSession.Distractions++
+			m.activeSession.ElapsedSeconds = m.timerElapsed
+			m.storage.SaveSession(*m.activeSession)
+			return m, nil
 
 		case key.Matches(msg, keys.Settings):
 			m.openSettings = true
@@ -366,26 +819,52 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			if m.viewState == StatsView || m.viewState == StatsDetailDaily ||
 				m.viewState == StatsDetailWeekly || m.viewState == StatsDetailMonthly ||
 				m.viewState == StatsDetailYearly {
-				return m, m.exportStats()
+				m.exporting = true
+				m.exportWizard = exportwizard.New(m.storage)
+				return m, m.exportWizard.Init()
 			}
 		}
 
+	case launchMsg:
+		return m.startLaunch(msg)
+
+	case scheduledMsg:
+		return m.updateScheduled(msg)
+
 	case tickMsg:
 		if m.timerRunning && !m.timerPaused {
-			m.timerElapsed++
+			previous := m.timerElapsed
+			m.syncElapsed()
+			m.refreshBuddy()
+			m.checkSleep()
 
 			// Save progress periodically
-			if m.timerElapsed%10 == 0 && m.activeSession != nil {
+			if m.timerElapsed-m.lastSavedElapsed >= progressSaveInterval && m.activeSession != nil {
+				// Stop here if the session was taken over elsewhere
+				if m.checkHandoff() {
+					return m, nil
+				}
 				m.activeSession.ElapsedSeconds = m.timerElapsed
-				m.storage.SaveSession(*m.activeSession)
+				m.heartbeat()
+				m.storage.SaveSessionProgress(*m.activeSession)
+				m.lastSavedElapsed = m.timerElapsed
 			}
 
-			// Check if session is complete
-			if m.timerElapsed >= m.timerDuration {
+			if m.timerElapsed/60 != previous/60 {
+				m.refreshPace()
+			}
+
+			// Check if session or break is complete, once it's settled
+			// whether time asleep counts
+			if m.timerElapsed >= m.timerDuration && m.sleep == nil {
+				if m.onBreak {
+					return m.finishBreak(true)
+				}
 				return m.completeSession()
 			}
 
-			return m, tickCmd()
+			milestones := m.checkMilestones(previous)
+			return m, tea.Batch(tickCmd(), m.speakCountdown(previous), milestones)
 		}
 		// If timer is paused or not running, don't continue ticking
 		return m, nil
@@ -396,57 +875,189 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		// Don't break the chain - the tick and progress should work independently
 		return m, cmd
 
-	case exportResultMsg:
-		m.exportMessage = msg.message
-		m.showExportMsg = true
-		return m, m.clearExportMsgAfterDelay()
+	case chainTickMsg:
+		return m.updateChain(msg)
+
+	case pauseExpiredMsg:
+		return m.updatePauseExpired(msg)
 
 	case clearExportMsg:
 		m.showExportMsg = false
 		m.exportMessage = ""
 		return m, nil
+
+	case clearToastMsg:
+		m.toast = ""
+		return m, nil
+
+	case pluginCommandsMsg:
+		return m.runPluginCommands(msg.commands)
+
+	case undoExpiredMsg:
+		return m.updateUndoExpired(msg)
+
+	case clearFlashMsg:
+		m.flashing = false
+		return m, nil
+
+	case blockFailedMsg:
+		return m.updateBlockFailed(msg)
+
+	case mqttResultMsg:
+		return m.updateMQTTResult(msg)
+
+	case environmentMsg:
+		return m.updateEnvironment(msg)
 	}
 
 	return m, nil
 }
 
-func (m Model) startNewSession() (tea.Model, tea.Cmd) {
+// openStats shows the stats overview with every period refreshed to the
+// current one.
+func (m *Model) openStats() {
+	m.viewState = StatsView
+	// Refresh all stats
+	m.statsAt = time.Time{}
+	now := m.now()
+
+	// Refresh daily stats
+	todayStats, err := m.storage.GetDayStats(now.Format("2006-01-02"))
+	if err == nil {
+		m.todayStats = todayStats
+	}
+
+	// Refresh weekly stats
+	weekYear, week := m.storage.WeekOf(now)
+	weekStats, err := m.storage.GetWeekStats(weekYear, week)
+	if err == nil {
+		m.weekStats = weekStats
+	}
+
+	// Refresh monthly stats
+	monthStats, err := m.storage.GetMonthStats(now.Year(), int(now.Month()))
+	if err == nil {
+		m.monthStats = monthStats
+	}
+
+	// Refresh yearly stats
+	yearStats, err := m.storage.GetYearStats(now.Year())
+	if err == nil {
+		m.yearStats = yearStats
+	}
+
+	m.refreshComparisons()
+	m.loadOffDays()
+}
+
+// startNewSession starts a session, carrying over the tag, project and
+// intention of labels when it is non-nil.
+func (m Model) startNewSession(labels *models.Session) (tea.Model, tea.Cmd) {
+	return m.startSession(labels, m.config.SessionDuration)
+}
+
+// pause pauses the running timer.
+func (m Model) pause() (tea.Model, tea.Cmd) {
+	m.syncElapsed()
+	m.timerPaused = true
+	if m.activeSession != nil {
+		m.activeSession.Pause(m.now())
+		m.activeSession.ElapsedSeconds = m.timerElapsed
+		m.heartbeat()
+		m.storage.SaveSession(*m.activeSession)
+		return m, tea.Batch(m.watchPause(), m.runHook(hooks.OnPause, *m.activeSession))
+	}
+	return m, m.watchPause()
+}
+
+// resume restarts the paused timer, unless the session moved to another
+// machine or was paused for too long meanwhile.
+func (m Model) resume() (tea.Model, tea.Cmd) {
+	if m.checkHandoff() {
+		return m, nil
+	}
+	if m.activeSession != nil && m.config.PauseExceeded(*m.activeSession, m.now()) != "" {
+		return m.updatePauseExpired(pauseExpiredMsg{id: m.activeSession.ID})
+	}
+	m.timerPaused = false
+	m.startRun()
+	if m.activeSession != nil {
+		m.activeSession.Unpause(m.now())
+		m.heartbeat()
+		m.storage.SaveSession(*m.activeSession)
+	}
+	return m, tickCmd()
+}
+
+// startSession starts a session of the given length in minutes, labelled
+// as in startNewSession.
+func (m Model) startSession(labels *models.Session, minutes int) (tea.Model, tea.Cmd) {
 	// Deactivate any existing sessions
 	m.storage.DeactivateAllSessions()
 
 	// Create new session
 	session := &models.Session{
 		ID:             uuid.New().String(),
-		StartTime:      time.Now(),
-		Duration:       m.config.SessionDuration,
-		Date:           time.Now().Format("2006-01-02"),
-		Week:           getWeekNumber(time.Now()),
-		Month:          time.Now().Format("2006-01"),
-		Year:           time.Now().Year(),
+		StartTime:      m.now(),
+		Duration:       minutes,
+		Date:           m.now().Format("2006-01-02"),
+		Week:           getWeekNumber(m.now()),
+		Month:          m.now().Format("2006-01"),
+		Year:           m.now().Year(),
 		Active:         true,
 		ElapsedSeconds: 0,
 		Paused:         false,
+		Method:         m.config.Method,
+		Strict:         m.nextStrict,
+		Ledger:         true,
+	}
+	if m.nextIntensity != models.IntensityNormal {
+		session.Intensity = m.nextIntensity
+	}
+	if labels != nil {
+		session.Tag = labels.Tag
+		session.Project = labels.Project
+		session.Intention = labels.Intention
+		session.TaskID = labels.TaskID
+		if labels.Intensity != "" {
+			session.Intensity = labels.Intensity
+		}
 	}
-
-	m.storage.SaveSession(*session)
 
 	// Update timer state
 	m.activeSession = session
+	m.heartbeat()
+	m.storage.SaveSession(*session)
 	m.timerRunning = true
 	m.timerPaused = false
 	m.timerElapsed = 0
-	m.timerDuration = m.config.SessionDuration * 60
-
-	return m, tickCmd()
+	m.timerDuration = minutes * 60
+	m.suggestion = nil
+	m.scheduled = nil
+	m.chainNext = chainNone
+	m.status = m.motivation("Stay Focused!")
+	m.startRun()
+
+	return m, tea.Batch(tickCmd(), m.runHook(hooks.OnStart, *session), m.blockSites(), m.controlMusic(true), m.captureEnvironment())
 }
 
 func (m Model) cancelSession() (tea.Model, tea.Cmd) {
+	m.syncElapsed()
+	var cmd tea.Cmd
 	if m.activeSession != nil {
-		m.activeSession.EndTime = time.Now()
+		m.activeSession.ElapsedSeconds = m.timerElapsed
+		if !m.activeSession.Abandoned {
+			// Until it is brought back the session counts as paused
+			before := *m.activeSession
+			before.Pause(m.now())
+			cmd = m.pushUndo(undoEntry{action: undoCancel, session: before, resume: !m.timerPaused})
+		}
+		m.activeSession.EndTime = m.now()
 		m.activeSession.Completed = false
 		m.activeSession.Active = false
 		m.activeSession.ElapsedSeconds = m.timerElapsed
 		m.storage.SaveSession(*m.activeSession)
+		cmd = tea.Batch(cmd, m.runHook(hooks.OnCancel, *m.activeSession), m.unblockSites())
 	}
 
 	// Reset timer state
@@ -456,19 +1067,28 @@ func (m Model) cancelSession() (tea.Model, tea.Cmd) {
 	m.timerElapsed = 0
 
 	// Refresh stats
-	todayStats, _ := m.storage.GetDayStats(time.Now().Format("2006-01-02"))
+	todayStats, _ := m.storage.GetDayStats(m.now().Format("2006-01-02"))
 	m.todayStats = todayStats
+	m.suggestion, _ = m.storage.GetContinueSuggestion()
 
-	return m, nil
+	return m, cmd
 }
 
 func (m Model) completeSession() (tea.Model, tea.Cmd) {
+	if m.timerElapsed > m.timerDuration {
+		m.timerElapsed = m.timerDuration
+	}
+	var finished tea.Cmd
+	completed := m.activeSession
 	if m.activeSession != nil {
-		m.activeSession.EndTime = time.Now()
+		m.activeSession.EndTime = m.now()
 		m.activeSession.Completed = true
 		m.activeSession.Active = false
 		m.activeSession.ElapsedSeconds = m.timerElapsed
 		m.storage.SaveSession(*m.activeSession)
+		m.lastLabels = m.activeSession
+		outbox.Publish(m.storage, m.config, outbox.EventSessionCompleted, *m.activeSession, m.now())
+		finished = tea.Batch(m.pushUndo(undoEntry{action: undoComplete, session: *m.activeSession}), m.unblockSites())
 	}
 
 	// Reset timer state
@@ -478,21 +1098,47 @@ func (m Model) completeSession() (tea.Model, tea.Cmd) {
 	m.timerElapsed = 0
 
 	// Refresh stats
-	todayStats, _ := m.storage.GetDayStats(time.Now().Format("2006-01-02"))
+	todayStats, _ := m.storage.GetDayStats(m.now().Format("2006-01-02"))
 	m.todayStats = todayStats
+	m.suggestion, _ = m.storage.GetContinueSuggestion()
+	m.refreshPace()
+	m.refreshBurndown()
+	m.refreshPlan()
+	m.refreshSchedule()
+	m.refreshSparkline()
+	m.refreshStreak()
+	if completed != nil {
+		// After the refresh, so the session counts toward the goal
+		finished = tea.Batch(finished, m.runHook(hooks.OnComplete, *completed))
+	}
 
-	now := time.Now()
-	_, week := now.ISOWeek()
-	weekStats, _ := m.storage.GetWeekStats(now.Year(), week)
+	now := m.now()
+	weekYear, week := m.storage.WeekOf(now)
+	weekStats, _ := m.storage.GetWeekStats(weekYear, week)
 	m.weekStats = weekStats
 
 	// Check if daily goal is met
+	message := ""
 	if m.todayStats.SessionsCount >= m.config.DailySessionGoal {
-		return m, tea.Printf("*** DAILY GOAL ACHIEVED! You completed %d/%d sessions! ***",
+		message = fmt.Sprintf("DAILY GOAL ACHIEVED! You completed %d/%d sessions!",
 			m.todayStats.SessionsCount, m.config.DailySessionGoal)
 	}
+	announce := m.celebrate(m.lastLabels, message)
+	spoken := m.speak(models.SpeechSessionComplete, "Session complete")
+	if message != "" && m.config.Speech[models.SpeechGoalReached] {
+		spoken = m.speak(models.SpeechGoalReached, "Session complete. Daily goal reached")
+	}
+	announce = tea.Batch(announce, spoken, m.checkAchievements(), finished)
 
-	return m, tea.Printf("*** Session completed! Great job! ***")
+	if m.config.Reflect && m.lastLabels != nil {
+		next, cmd := m.startReflection(*m.lastLabels)
+		return next, tea.Batch(announce, cmd)
+	}
+	if m.config.AutoContinue {
+		next, cmd := m.scheduleChain(chainBreak)
+		return next, tea.Batch(announce, cmd)
+	}
+	return m, announce
 }
 
 func (m Model) View() string {
@@ -500,6 +1146,19 @@ func (m Model) View() string {
 		return "Loading..."
 	}
 
+	if m.exporting {
+		return lipgloss.NewStyle().Padding(2).Render(m.exportWizard.View())
+	}
+	if m.helpBar.ShowAll {
+		return m.renderKeyHelp()
+	}
+	if m.paletteOpen {
+		return m.renderPalette()
+	}
+	if m.tour != tourNone {
+		return m.renderTour()
+	}
+
 	switch m.viewState {
 	case StatsView:
 		return m.renderStatsView()
@@ -511,35 +1170,85 @@ func (m Model) View() string {
 		return m.renderMonthlyDetailView()
 	case StatsDetailYearly:
 		return m.renderYearlyDetailView()
-	case HelpView:
-		return m.helpModel.View()
+	case InsightsView:
+		return m.renderInsightsView()
+	case HoursView:
+		return m.renderHoursView()
+	case AchievementsView:
+		return m.renderAchievementsView()
+	case PlannerView:
+		return m.renderPlannerView()
+	case TasksView:
+		return m.renderTasksView()
+	case ReviewView:
+		return m.renderReviewView()
+	case YearReviewView:
+		return m.renderYearReviewView()
+	case RangeView:
+		return m.renderRangeView()
+	case EstimatesView:
+		return m.renderEstimatesView()
 	default:
+		if m.zen {
+			return m.renderZenView()
+		}
 		return m.renderHomeView()
 	}
 }
 
 func (m Model) renderHomeView() string {
+	if layout.Compact(m.height) {
+		return m.renderCompactHomeView()
+	}
+
 	containerStyle := lipgloss.NewStyle().
 		Width(m.width).
 		Height(m.height).
 		Align(lipgloss.Center, lipgloss.Center).
 		Padding(4)
 
-	// Main timer section - larger and centered
-	timerSection := m.renderCenterTimer()
-
-	// Simple progress indicator
-	progressSection := m.renderSimpleProgress()
-
-	// Help at bottom
+	// Help at bottom, replaced by the label editor, intention prompt,
+	// reflection form, interruption log, strict cancel prompt, cancel
+	// confirmation, sleep prompt, startup resume prompt, method or profile
+	// picker or handoff conflict while one is open
 	help := m.renderHelp()
+	if m.editingLabels {
+		help = m.renderLabelEditor()
+	} else if m.promptingIntention {
+		help = m.renderIntentionPrompt()
+	} else if m.reflection != nil {
+		help = m.renderReflection()
+	} else if m.loggingInterruption {
+		help = m.renderInterruptionLog()
+	} else if m.cancellingStrict {
+		help = m.renderStrictCancel()
+	} else if m.confirmingCancel {
+		help = m.renderCancelConfirm()
+	} else if m.sleep != nil {
+		help = m.renderSleep()
+	} else if m.resuming {
+		help = m.renderResume()
+	} else if m.pickingMethod {
+		help = m.renderMethodPicker()
+	} else if m.pickingProfile {
+		help = m.renderProfilePicker()
+	} else if m.conflict != nil {
+		help = m.renderHandoff()
+	}
 
-	content := lipgloss.JoinVertical(
-		lipgloss.Center,
-		timerSection,
-		progressSection,
-		help,
-	)
+	// Timer and progress widgets, in the configured order
+	parts := m.renderWidgets()
+	if toast := m.renderToast(); toast != "" {
+		parts = append(parts, toast)
+	}
+	main := lipgloss.JoinVertical(lipgloss.Center, parts...)
+
+	// Today's sessions sit beside the timer when the terminal is wide enough
+	if panel := m.renderSessionPanel(); panel != "" &&
+		lipgloss.Width(main)+lipgloss.Width(panel) <= layout.Inner(m.width, 4) {
+		main = lipgloss.JoinHorizontal(lipgloss.Center, main, panel)
+	}
+	content := lipgloss.JoinVertical(lipgloss.Center, main, help)
 
 	return containerStyle.Render(content)
 }
@@ -547,14 +1256,14 @@ func (m Model) renderHomeView() string {
 func (m Model) renderCenterTimer() string {
 	timerStyle := lipgloss.NewStyle().
 		Bold(true).
-		Foreground(lipgloss.Color("#FAFAFA")).
-		Background(lipgloss.Color("#7D56F4")).
+		Foreground(theme.OnPrimary).
+		Background(theme.Primary).
 		Padding(2, 4).
 		Align(lipgloss.Center).
 		MarginBottom(3)
 
 	statusStyle := lipgloss.NewStyle().
-		Foreground(lipgloss.Color("#888")).
+		Foreground(theme.Muted).
 		Align(lipgloss.Center).
 		MarginBottom(2)
 
@@ -565,26 +1274,95 @@ func (m Model) renderCenterTimer() string {
 		minutes := remaining / 60
 		seconds := remaining % 60
 
-		// Create large ASCII art style numbers
-		bigTime := m.renderBigTime(minutes, seconds)
-		timerDisplay = timerStyle.Render(bigTime)
+		// Create large ASCII art style numbers, or a plain clock when the
+		// timer widget is turned off
+		if m.bigClock() {
+			rows, cols := max(m.height/3, 5), layout.Inner(m.width, 4)-8
+			timerDisplay = m.flash(timerStyle).Render(m.renderClock(minutes, seconds, rows, cols))
+		} else {
+			timerDisplay = m.flash(timerStyle.Padding(0, 1).MarginBottom(1)).Render(fmt.Sprintf("%02d:%02d", minutes, seconds))
+		}
+
+		// The buddy's countdown sits beside ours when there is room
+		if m.config.Buddy {
+			panel := m.renderBuddyPanel()
+			if lipgloss.Width(timerDisplay)+lipgloss.Width(panel) <= layout.Inner(m.width, 4) {
+				timerDisplay = lipgloss.JoinHorizontal(lipgloss.Top, timerDisplay, panel)
+			} else {
+				timerDisplay = lipgloss.JoinVertical(lipgloss.Center, timerDisplay, m.buddyLine())
+			}
+		}
 
 		percent := float64(m.timerElapsed) / float64(m.timerDuration)
-		progressWidth := 60
-		m.timerProgress.Width = progressWidth
-		progressBar = m.timerProgress.ViewAs(percent)
+		progressBar = m.renderProgress(percent, layout.Fit(60, layout.Inner(m.width, 4), 10))
 
-		if m.timerPaused {
-			status = statusStyle.Render("⏸️  Session Paused")
-		} else {
-			status = statusStyle.Render("🎯 Stay Focused!")
+		switch {
+		case m.timerPaused:
+			status = statusStyle.Render("⏸️  Session Paused • " + m.endTimeText())
+		case m.onBreak:
+			status = statusStyle.Render(lipgloss.JoinVertical(lipgloss.Center,
+				"☕ Break time - step away from the screen • "+m.endTimeText(),
+				m.renderBreakPrompt(),
+			))
+		default:
+			text := "🎯 " + m.status
+			if m.activeSession != nil {
+				if badge := intensityBadge(m.activeSession.Intensity); badge != "" {
+					text += " • " + badge
+				}
+				if badge := strictBadge(m.activeSession.Strict); badge != "" {
+					text += " • " + badge
+				}
+				if m.activeSession.Distractions > 0 {
+					text += " • ⚡ " + pluralDistractions(m.activeSession.Distractions)
+				}
+			}
+			status = statusStyle.Render(text + " • " + m.endTimeText())
 		}
 	} else {
 		timerDisplay = timerStyle.Render("Ready to Focus")
-		progressWidth := 60
-		m.timerProgress.Width = progressWidth
-		progressBar = m.timerProgress.ViewAs(0)
+		progressBar = m.renderProgress(0, layout.Fit(60, layout.Inner(m.width, 4), 10))
 		status = statusStyle.Render("Press 's' to start a session")
+		var badges []string
+		for _, badge := range []string{intensityBadge(m.nextIntensity), strictBadge(m.nextStrict)} {
+			if badge != "" {
+				badges = append(badges, badge)
+			}
+		}
+		if len(badges) > 0 {
+			status = statusStyle.Render(fmt.Sprintf("Press 's' to start a %s session", strings.Join(badges, " ")))
+		}
+		if m.suggestion != nil {
+			suggestionStyle := lipgloss.NewStyle().
+				Foreground(theme.Success).
+				Align(lipgloss.Center).
+				MarginBottom(2)
+			status = lipgloss.JoinVertical(
+				lipgloss.Center,
+				status,
+				suggestionStyle.Render(fmt.Sprintf("↩ Continue: %s? Press 'y'", m.suggestion.Label())),
+			)
+		}
+	}
+
+	if notice := m.renderChainNotice(); notice != "" {
+		status = lipgloss.JoinVertical(lipgloss.Center, status, notice)
+	}
+	if offer := m.renderScheduledOffer(); offer != "" {
+		status = lipgloss.JoinVertical(lipgloss.Center, status, offer)
+	}
+
+	if m.timerRunning && m.activeSession != nil {
+		if label := m.activeSession.Label(); label != "" {
+			labelStyle := lipgloss.NewStyle().
+				Foreground(theme.Accent).
+				Align(lipgloss.Center)
+			status = lipgloss.JoinVertical(lipgloss.Center, labelStyle.Render("🏷  "+label), status)
+		}
+	}
+
+	if m.onBreak && m.config.BreathingGuide {
+		status = lipgloss.JoinVertical(lipgloss.Center, m.renderBreathing(), status)
 	}
 
 	return lipgloss.JoinVertical(
@@ -595,52 +1373,24 @@ func (m Model) renderCenterTimer() string {
 	)
 }
 
-func (m Model) renderBigTime(minutes, seconds int) string {
-	// ASCII art for digits 0-9
-	digits := map[int][]string{
-		0: {"███", "█ █", "█ █", "█ █", "███"},
-		1: {" █ ", "██ ", " █ ", " █ ", "███"},
-		2: {"███", "  █", "███", "█  ", "███"},
-		3: {"███", "  █", "███", "  █", "███"},
-		4: {"█ █", "█ █", "███", "  █", "  █"},
-		5: {"███", "█  ", "███", "  █", "███"},
-		6: {"███", "█  ", "███", "█ █", "███"},
-		7: {"███", "  █", "  █", "  █", "  █"},
-		8: {"███", "█ █", "███", "█ █", "███"},
-		9: {"███", "█ █", "███", "  █", "███"},
-	}
-
-	colon := []string{" ", "█", " ", "█", " "}
-
-	m1 := minutes / 10
-	m2 := minutes % 10
-	s1 := seconds / 10
-	s2 := seconds % 10
-
-	var lines []string
-	for row := 0; row < 5; row++ {
-		line := digits[m1][row] + " " + digits[m2][row] + " " + colon[row] + " " + digits[s1][row] + " " + digits[s2][row]
-		lines = append(lines, line)
-	}
-
-	return lipgloss.JoinVertical(lipgloss.Center, lines...)
-}
-
 func (m Model) renderSimpleProgress() string {
 	progressStyle := lipgloss.NewStyle().
-		Foreground(lipgloss.Color("#FDFF8C")).
+		Foreground(theme.Highlight).
 		Align(lipgloss.Center).
 		MarginTop(2)
 
 	dateStyle := lipgloss.NewStyle().
-		Foreground(lipgloss.Color("#888")).
+		Foreground(theme.Muted).
 		Align(lipgloss.Center).
 		MarginBottom(1)
 
 	completed := m.todayStats.SessionsCount
 	goal := m.config.DailySessionGoal
 
-	currentDate := time.Now().Format("Monday, January 2, 2006")
+	currentDate := m.now().Format("Monday, January 2, 2006")
+	if profile := m.storage.Profile(); profile != storage.DefaultProfile {
+		currentDate += " • " + profile
+	}
 	progressText := fmt.Sprintf(
 		"Today: %d/%d sessions • %dm",
 		completed,
@@ -649,53 +1399,45 @@ func (m Model) renderSimpleProgress() string {
 	)
 
 	// Simple progress bar
-	barWidth := 40
-	filledWidth := int(float64(completed) / float64(goal) * float64(barWidth))
-	if filledWidth > barWidth {
-		filledWidth = barWidth
-	}
+	barWidth := layout.Fit(40, layout.Inner(m.width, 4), 10)
+	bar := layout.Bar(float64(completed)/float64(goal), barWidth, "■", "□")
 
-	bar := ""
-	for i := 0; i < barWidth; i++ {
-		if i < filledWidth {
-			bar += "■"
-		} else {
-			bar += "□"
-		}
-	}
-
-	return lipgloss.JoinVertical(
-		lipgloss.Center,
+	parts := []string{
 		dateStyle.Render(currentDate),
 		progressStyle.Render(progressText),
 		progressStyle.Render(bar),
-	)
+	}
+	if countdown := m.renderGoalCountdown(); countdown != "" {
+		parts = append(parts, countdown)
+	}
+
+	return lipgloss.JoinVertical(lipgloss.Center, parts...)
 }
 
 func (m Model) renderTimerSection(width int) string {
 	sectionStyle := lipgloss.NewStyle().
 		Width(width).
 		Border(lipgloss.RoundedBorder()).
-		BorderForeground(lipgloss.Color("#FF7CCB")).
+		BorderForeground(theme.Accent).
 		Padding(1).
 		MarginRight(1)
 
 	titleStyle := lipgloss.NewStyle().
 		Bold(true).
-		Foreground(lipgloss.Color("#FF7CCB")).
+		Foreground(theme.Accent).
 		Align(lipgloss.Center).
 		MarginBottom(1)
 
 	timerStyle := lipgloss.NewStyle().
 		Bold(true).
-		Foreground(lipgloss.Color("#FAFAFA")).
-		Background(lipgloss.Color("#7D56F4")).
+		Foreground(theme.OnPrimary).
+		Background(theme.Primary).
 		Padding(1, 2).
 		Align(lipgloss.Center).
 		MarginBottom(1)
 
 	statusStyle := lipgloss.NewStyle().
-		Foreground(lipgloss.Color("#888")).
+		Foreground(theme.Muted).
 		Align(lipgloss.Center).
 		MarginBottom(1)
 
@@ -738,18 +1480,18 @@ func (m Model) renderDailySection(width int) string {
 	sectionStyle := lipgloss.NewStyle().
 		Width(width).
 		Border(lipgloss.RoundedBorder()).
-		BorderForeground(lipgloss.Color("#FDFF8C")).
+		BorderForeground(theme.Highlight).
 		Padding(1).
 		MarginRight(1)
 
 	titleStyle := lipgloss.NewStyle().
 		Bold(true).
-		Foreground(lipgloss.Color("#FDFF8C")).
+		Foreground(theme.Highlight).
 		Align(lipgloss.Center).
 		MarginBottom(1)
 
 	statsStyle := lipgloss.NewStyle().
-		Foreground(lipgloss.Color("#888")).
+		Foreground(theme.Muted).
 		Align(lipgloss.Center).
 		MarginBottom(1)
 
@@ -766,27 +1508,11 @@ func (m Model) renderDailySection(width int) string {
 	// Progress bar
 	completed := m.todayStats.SessionsCount
 	goal := m.config.DailySessionGoal
-	barWidth := width - 6
-	if barWidth < 10 {
-		barWidth = 10
-	}
-
-	filledWidth := int(float64(completed) / float64(goal) * float64(barWidth))
-	if filledWidth > barWidth {
-		filledWidth = barWidth
-	}
-
-	bar := ""
-	for i := 0; i < barWidth; i++ {
-		if i < filledWidth {
-			bar += "█"
-		} else {
-			bar += "░"
-		}
-	}
+	barWidth := max(width-6, 10)
+	bar := layout.Bar(float64(completed)/float64(goal), barWidth, "█", "░")
 
 	progressStyle := lipgloss.NewStyle().
-		Foreground(lipgloss.Color("#FDFF8C")).
+		Foreground(theme.Highlight).
 		Align(lipgloss.Center)
 
 	content := lipgloss.JoinVertical(
@@ -803,17 +1529,17 @@ func (m Model) renderWeeklySection(width int) string {
 	sectionStyle := lipgloss.NewStyle().
 		Width(width).
 		Border(lipgloss.RoundedBorder()).
-		BorderForeground(lipgloss.Color("#4CAF50")).
+		BorderForeground(theme.Success).
 		Padding(1)
 
 	titleStyle := lipgloss.NewStyle().
 		Bold(true).
-		Foreground(lipgloss.Color("#4CAF50")).
+		Foreground(theme.Success).
 		Align(lipgloss.Center).
 		MarginBottom(1)
 
 	statsStyle := lipgloss.NewStyle().
-		Foreground(lipgloss.Color("#888")).
+		Foreground(theme.Muted).
 		Align(lipgloss.Center)
 
 	title := titleStyle.Render("📅 This Week")
@@ -844,7 +1570,7 @@ func (m Model) renderDailyView() string {
 
 	titleStyle := lipgloss.NewStyle().
 		Bold(true).
-		Foreground(lipgloss.Color("#FF7CCB")).
+		Foreground(theme.Accent).
 		MarginBottom(2).
 		Align(lipgloss.Center)
 
@@ -873,7 +1599,7 @@ func (m Model) renderWeeklyView() string {
 
 	titleStyle := lipgloss.NewStyle().
 		Bold(true).
-		Foreground(lipgloss.Color("#FF7CCB")).
+		Foreground(theme.Accent).
 		MarginBottom(2).
 		Align(lipgloss.Center)
 
@@ -886,6 +1612,7 @@ func (m Model) renderWeeklyView() string {
 		lipgloss.Left,
 		title,
 		statsSection,
+		m.renderBurndown(),
 		help,
 	)
 
@@ -894,25 +1621,45 @@ func (m Model) renderWeeklyView() string {
 
 func (m Model) renderDailyStatsDetail() string {
 	statsStyle := lipgloss.NewStyle().
-		Foreground(lipgloss.Color("#FDFF8C")).
+		Foreground(theme.Highlight).
 		MarginBottom(1)
 
 	sessionStyle := lipgloss.NewStyle().
-		Foreground(lipgloss.Color("#888")).
+		Foreground(theme.Muted).
 		PaddingLeft(2)
 
 	stats := statsStyle.Render(fmt.Sprintf(
 		"Completed Sessions: %d | Actual Time: %d mins",
 		m.todayStats.SessionsCount,
 		m.todayStats.TotalMinutes,
-	))
+	) + qualityLine(
+		avgFocusText(m.todayStats.AverageFocus),
+		distractionsText(m.todayStats.Distractions),
+		weightedText(m.todayStats.WeightedMinutes, m.todayStats.TotalMinutes),
+		falseStartsText(m.todayStats.FalseStarts),
+		partialText(m.todayStats.PartialSessions, m.todayStats.PartialMinutes),
+	) + qualityLine(intensityText(m.todayStats.IntensityMinutes)))
+
+	metaStyle := lipgloss.NewStyle().
+		Foreground(theme.Faint).
+		PaddingLeft(5)
 
 	var sessions string
 	if len(m.todayStats.Sessions) == 0 {
 		sessions = sessionStyle.Render("No sessions yet today. Time to focus! 🚀")
+		if !m.statsAt.IsZero() {
+			sessions = sessionStyle.Render("No sessions this day.")
+		}
 	} else {
 		sessions = "\nSession History:\n"
+		if m.historyFilter != "" {
+			sessions = fmt.Sprintf("\nSession History (filter: %q):\n", m.historyFilter)
+		}
 		for i, session := range m.todayStats.Sessions {
+			if !session.MatchesMetadata(m.historyFilter) {
+				continue
+			}
+
 			var status string
 			var sessionInfo string
 
@@ -960,8 +1707,8 @@ func (m Model) renderDailyStatsDetail() string {
 
 				if actualDuration > 0 {
 					sessionInfo = fmt.Sprintf(
-						"%s Session %d: Stopped early - %s (%d of %d min)",
-						status, i+1,
+						"%s Session %d: %s - %s (%d of %d min)",
+						status, i+1, stoppedLabel(session),
 						session.StartTime.Format("3:04 PM"),
 						actualDuration, session.Duration,
 					)
@@ -973,24 +1720,48 @@ func (m Model) renderDailyStatsDetail() string {
 					)
 				}
 			}
+			if badge := intensityBadge(session.Intensity); badge != "" {
+				sessionInfo += " " + badge
+			}
+			if session.IsFalseStart(m.config.MinSessionMinutes) {
+				sessionInfo += " · false start"
+			}
 			sessions += sessionStyle.Render(sessionInfo) + "\n"
+			if label := session.Label(); label != "" {
+				sessions += metaStyle.Render("🏷  "+label) + "\n"
+			}
+			if session.Distractions > 0 {
+				sessions += metaStyle.Render("⚡ "+pluralDistractions(session.Distractions)) + "\n"
+			}
+			if reflection := formatReflection(session); reflection != "" {
+				sessions += metaStyle.Render(reflection) + "\n"
+			}
+			if meta := formatMetadata(session.Metadata); meta != "" {
+				sessions += metaStyle.Render(meta) + "\n"
+			}
 		}
 	}
 
+	sessions += renderInterruptions(m.todayStats.Sessions)
+
+	if m.filtering {
+		sessions += "\n" + m.filterInput.View()
+	}
+
 	return lipgloss.JoinVertical(
 		lipgloss.Left,
 		stats,
 		sessions,
-	)
+	) + m.renderOffDay() + m.renderJournal()
 }
 
 func (m Model) renderWeeklyStatsDetail() string {
 	statsStyle := lipgloss.NewStyle().
-		Foreground(lipgloss.Color("#FDFF8C")).
+		Foreground(theme.Highlight).
 		MarginBottom(1)
 
 	dayStyle := lipgloss.NewStyle().
-		Foreground(lipgloss.Color("#888")).
+		Foreground(theme.Muted).
 		PaddingLeft(2)
 
 	hours := m.weekStats.TotalMinutes / 60
@@ -1010,11 +1781,21 @@ func (m Model) renderWeeklyStatsDetail() string {
 		"Completed Sessions: %d | Actual Time: %s",
 		m.weekStats.SessionsCount,
 		timeStr,
-	))
+	) + qualityLine(
+		avgFocusText(m.weekStats.AverageFocus),
+		avgDistractionsText(m.weekStats.Distractions, m.weekStats.SessionsCount),
+		weightedText(m.weekStats.WeightedMinutes, m.weekStats.TotalMinutes),
+		falseStartsText(m.weekStats.FalseStarts),
+		partialText(m.weekStats.PartialSessions, m.weekStats.PartialMinutes),
+	) + qualityLine(intensityText(m.weekStats.IntensityMinutes)) +
+		qualityLine(comparisonText(m.weekDelta, "week")))
 
 	var days string
 	if len(m.weekStats.DailyStats) == 0 {
 		days = dayStyle.Render("No sessions this week yet. Let's get started! 💪")
+		if !m.statsAt.IsZero() {
+			days = dayStyle.Render("No sessions this week.")
+		}
 	} else {
 		days = "\nDaily Breakdown:\n"
 		for _, day := range m.weekStats.DailyStats {
@@ -1038,7 +1819,7 @@ func (m Model) renderWeeklyStatsDetail() string {
 				date.Format("Monday"),
 				day.SessionsCount,
 				timeStr,
-			)
+			) + rowQuality(day.AverageFocus, day.Distractions, day.SessionsCount)
 			days += dayStyle.Render(dayInfo) + "\n"
 		}
 	}
@@ -1058,24 +1839,24 @@ func (m Model) renderStatsView() string {
 
 	titleStyle := lipgloss.NewStyle().
 		Bold(true).
-		Foreground(lipgloss.Color("#FF7CCB")).
+		Foreground(theme.Accent).
 		MarginBottom(1).
 		Align(lipgloss.Center)
 
 	dateStyle := lipgloss.NewStyle().
-		Foreground(lipgloss.Color("#888")).
+		Foreground(theme.Muted).
 		MarginBottom(2).
 		Align(lipgloss.Center)
 
 	sectionStyle := lipgloss.NewStyle().
 		Border(lipgloss.RoundedBorder()).
-		BorderForeground(lipgloss.Color("#666")).
+		BorderForeground(theme.Faint).
 		Padding(0, 1).
 		MarginRight(1).
 		MarginBottom(1)
 
-	currentYear := time.Now().Year()
-	currentDate := time.Now().Format("Monday, January 2, 2006")
+	currentYear := m.now().Year()
+	currentDate := m.now().Format("Monday, January 2, 2006")
 
 	title := titleStyle.Render(fmt.Sprintf("📊 Statistics Overview - %d", currentYear))
 	dateInfo := dateStyle.Render(currentDate)
@@ -1087,10 +1868,11 @@ func (m Model) renderStatsView() string {
 	yearlySection := m.renderYearlySummary()
 
 	// Calculate available width after container padding
-	availableWidth := m.width - 4 // Account for container padding
+	availableWidth := layout.Inner(m.width, 2)
 
 	var content string
-	if availableWidth > 200 {
+	switch layout.For(availableWidth) {
+	case layout.XLarge:
 		// Very wide screen - show four columns
 		// Account for borders (2 chars each) and gaps between sections
 		colWidth := (availableWidth - 12) / 4 // 3 gaps * 4 chars for borders
@@ -1101,7 +1883,7 @@ func (m Model) renderStatsView() string {
 			sectionStyle.Width(colWidth).Render(monthlySection),
 			sectionStyle.Width(colWidth).Render(yearlySection),
 		)
-	} else if availableWidth > 100 {
+	case layout.Large:
 		// Medium screen - show 2x2 grid
 		colWidth := (availableWidth - 6) / 2 // 1 gap, 4 chars for borders
 		row1 := lipgloss.JoinHorizontal(
@@ -1115,7 +1897,7 @@ func (m Model) renderStatsView() string {
 			sectionStyle.Width(colWidth).Render(yearlySection),
 		)
 		content = lipgloss.JoinVertical(lipgloss.Left, row1, row2)
-	} else if availableWidth > 60 {
+	case layout.Medium, layout.Small:
 		// Narrow screen - stack in pairs
 		row1 := lipgloss.JoinVertical(
 			lipgloss.Left,
@@ -1128,7 +1910,7 @@ func (m Model) renderStatsView() string {
 			sectionStyle.Width(availableWidth-2).Render(yearlySection),
 		)
 		content = lipgloss.JoinVertical(lipgloss.Left, row1, row2)
-	} else {
+	default:
 		// Very narrow screen - show only today and week
 		content = lipgloss.JoinVertical(
 			lipgloss.Left,
@@ -1138,6 +1920,9 @@ func (m Model) renderStatsView() string {
 	}
 
 	help := m.renderHelp()
+	if m.pickingFilter {
+		help = m.renderStatsFilter()
+	}
 
 	fullContent := lipgloss.JoinVertical(
 		lipgloss.Left,
@@ -1153,12 +1938,12 @@ func (m Model) renderStatsView() string {
 func (m Model) renderDailySummary() string {
 	titleStyle := lipgloss.NewStyle().
 		Bold(true).
-		Foreground(lipgloss.Color("#FDFF8C"))
+		Foreground(theme.Highlight)
 
 	contentStyle := lipgloss.NewStyle().
-		Foreground(lipgloss.Color("#888"))
+		Foreground(theme.Muted)
 
-	date := time.Now().Format("Monday, Jan 2")
+	date := m.now().Format("Monday, Jan 2")
 	title := titleStyle.Render("📅 " + date)
 
 	goalText := "sessions"
@@ -1171,7 +1956,7 @@ func (m Model) renderDailySummary() string {
 		m.todayStats.TotalMinutes,
 		m.config.DailySessionGoal,
 		goalText,
-	))
+	) + focusLine(m.todayStats.AverageFocus))
 
 	return title + content
 }
@@ -1179,10 +1964,10 @@ func (m Model) renderDailySummary() string {
 func (m Model) renderWeeklySummary() string {
 	titleStyle := lipgloss.NewStyle().
 		Bold(true).
-		Foreground(lipgloss.Color("#4CAF50"))
+		Foreground(theme.Success)
 
 	contentStyle := lipgloss.NewStyle().
-		Foreground(lipgloss.Color("#888"))
+		Foreground(theme.Muted)
 
 	hours := m.weekStats.TotalMinutes / 60
 	mins := m.weekStats.TotalMinutes % 60
@@ -1198,13 +1983,15 @@ func (m Model) renderWeeklySummary() string {
 	}
 
 	title := titleStyle.Render(fmt.Sprintf("📅 Week %d", m.weekStats.Week))
+	weekFrom, weekTo := m.weekRange()
 
 	content := contentStyle.Render(fmt.Sprintf(
-		"\nSessions: %d\nTime: %s\nAvg/day: %.1f",
+		"\nSessions: %d\nTime: %s\nAvg/%s: %.1f",
 		m.weekStats.SessionsCount,
 		timeStr,
-		float64(m.weekStats.SessionsCount)/7.0,
-	))
+		m.averageDayName(),
+		m.averagePerDay(m.weekStats.SessionsCount, weekFrom, weekTo),
+	) + focusLine(m.weekStats.AverageFocus))
 
 	return title + content
 }
@@ -1212,10 +1999,10 @@ func (m Model) renderWeeklySummary() string {
 func (m Model) renderMonthlySummary() string {
 	titleStyle := lipgloss.NewStyle().
 		Bold(true).
-		Foreground(lipgloss.Color("#FF6B6B"))
+		Foreground(theme.Danger)
 
 	contentStyle := lipgloss.NewStyle().
-		Foreground(lipgloss.Color("#888"))
+		Foreground(theme.Muted)
 
 	hours := m.monthStats.TotalMinutes / 60
 	mins := m.monthStats.TotalMinutes % 60
@@ -1232,13 +2019,15 @@ func (m Model) renderMonthlySummary() string {
 
 	monthTime, _ := time.Parse("2006-01", m.monthStats.Month)
 	title := titleStyle.Render("📈 " + monthTime.Format("January"))
+	monthFrom, monthTo := m.monthRange()
 
 	content := contentStyle.Render(fmt.Sprintf(
-		"\nSessions: %d\nTime: %s\nAvg/day: %.1f",
+		"\nSessions: %d\nTime: %s\nAvg/%s: %.1f",
 		m.monthStats.SessionsCount,
 		timeStr,
-		float64(m.monthStats.SessionsCount)/30.0,
-	))
+		m.averageDayName(),
+		m.averagePerDay(m.monthStats.SessionsCount, monthFrom, monthTo),
+	) + focusLine(m.monthStats.AverageFocus))
 
 	return title + content
 }
@@ -1251,7 +2040,7 @@ func (m Model) renderMonthlyView() string {
 
 	titleStyle := lipgloss.NewStyle().
 		Bold(true).
-		Foreground(lipgloss.Color("#FF7CCB")).
+		Foreground(theme.Accent).
 		MarginBottom(2).
 		Align(lipgloss.Center)
 
@@ -1273,11 +2062,11 @@ func (m Model) renderMonthlyView() string {
 
 func (m Model) renderMonthlyStatsDetail() string {
 	statsStyle := lipgloss.NewStyle().
-		Foreground(lipgloss.Color("#FDFF8C")).
+		Foreground(theme.Highlight).
 		MarginBottom(1)
 
 	weekStyle := lipgloss.NewStyle().
-		Foreground(lipgloss.Color("#888")).
+		Foreground(theme.Muted).
 		PaddingLeft(2)
 
 	hours := m.monthStats.TotalMinutes / 60
@@ -1297,17 +2086,28 @@ func (m Model) renderMonthlyStatsDetail() string {
 		"Total Sessions: %d | Total Time: %s",
 		m.monthStats.SessionsCount,
 		timeStr,
-	))
-
-	avgPerDay := float64(m.monthStats.SessionsCount) / 30.0
+	) + qualityLine(
+		avgFocusText(m.monthStats.AverageFocus),
+		weightedText(m.monthStats.WeightedMinutes, m.monthStats.TotalMinutes),
+		falseStartsText(m.monthStats.FalseStarts),
+		partialText(m.monthStats.PartialSessions, m.monthStats.PartialMinutes),
+	) + qualityLine(intensityText(m.monthStats.IntensityMinutes)) +
+		qualityLine(comparisonText(m.monthDelta, "month")))
+
+	from, to := m.monthRange()
+	avgPerDay := m.averagePerDay(m.monthStats.SessionsCount, from, to)
 	avgStats := statsStyle.Render(fmt.Sprintf(
-		"Average: %.1f sessions per day",
+		"Average: %.1f sessions per %s",
 		avgPerDay,
+		m.averageDayName(),
 	))
 
 	var weeks string
 	if len(m.monthStats.WeeklyStats) == 0 {
 		weeks = weekStyle.Render("No sessions this month yet. Time to build momentum! 🎯")
+		if !m.statsAt.IsZero() {
+			weeks = weekStyle.Render("No sessions this month.")
+		}
 	} else {
 		weeks = "\nWeekly Breakdown:\n"
 		for _, week := range m.monthStats.WeeklyStats {
@@ -1329,7 +2129,7 @@ func (m Model) renderMonthlyStatsDetail() string {
 				week.Week,
 				week.SessionsCount,
 				weekTimeStr,
-			)
+			) + rowQuality(week.AverageFocus, 0, week.SessionsCount)
 			weeks += weekStyle.Render(weekInfo) + "\n"
 		}
 	}
@@ -1350,7 +2150,7 @@ func (m Model) renderDailyDetailView() string {
 
 	titleStyle := lipgloss.NewStyle().
 		Bold(true).
-		Foreground(lipgloss.Color("#FF7CCB")).
+		Foreground(theme.Accent).
 		MarginBottom(2).
 		Align(lipgloss.Center)
 
@@ -1378,7 +2178,7 @@ func (m Model) renderWeeklyDetailView() string {
 
 	titleStyle := lipgloss.NewStyle().
 		Bold(true).
-		Foreground(lipgloss.Color("#FF7CCB")).
+		Foreground(theme.Accent).
 		MarginBottom(2).
 		Align(lipgloss.Center)
 
@@ -1391,6 +2191,7 @@ func (m Model) renderWeeklyDetailView() string {
 		lipgloss.Left,
 		title,
 		statsSection,
+		m.renderBurndown(),
 		help,
 	)
 
@@ -1400,10 +2201,10 @@ func (m Model) renderWeeklyDetailView() string {
 func (m Model) renderYearlySummary() string {
 	titleStyle := lipgloss.NewStyle().
 		Bold(true).
-		Foreground(lipgloss.Color("#00BFFF"))
+		Foreground(theme.Info)
 
 	contentStyle := lipgloss.NewStyle().
-		Foreground(lipgloss.Color("#888"))
+		Foreground(theme.Muted)
 
 	hours := m.yearStats.TotalMinutes / 60
 	mins := m.yearStats.TotalMinutes % 60
@@ -1424,8 +2225,8 @@ func (m Model) renderYearlySummary() string {
 		"\nSessions: %d\nTime: %s\nAvg/month: %.1f",
 		m.yearStats.SessionsCount,
 		timeStr,
-		float64(m.yearStats.SessionsCount)/12.0,
-	))
+		float64(m.yearStats.SessionsCount)/float64(m.monthsElapsed()),
+	) + focusLine(m.yearStats.AverageFocus))
 
 	return title + content
 }
@@ -1438,7 +2239,7 @@ func (m Model) renderMonthlyDetailView() string {
 
 	titleStyle := lipgloss.NewStyle().
 		Bold(true).
-		Foreground(lipgloss.Color("#FF7CCB")).
+		Foreground(theme.Accent).
 		MarginBottom(2).
 		Align(lipgloss.Center)
 
@@ -1452,6 +2253,7 @@ func (m Model) renderMonthlyDetailView() string {
 		lipgloss.Left,
 		title,
 		statsSection,
+		m.renderTrend(),
 		help,
 	)
 
@@ -1466,7 +2268,7 @@ func (m Model) renderYearlyDetailView() string {
 
 	titleStyle := lipgloss.NewStyle().
 		Bold(true).
-		Foreground(lipgloss.Color("#FF7CCB")).
+		Foreground(theme.Accent).
 		MarginBottom(2).
 		Align(lipgloss.Center)
 
@@ -1479,6 +2281,7 @@ func (m Model) renderYearlyDetailView() string {
 		lipgloss.Left,
 		title,
 		statsSection,
+		m.renderTrend(),
 		help,
 	)
 
@@ -1487,11 +2290,11 @@ func (m Model) renderYearlyDetailView() string {
 
 func (m Model) renderYearlyStatsDetail() string {
 	statsStyle := lipgloss.NewStyle().
-		Foreground(lipgloss.Color("#FDFF8C")).
+		Foreground(theme.Highlight).
 		MarginBottom(1)
 
 	monthStyle := lipgloss.NewStyle().
-		Foreground(lipgloss.Color("#888")).
+		Foreground(theme.Muted).
 		PaddingLeft(2)
 
 	hours := m.yearStats.TotalMinutes / 60
@@ -1511,19 +2314,29 @@ func (m Model) renderYearlyStatsDetail() string {
 		"Total Sessions: %d | Total Time: %s",
 		m.yearStats.SessionsCount,
 		timeStr,
-	))
-
-	avgPerDay := float64(m.yearStats.SessionsCount) / 365.0
-	avgPerMonth := float64(m.yearStats.SessionsCount) / 12.0
+	) + qualityLine(
+		avgFocusText(m.yearStats.AverageFocus),
+		weightedText(m.yearStats.WeightedMinutes, m.yearStats.TotalMinutes),
+		falseStartsText(m.yearStats.FalseStarts),
+		partialText(m.yearStats.PartialSessions, m.yearStats.PartialMinutes),
+	) + qualityLine(intensityText(m.yearStats.IntensityMinutes)))
+
+	from, to := m.yearRange()
+	avgPerDay := m.averagePerDay(m.yearStats.SessionsCount, from, to)
+	avgPerMonth := float64(m.yearStats.SessionsCount) / float64(m.monthsElapsed())
 	avgStats := statsStyle.Render(fmt.Sprintf(
-		"Average: %.1f sessions per day | %.1f sessions per month",
+		"Average: %.1f sessions per %s | %.1f sessions per month",
 		avgPerDay,
+		m.averageDayName(),
 		avgPerMonth,
 	))
 
 	var months string
 	if len(m.yearStats.MonthlyStats) == 0 {
 		months = monthStyle.Render("No sessions this year yet. Time to get started! 🎯")
+		if !m.statsAt.IsZero() {
+			months = monthStyle.Render("No sessions this year.")
+		}
 	} else {
 		months = "\nMonthly Breakdown:\n"
 		for _, month := range m.yearStats.MonthlyStats {
@@ -1546,7 +2359,7 @@ func (m Model) renderYearlyStatsDetail() string {
 				monthTime.Format("January"),
 				month.SessionsCount,
 				monthTimeStr,
-			)
+			) + rowQuality(month.AverageFocus, 0, month.SessionsCount)
 			months += monthStyle.Render(monthInfo) + "\n"
 		}
 	}
@@ -1561,39 +2374,35 @@ func (m Model) renderYearlyStatsDetail() string {
 
 func (m Model) renderHelp() string {
 	helpStyle := lipgloss.NewStyle().
-		Foreground(lipgloss.Color("#666")).
+		Foreground(theme.Faint).
 		MarginTop(2)
 
+	// Stats views are padded by 2 on each side, the home view by 4
+	inner := layout.Inner(m.width, 2)
+
 	var helpText string
-	switch m.viewState {
-	case StatsView:
-		if m.width > 100 {
-			helpText = "d: daily • w: weekly • m: monthly • y: yearly • e: export • b: back • ?: help • g: settings • q: quit"
-		} else {
-			helpText = "d/w/m/y: details • e: export • b: back • ?: help • q: quit"
-		}
-	case StatsDetailDaily, StatsDetailWeekly, StatsDetailMonthly, StatsDetailYearly:
-		helpText = "e: export all stats • b: back • h: home • ?: help • q: quit"
+	switch {
+	case m.viewState == RangeView && m.pickingRange:
+		helpText = "tab: next field • enter: show stats • esc: cancel"
+	case m.viewState == StatsDetailDaily && m.filtering:
+		helpText = "enter: apply filter • esc: clear filter"
+	case m.viewState == StatsDetailDaily && m.editingJournal:
+		helpText = "enter: save note • esc: cancel"
+	case m.viewState == HomeView:
+		helpText = m.shortHelp(layout.Inner(m.width, 4))
 	default:
-		if m.timerRunning {
-			if m.width > 80 {
-				helpText = "p: pause • r: resume • c: cancel • t: stats • ?: help • g: settings • q: quit"
-			} else {
-				helpText = "p: pause • r: resume • c: cancel • t: stats • q: quit"
-			}
-		} else {
-			if m.width > 80 {
-				helpText = "s: start • t: stats • ?: help • g: settings • q: quit"
-			} else {
-				helpText = "s: start • t: stats • ?: help • q: quit"
-			}
-		}
+		helpText = m.shortHelp(inner)
+	}
+
+	// Stats views note when they only count some sessions
+	if badge := m.renderFilterBadge(); badge != "" && m.viewState != HomeView {
+		helpText = badge + "\n" + helpText
 	}
 
 	// Show export message if present
 	if m.showExportMsg && m.exportMessage != "" {
 		messageStyle := lipgloss.NewStyle().
-			Foreground(lipgloss.Color("#00FF00")).
+			Foreground(theme.Notice).
 			Bold(true).
 			MarginBottom(1)
 		return lipgloss.JoinVertical(
@@ -1627,21 +2436,51 @@ func min(a, b int) int {
 }
 
 type keyMap struct {
-	Start    key.Binding
-	Pause    key.Binding
-	Resume   key.Binding
-	Cancel   key.Binding
-	Home     key.Binding
-	Stats    key.Binding
-	Daily    key.Binding
-	Weekly   key.Binding
-	Monthly  key.Binding
-	Yearly   key.Binding
-	Back     key.Binding
-	Help     key.Binding
-	Settings key.Binding
-	Quit     key.Binding
-	Export   key.Binding
+	Start        key.Binding
+	Pause        key.Binding
+	Resume       key.Binding
+	Cancel       key.Binding
+	Home         key.Binding
+	Stats        key.Binding
+	Daily        key.Binding
+	Weekly       key.Binding
+	Monthly      key.Binding
+	Yearly       key.Binding
+	Back         key.Binding
+	Help         key.Binding
+	Settings     key.Binding
+	Quit         key.Binding
+	Export       key.Binding
+	Filter       key.Binding
+	Insights     key.Binding
+	Label        key.Binding
+	Continue     key.Binding
+	Zen          key.Binding
+	Break        key.Binding
+	Plan         key.Binding
+	Tasks        key.Binding
+	Journal      key.Binding
+	DayOff       key.Binding
+	Review       key.Binding
+	YearReview   key.Binding
+	DateRange    key.Binding
+	StatsFilter  key.Binding
+	Estimates    key.Binding
+	Distract     key.Binding
+	Level        key.Binding
+	Interrupt    key.Binding
+	Buddy        key.Binding
+	Method       key.Binding
+	Profile      key.Binding
+	Hours        key.Binding
+	Achievements key.Binding
+	Strict       key.Binding
+	Undo         key.Binding
+	Extend       key.Binding
+	Shorten      key.Binding
+	Prev         key.Binding
+	Next         key.Binding
+	Palette      key.Binding
 }
 
 var keys = keyMap{
@@ -1691,7 +2530,7 @@ var keys = keyMap{
 	),
 	Help: key.NewBinding(
 		key.WithKeys("?", "f1"),
-		key.WithHelp("?/f1", "help"),
+		key.WithHelp("?", "help"),
 	),
 	Settings: key.NewBinding(
 		key.WithKeys("g"),
@@ -1703,6 +2542,126 @@ var keys = keyMap{
 	),
 	Export: key.NewBinding(
 		key.WithKeys("e"),
-		key.WithHelp("e", "export stats"),
+		key.WithHelp("e", "export"),
+	),
+	Filter: key.NewBinding(
+		key.WithKeys("f"),
+		key.WithHelp("f", "filter history"),
+	),
+	Insights: key.NewBinding(
+		key.WithKeys("i"),
+		key.WithHelp("i", "insights"),
+	),
+	Label: key.NewBinding(
+		key.WithKeys("n"),
+		key.WithHelp("n", "label"),
+	),
+	Continue: key.NewBinding(
+		key.WithKeys("y"),
+		key.WithHelp("y", "continue last task"),
+	),
+	Zen: key.NewBinding(
+		key.WithKeys("z"),
+		key.WithHelp("z", "zen"),
+	),
+	Break: key.NewBinding(
+		key.WithKeys("a"),
+		key.WithHelp("a", "break"),
+	),
+	Plan: key.NewBinding(
+		key.WithKeys("o"),
+		key.WithHelp("o", "plan"),
+	),
+	Tasks: key.NewBinding(
+		key.WithKeys("T"),
+		key.WithHelp("T", "tasks"),
+	),
+	Journal: key.NewBinding(
+		key.WithKeys("n"),
+		key.WithHelp("n", "journal"),
+	),
+	Review: key.NewBinding(
+		key.WithKeys("R"),
+		key.WithHelp("R", "weekly review"),
+	),
+	DayOff: key.NewBinding(
+		key.WithKeys("O"),
+		key.WithHelp("O", "day off"),
+	),
+	YearReview: key.NewBinding(
+		key.WithKeys("Y"),
+		key.WithHelp("Y", "year in review"),
+	),
+	DateRange: key.NewBinding(
+		key.WithKeys("D"),
+		key.WithHelp("D", "date range"),
+	),
+	StatsFilter: key.NewBinding(
+		key.WithKeys("F"),
+		key.WithHelp("F", "filter stats"),
+	),
+	Estimates: key.NewBinding(
+		key.WithKeys("E"),
+		key.WithHelp("E", "estimates"),
+	),
+	Distract: key.NewBinding(
+		key.WithKeys("x"),
+		key.WithHelp("x", "distracted"),
+	),
+	Level: key.NewBinding(
+		key.WithKeys("l"),
+		key.WithHelp("l", "intensity"),
+	),
+	Interrupt: key.NewBinding(
+		key.WithKeys("X"),
+		key.WithHelp("X", "interruption"),
+	),
+	Buddy: key.NewBinding(
+		key.WithKeys("u"),
+		key.WithHelp("u", "buddy"),
+	),
+	Method: key.NewBinding(
+		key.WithKeys("M"),
+		key.WithHelp("M", "method"),
+	),
+	Profile: key.NewBinding(
+		key.WithKeys("P"),
+		key.WithHelp("P", "profile"),
+	),
+	Hours: key.NewBinding(
+		key.WithKeys("H"),
+		key.WithHelp("H", "hours"),
+	),
+	Achievements: key.NewBinding(
+		key.WithKeys("A"),
+		key.WithHelp("A", "achievements"),
+	),
+	Strict: key.NewBinding(
+		key.WithKeys("S"),
+		key.WithHelp("S", "strict"),
+	),
+	Undo: key.NewBinding(
+		key.WithKeys("U"),
+		key.WithHelp("U", "undo"),
+	),
+	Extend: key.NewBinding(
+		key.WithKeys("+", "="),
+		key.WithHelp("+", "+5 min"),
+	),
+	Shorten: key.NewBinding(
+		key.WithKeys("-"),
+		key.WithHelp("-", "-5 min"),
+	),
+	Prev: key.NewBinding(
+		key.WithKeys("left"),
+		key.WithHelp("←", "previous"),
+	),
+	Next: key.NewBinding(
+		key.WithKeys("right"),
+		key.WithHelp("→", "next"),
+	),
+	Palette: key.NewBinding(
+		key.WithKeys("ctrl+p"),
+		key.WithHelp("ctrl+p", "commands"),
 	),
 }
@@ -0,0 +1,202 @@
+package dashboard
+
+import (
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/adibhanna/focussessions/internal/models"
+	"github.com/adibhanna/focussessions/internal/ui/layout"
+)
+
+// clockFont draws the digits 0-9 and the colon of the big countdown, one
+// string per row.
+type clockFont struct {
+	digits map[int][]string
+	colon  []string
+}
+
+var clockFonts = map[string]clockFont{
+	models.ClockBlock: {
+		digits: map[int][]string{
+			0: {"███", "█ █", "█ █", "█ █", "███"},
+			1: {" █ ", "██ ", " █ ", " █ ", "███"},
+			2: {"███", "  █", "███", "█  ", "███"},
+			3: {"███", "  █", "███", "  █", "███"},
+			4: {"█ █", "█ █", "███", "  █", "  █"},
+			5: {"███", "█  ", "███", "  █", "███"},
+			6: {"███", "█  ", "███", "█ █", "███"},
+			7: {"███", "  █", "  █", "  █", "  █"},
+			8: {"███", "█ █", "███", "█ █", "███"},
+			9: {"███", "█ █", "███", "  █", "███"},
+		},
+		colon: []string{" ", "█", " ", "█", " "},
+	},
+	models.ClockSmall: {
+		digits: map[int][]string{
+			0: {"█▀█", "█ █", "█▄█"},
+			1: {"▀█ ", " █ ", "▄█▄"},
+			2: {"▀▀█", "█▀▀", "█▄▄"},
+			3: {"▀▀█", " ▀█", "▄▄█"},
+			4: {"█ █", "▀▀█", "  █"},
+			5: {"█▀▀", "▀▀█", "▄▄█"},
+			6: {"█▀▀", "█▀█", "█▄█"},
+			7: {"▀▀█", "  █", "  █"},
+			8: {"█▀█", "█▀█", "█▄█"},
+			9: {"█▀█", "▀▀█", "▄▄█"},
+		},
+		colon: []string{"▄", " ", "▀"},
+	},
+	models.ClockSlim: {
+		digits: map[int][]string{
+			0: {" _ ", "| |", "|_|"},
+			1: {"   ", "  |", "  |"},
+			2: {" _ ", " _|", "|_ "},
+			3: {" _ ", " _|", " _|"},
+			4: {"   ", "|_|", "  |"},
+			5: {" _ ", "|_ ", " _|"},
+			6: {" _ ", "|_ ", "|_|"},
+			7: {" _ ", "  |", "  |"},
+			8: {" _ ", "|_|", "|_|"},
+			9: {" _ ", "|_|", " _|"},
+		},
+		colon: []string{" ", ".", "."},
+	},
+}
+
+// smallerClocks is what each rendering falls back to when it doesn't fit,
+// ending with plain text.
+var smallerClocks = map[string]string{
+	models.ClockBlock:  models.ClockSmall,
+	models.ClockSmall:  models.ClockText,
+	models.ClockSlim:   models.ClockText,
+	models.ClockAnalog: models.ClockText,
+}
+
+// maxClockRadius bounds the analog clock face on large terminals.
+const maxClockRadius = 10
+
+// renderClock draws the countdown in the configured rendering within rows
+// by cols cells, falling back to smaller ones until it fits.
+func (m Model) renderClock(minutes, seconds, rows, cols int) string {
+	text := fmt.Sprintf("%02d:%02d", minutes, seconds)
+	for style := m.config.Font(); style != models.ClockText; style = smallerClocks[style] {
+		var clock string
+		if style == models.ClockAnalog {
+			clock = renderAnalogClock(minutes, seconds, min(min((rows-2)/2, (cols-1)/4), maxClockRadius))
+		} else if font, ok := clockFonts[style]; ok {
+			clock = font.render(text)
+		}
+		if clock != "" && lipgloss.Height(clock) <= rows && lipgloss.Width(clock) <= cols {
+			return clock
+		}
+	}
+	return text
+}
+
+// render draws text, made of digits and a colon, in the font.
+func (f clockFont) render(text string) string {
+	lines := make([]string, len(f.colon))
+	for row := range lines {
+		glyphs := make([]string, 0, len(text))
+		for _, r := range text {
+			if r == ':' {
+				glyphs = append(glyphs, f.colon[row])
+			} else {
+				glyphs = append(glyphs, f.digits[int(r-'0')][row])
+			}
+		}
+		lines[row] = strings.Join(glyphs, " ")
+	}
+	return lipgloss.JoinVertical(lipgloss.Center, lines...)
+}
+
+// renderAnalogClock draws a clock face radius rows above and below its
+// center, twice as wide since cells are about twice as tall as wide. The
+// hand points at the minutes left on a 60-minute dial, a * on the rim
+// marks the seconds, and the time is spelled out under the face. It is
+// empty when radius is too small to draw.
+func renderAnalogClock(minutes, seconds, radius int) string {
+	if radius < 2 {
+		return ""
+	}
+	size := 2*radius + 1
+	grid := make([][]rune, size)
+	for y := range grid {
+		grid[y] = []rune(strings.Repeat(" ", 2*size-1))
+	}
+	// at returns the cell distance rows out from the center along the
+	// direction of fraction of a turn, clockwise from 12
+	at := func(fraction, distance float64) (x, y int) {
+		angle := 2 * math.Pi * fraction
+		return 2*radius + int(math.Round(2*distance*math.Sin(angle))), radius - int(math.Round(distance*math.Cos(angle)))
+	}
+
+	for hour := range 12 {
+		tick := '.'
+		if hour%3 == 0 {
+			tick = '+'
+		}
+		x, y := at(float64(hour)/12, float64(radius))
+		grid[y][x] = tick
+	}
+
+	hand := float64(minutes%60) / 60
+	stroke := handStroke(hand)
+	for distance := 0.5; distance <= float64(radius)-1; distance += 0.25 {
+		x, y := at(hand, distance)
+		grid[y][x] = stroke
+	}
+	x, y := at(float64(seconds)/60, float64(radius))
+	grid[y][x] = '*'
+	grid[radius][2*radius] = 'o'
+
+	lines := make([]string, 0, size+1)
+	for _, row := range grid {
+		lines = append(lines, string(row))
+	}
+	lines = append(lines, fmt.Sprintf("%02d:%02d", minutes, seconds))
+	return lipgloss.JoinVertical(lipgloss.Center, lines...)
+}
+
+// handStroke returns the character drawing a hand pointing at fraction of
+// a turn, by the slope it has on screen.
+func handStroke(fraction float64) rune {
+	angle := 2 * math.Pi * fraction
+	slope := math.Mod(math.Atan2(math.Cos(angle), 2*math.Sin(angle))+math.Pi, math.Pi)
+	switch {
+	case slope < math.Pi/8 || slope >= 7*math.Pi/8:
+		return '-'
+	case slope < 3*math.Pi/8:
+		return '/'
+	case slope < 5*math.Pi/8:
+		return '|'
+	}
+	return '\\'
+}
+
+// dialFaces fill up by quarters as the session progresses.
+var dialFaces = []string{"○", "◔", "◑", "◕", "●"}
+
+// renderProgress draws how far the timer is in the configured style, at
+// most width columns wide.
+func (m Model) renderProgress(percent float64, width int) string {
+	percent = math.Max(0, math.Min(percent, 1))
+	label := fmt.Sprintf("%3d%%", int(percent*100))
+
+	switch m.config.Progress() {
+	case models.ProgressThin:
+		return layout.Bar(percent, width-len(label)-1, "━", "─") + " " + label
+	case models.ProgressBlock:
+		return layout.Bar(percent, width-len(label)-1, "█", "░") + " " + label
+	case models.ProgressPercent:
+		return strings.TrimSpace(label)
+	case models.ProgressDial:
+		return dialFaces[int(percent*float64(len(dialFaces)-1))] + " " + strings.TrimSpace(label)
+	}
+
+	m.timerProgress.Width = width
+	return m.timerProgress.ViewAs(percent)
+}
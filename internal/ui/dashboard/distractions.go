@@ -0,0 +1,46 @@
+package dashboard
+
+import "fmt"
+
+func pluralDistractions(n int) string {
+	if n == 1 {
+		return "1 distraction"
+	}
+	return fmt.Sprintf("%d distractions", n)
+}
+
+// distractionsText reports a distraction count, e.g. "Distractions: 3",
+// and is empty when there were none.
+func distractionsText(n int) string {
+	if n == 0 {
+		return ""
+	}
+	return fmt.Sprintf("Distractions: %d", n)
+}
+
+// avgDistractionsText reports the average distractions per completed
+// session, e.g. "Distractions: 1.5 per session".
+func avgDistractionsText(n, sessions int) string {
+	if n == 0 || sessions == 0 {
+		return ""
+	}
+	return fmt.Sprintf("Distractions: %.1f per session", float64(n)/float64(sessions))
+}
+
+// falseStartsText reports sessions too short to count toward stats, e.g.
+// "False starts: 2", and is empty when there were none.
+func falseStartsText(n int) string {
+	if n == 0 {
+		return ""
+	}
+	return fmt.Sprintf("False starts: %d", n)
+}
+
+// partialText reports stopped sessions credited toward the time totals,
+// e.g. "Partial: 50m from 1", and is empty when there were none.
+func partialText(sessions, minutes int) string {
+	if sessions == 0 {
+		return ""
+	}
+	return fmt.Sprintf("Partial: %dm from %d", minutes, sessions)
+}
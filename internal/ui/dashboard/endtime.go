@@ -0,0 +1,19 @@
+package dashboard
+
+import "time"
+
+// endTimeText gives the wall-clock time the running session or break
+// finishes, e.g. "ends at 3:42pm", so it can be planned around meetings.
+// It follows extensions and, while paused, tells when it would end if
+// resumed now.
+func (m Model) endTimeText() string {
+	if !m.timerRunning {
+		return ""
+	}
+	end := m.now().Add(time.Duration(m.timerDuration-m.timerElapsed) * time.Second)
+	text := "ends at " + end.Format("3:04pm")
+	if m.timerPaused {
+		text += " if resumed now"
+	}
+	return text
+}
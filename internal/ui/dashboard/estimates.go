@@ -0,0 +1,89 @@
+package dashboard
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/adibhanna/focussessions/internal/models"
+	"github.com/adibhanna/focussessions/internal/ui/theme"
+)
+
+func (m *Model) loadEstimates() {
+	m.refreshTasks()
+	m.estimates = models.NewEstimateReport(m.tasks)
+}
+
+func (m Model) renderEstimatesView() string {
+	containerStyle := lipgloss.NewStyle().
+		Width(m.width).
+		Height(m.height).
+		Padding(2)
+
+	titleStyle := lipgloss.NewStyle().
+		Bold(true).
+		Foreground(theme.Accent).
+		MarginBottom(1).
+		Align(lipgloss.Center)
+
+	summaryStyle := lipgloss.NewStyle().
+		Foreground(theme.Highlight).
+		MarginBottom(1)
+
+	rowStyle := lipgloss.NewStyle().
+		Foreground(theme.Muted).
+		PaddingLeft(2)
+
+	title := titleStyle.Render("🍅 Estimated vs Actual Pomodoros")
+
+	report := m.estimates
+	parts := []string{title}
+	if len(report.Tasks) == 0 {
+		parts = append(parts, rowStyle.Render("No finished tasks with an estimate yet. Estimate tasks with +/- in the task list (T)."))
+		parts = append(parts, m.renderHelp())
+		return containerStyle.Render(lipgloss.JoinVertical(lipgloss.Left, parts...))
+	}
+
+	parts = append(parts, summaryStyle.Render(fmt.Sprintf(
+		"Finished tasks: %d • estimated %d, took %d (%s)\nWithin estimate: %d of %d",
+		len(report.Tasks),
+		report.Estimated,
+		report.Actual,
+		driftText(report.Drift()),
+		report.Within,
+		len(report.Tasks),
+	)))
+
+	// Leave room for the title, summary and help around the list
+	rows := report.Tasks
+	if limit := max(m.height-14, 3); len(rows) > limit {
+		rows = rows[:limit]
+	}
+	for _, task := range rows {
+		line := fmt.Sprintf("%s: %d estimated, %d actual", task.Title, task.Estimate, task.Pomodoros)
+		if diff := task.Pomodoros - task.Estimate; diff != 0 {
+			line += fmt.Sprintf(" (%+d)", diff)
+		}
+		parts = append(parts, rowStyle.Render(line))
+	}
+	if hidden := len(report.Tasks) - len(rows); hidden > 0 {
+		parts = append(parts, rowStyle.Render(fmt.Sprintf("… %d older tasks", hidden)))
+	}
+	parts = append(parts, m.renderHelp())
+
+	return containerStyle.Render(lipgloss.JoinVertical(lipgloss.Left, parts...))
+}
+
+// driftText describes how far the actual pomodoros were off the estimates,
+// e.g. "25% over" or "on estimate".
+func driftText(drift float64) string {
+	percent := int(math.Round(math.Abs(drift) * 100))
+	switch {
+	case percent == 0:
+		return "on estimate"
+	case drift > 0:
+		return fmt.Sprintf("%d%% over", percent)
+	}
+	return fmt.Sprintf("%d%% under", percent)
+}
@@ -0,0 +1,25 @@
+package dashboard
+
+import (
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/adibhanna/focussessions/internal/ui/exportwizard"
+)
+
+// updateExportWizard forwards msg to the export wizard and, once it
+// finishes, closes it and shows the result below the current view.
+func (m Model) updateExportWizard(msg tea.Msg) (tea.Model, tea.Cmd) {
+	wizard, cmd := m.exportWizard.Update(msg)
+	m.exportWizard = wizard.(exportwizard.Model)
+	if !m.exportWizard.Done() {
+		return m, cmd
+	}
+
+	m.exporting = false
+	if m.exportWizard.Cancelled() {
+		return m, nil
+	}
+	m.exportMessage = m.exportWizard.Result()
+	m.showExportMsg = true
+	return m, m.clearExportMsgAfterDelay()
+}
@@ -0,0 +1,39 @@
+package dashboard
+
+import (
+	"fmt"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// extendStep is how many minutes + and - add to or take off a session.
+const extendStep = 5
+
+// extend adds minutes to the running session, or takes them off when
+// negative, and stores the new length. A session can't be shortened to end
+// before now, and a strict one can't be shortened at all.
+func (m Model) extend(minutes int) (tea.Model, tea.Cmd) {
+	if m.activeSession == nil {
+		return m, nil
+	}
+	m.syncElapsed()
+
+	duration := m.activeSession.Duration + minutes
+	switch {
+	case minutes < 0 && m.activeSession.Strict:
+		m.toast = "🔒 Strict session: it can't be shortened"
+		return m, clearToastAfter()
+	case duration*60 <= m.timerElapsed:
+		m.toast = fmt.Sprintf("Less than %d minutes left to take off", -minutes)
+		return m, clearToastAfter()
+	}
+
+	m.activeSession.Duration = duration
+	m.activeSession.ElapsedSeconds = m.timerElapsed
+	m.timerDuration = duration * 60
+	m.heartbeat()
+	m.storage.SaveSession(*m.activeSession)
+
+	m.toast = fmt.Sprintf("Session is now %d minutes", duration)
+	return m, clearToastAfter()
+}
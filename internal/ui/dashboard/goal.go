@@ -0,0 +1,64 @@
+package dashboard
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/adibhanna/focussessions/internal/ui/theme"
+)
+
+// goalProjection returns how many sessions remain to reach today's goal and
+// when the last of them would end if they ran back to back from now with a
+// break between each. A running session counts toward the remaining ones
+// with the time it has left; a paused one is assumed to resume now.
+func (m Model) goalProjection() (remaining int, finish time.Time) {
+	remaining = m.config.DailySessionGoal - m.todayStats.SessionsCount
+	if remaining <= 0 {
+		return 0, time.Time{}
+	}
+
+	session := time.Duration(m.config.SessionDuration) * time.Minute
+	rest := time.Duration(m.config.BreakDuration) * time.Minute
+	left := time.Duration(m.timerDuration-m.timerElapsed) * time.Second
+
+	finish = m.now()
+	todo := remaining
+	switch {
+	case m.timerRunning && m.onBreak:
+		finish = finish.Add(left)
+	case m.timerRunning:
+		finish = finish.Add(left)
+		todo--
+		if todo > 0 {
+			finish = finish.Add(rest)
+		}
+	}
+	if todo > 0 {
+		finish = finish.Add(time.Duration(todo)*session + time.Duration(todo-1)*rest)
+	}
+	return remaining, finish
+}
+
+// renderGoalCountdown shows the sessions left to today's goal and the
+// projected finish time, e.g. "3 sessions to go • done around 5:40pm".
+func (m Model) renderGoalCountdown() string {
+	remaining, finish := m.goalProjection()
+	if remaining == 0 {
+		return ""
+	}
+
+	text := fmt.Sprintf("%d sessions to go • done around %s", remaining, finish.Format("3:04pm"))
+	if remaining == 1 {
+		text = fmt.Sprintf("1 session to go • done around %s", finish.Format("3:04pm"))
+	}
+	if now := m.now(); finish.YearDay() != now.YearDay() || finish.Year() != now.Year() {
+		text = fmt.Sprintf("%d to go • won't fit before midnight", remaining)
+	}
+
+	return lipgloss.NewStyle().
+		Foreground(theme.Muted).
+		Align(lipgloss.Center).
+		Render(text)
+}
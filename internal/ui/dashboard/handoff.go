@@ -0,0 +1,92 @@
+package dashboard
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/adibhanna/focussessions/internal/storage"
+	"github.com/adibhanna/focussessions/internal/ui/theme"
+)
+
+func hostname() string {
+	host, err := os.Hostname()
+	if err != nil {
+		return ""
+	}
+	return host
+}
+
+// heartbeat stamps the running session with this machine and the current
+// time, so other machines sharing the data directory see where it runs.
+func (m *Model) heartbeat() {
+	m.activeSession.Host = m.host
+	m.activeSession.HeartbeatAt = m.now()
+}
+
+// checkHandoff holds the local timer when another machine is running the
+// active session, and reports whether it did.
+func (m *Model) checkHandoff() bool {
+	if m.activeSession == nil {
+		return false
+	}
+	var conflict *storage.ConflictError
+	if !errors.As(m.storage.CheckHandoff(m.activeSession.ID, m.host, m.now()), &conflict) {
+		return false
+	}
+	m.syncElapsed()
+	m.timerPaused = true
+	m.conflict = conflict
+	return true
+}
+
+// updateHandoff resolves a conflict: enter takes the session over on this
+// machine, esc leaves it running on the other one. Other keys are not
+// handled.
+func (m Model) updateHandoff(msg tea.KeyMsg) (tea.Model, tea.Cmd, bool) {
+	switch msg.String() {
+	case "enter":
+		m.conflict = nil
+		if stored, err := m.storage.GetActiveSession(); err == nil && stored != nil && stored.ID == m.activeSession.ID {
+			*m.activeSession = *stored
+			m.timerDuration = stored.Duration * 60
+			m.timerElapsed = min(stored.ElapsedAt(m.now()), m.timerDuration)
+		}
+		m.timerPaused = false
+		m.activeSession.Unpause(m.now())
+		m.activeSession.ElapsedSeconds = m.timerElapsed
+		m.heartbeat()
+		m.storage.SaveSession(*m.activeSession)
+		m.startRun()
+		return m, tickCmd(), true
+
+	case "esc":
+		m.conflict = nil
+		m.activeSession = nil
+		m.timerRunning = false
+		m.timerPaused = false
+		m.timerElapsed = 0
+		return m, nil, true
+	}
+	return m, nil, false
+}
+
+func (m Model) renderHandoff() string {
+	warnStyle := lipgloss.NewStyle().
+		Foreground(theme.Danger)
+
+	helpStyle := lipgloss.NewStyle().
+		Foreground(theme.Faint).
+		MarginTop(1)
+
+	seen := m.now().Sub(m.conflict.HeartbeatAt).Round(time.Second)
+	return lipgloss.NewStyle().MarginTop(2).Render(lipgloss.JoinVertical(
+		lipgloss.Left,
+		warnStyle.Render(fmt.Sprintf("⚠️  This session is running on %s (seen %s ago)", m.conflict.Host, seen)),
+		helpStyle.Render("enter: take over here • esc: leave it there"),
+	))
+}
@@ -0,0 +1,35 @@
+package dashboard
+
+import (
+	"testing"
+	"time"
+)
+
+func TestTakeOver(t *testing.T) {
+	t.Parallel()
+	m := newTestModel(t)
+	m = press(t, m, "s")
+	m = advance(m, 10*time.Minute)
+
+	// Another machine extended the session and ran it past the local length
+	stored := *m.activeSession
+	stored.Host = "laptop"
+	stored.HeartbeatAt = m.now()
+	stored.Duration = m.timerDuration/60 + 30
+	stored.ElapsedSeconds = m.timerDuration + 5*60
+	stored.StartTime = m.now().Add(-time.Duration(stored.ElapsedSeconds) * time.Second)
+	if err := m.storage.SaveSession(stored); err != nil {
+		t.Fatal(err)
+	}
+	if !m.checkHandoff() {
+		t.Fatal("no conflict with a session running on another machine")
+	}
+
+	m = press(t, m, "enter")
+	if m.timerDuration != stored.Duration*60 {
+		t.Errorf("timer duration %ds, want %ds", m.timerDuration, stored.Duration*60)
+	}
+	if m.timerElapsed < stored.ElapsedSeconds {
+		t.Errorf("timer elapsed %ds, want at least %ds", m.timerElapsed, stored.ElapsedSeconds)
+	}
+}
@@ -0,0 +1,34 @@
+package dashboard
+
+import (
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/adibhanna/focussessions/internal/hooks"
+	"github.com/adibhanna/focussessions/internal/models"
+)
+
+// runHook runs the hook script and configured command for event about
+// session, if there are any, and sends the event to the plugins and
+// scripts.
+func (m Model) runHook(event string, session models.Session) tea.Cmd {
+	return m.startHook(hooks.Payload{Event: event, Session: &session})
+}
+
+func (m Model) startHook(payload hooks.Payload) tea.Cmd {
+	payload.Remaining = max(m.config.DailySessionGoal-m.todayStats.SessionsCount, 0)
+
+	var command string
+	switch payload.Event {
+	case hooks.OnStart:
+		command = m.config.OnSessionStartCmd
+	case hooks.OnComplete, hooks.OnCancel:
+		command = m.config.OnSessionEndCmd
+	}
+
+	dir := m.storage.HooksDir()
+	return tea.Batch(func() tea.Msg {
+		hooks.Run(dir, payload)
+		hooks.RunCommand(command, payload)
+		return nil
+	}, m.runPlugins(payload), m.runScripts(payload))
+}
@@ -0,0 +1,115 @@
+package dashboard
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/adibhanna/focussessions/internal/models"
+	"github.com/adibhanna/focussessions/internal/ui/chart"
+	"github.com/adibhanna/focussessions/internal/ui/layout"
+	"github.com/adibhanna/focussessions/internal/ui/theme"
+)
+
+// hourRanges are the date ranges, in days up to today, the time-of-day view
+// cycles through.
+var hourRanges = []int{7, 30, 90, 365}
+
+// defaultHourRange indexes the range the time-of-day view opens with.
+const defaultHourRange = 1
+
+func (m *Model) loadHours() {
+	now := m.now()
+	to := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location()).AddDate(0, 0, 1)
+	hours, err := m.storage.GetHourlyMinutes(to.AddDate(0, 0, -hourRanges[m.hourRange]), to)
+	if err != nil {
+		hours = [24]int{}
+	}
+	m.hours = hours
+}
+
+// cycleHourRange moves to the previous (step -1) or next (step 1) range.
+func (m *Model) cycleHourRange(step int) {
+	m.hourRange = (m.hourRange + step + len(hourRanges)) % len(hourRanges)
+	m.loadHours()
+}
+
+func (m Model) renderHoursView() string {
+	containerStyle := lipgloss.NewStyle().
+		Width(m.width).
+		Height(m.height).
+		Padding(2)
+
+	titleStyle := lipgloss.NewStyle().
+		Bold(true).
+		Foreground(theme.Accent).
+		MarginBottom(1).
+		Align(lipgloss.Center)
+
+	captionStyle := lipgloss.NewStyle().
+		Foreground(theme.Highlight).
+		MarginBottom(1)
+
+	chartStyle := lipgloss.NewStyle().
+		Foreground(theme.Primary).
+		PaddingLeft(2)
+
+	axisStyle := lipgloss.NewStyle().
+		Foreground(theme.Faint).
+		PaddingLeft(2)
+
+	title := titleStyle.Render(fmt.Sprintf("🕘 Focus by Hour - Last %d days", hourRanges[m.hourRange]))
+
+	peak, total := 0, 0
+	for h, minutes := range m.hours {
+		total += minutes
+		if minutes > m.hours[peak] {
+			peak = h
+		}
+	}
+	if total == 0 {
+		empty := captionStyle.Render("No completed sessions in this range yet.")
+		return containerStyle.Render(lipgloss.JoinVertical(lipgloss.Left, title, empty, m.renderHelp()))
+	}
+
+	caption := captionStyle.Render(fmt.Sprintf("Focus minutes by hour of day • peak %s (%s)",
+		hourLabel(peak), models.FormatMinutes(m.hours[peak])))
+
+	// Widen each hour to several columns when there is room
+	step := max(1, min(3, (layout.Inner(m.width, 2)-2)/24))
+	var values []float64
+	for _, minutes := range m.hours {
+		for range step {
+			values = append(values, float64(minutes))
+		}
+	}
+	height := 8
+	if layout.Compact(m.height) {
+		height = 3
+	}
+	rows := chart.Bars(values, len(values), height)
+
+	// Label every third hour, or every sixth when hours are one column wide
+	every := 3
+	if step == 1 {
+		every = 6
+	}
+	var axis strings.Builder
+	for h := 0; h < 24; h += every {
+		axis.WriteString(fmt.Sprintf("%-*d", every*step, h))
+	}
+
+	parts := []string{title, caption}
+	for _, row := range rows {
+		parts = append(parts, chartStyle.Render(row))
+	}
+	parts = append(parts, axisStyle.Render(strings.TrimRight(axis.String(), " ")), m.renderHelp())
+
+	return containerStyle.Render(lipgloss.JoinVertical(lipgloss.Left, parts...))
+}
+
+func hourLabel(h int) string {
+	return time.Date(2000, 1, 1, h, 0, 0, 0, time.Local).Format("3pm")
+}
@@ -0,0 +1,75 @@
+package dashboard
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/adibhanna/focussessions/internal/insights"
+	"github.com/adibhanna/focussessions/internal/ui/theme"
+)
+
+// insightsWindowDays is how far back the insights view looks.
+const insightsWindowDays = 30
+
+func (m *Model) loadInsights() {
+	now := m.now()
+	to := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location()).AddDate(0, 0, 1)
+	from := to.AddDate(0, 0, -insightsWindowDays)
+
+	sessions, err := m.storage.GetSessionsInRange(from, to)
+	if err != nil {
+		m.insightSections = nil
+		return
+	}
+
+	offDays, err := m.storage.GetOffDays()
+	if err != nil {
+		offDays = nil
+	}
+
+	m.insightDays = insightsWindowDays
+	m.insightSections = insights.Run(insights.Range{From: from, To: to, Sessions: sessions, OffDays: offDays})
+}
+
+func (m Model) renderInsightsView() string {
+	containerStyle := lipgloss.NewStyle().
+		Width(m.width).
+		Height(m.height).
+		Padding(2)
+
+	titleStyle := lipgloss.NewStyle().
+		Bold(true).
+		Foreground(theme.Accent).
+		MarginBottom(2).
+		Align(lipgloss.Center)
+
+	sectionStyle := lipgloss.NewStyle().
+		Bold(true).
+		Foreground(theme.Highlight).
+		MarginTop(1)
+
+	findingStyle := lipgloss.NewStyle().
+		Foreground(theme.Muted).
+		PaddingLeft(2)
+
+	labelStyle := lipgloss.NewStyle().
+		Foreground(theme.Success)
+
+	title := titleStyle.Render(fmt.Sprintf("💡 Insights - Last %d days", m.insightDays))
+
+	parts := []string{title}
+	if len(m.insightSections) == 0 {
+		parts = append(parts, findingStyle.Render("Not enough sessions yet. Complete a few and check back! 🌱"))
+	}
+	for _, section := range m.insightSections {
+		parts = append(parts, sectionStyle.Render(section.Name))
+		for _, finding := range section.Findings {
+			parts = append(parts, findingStyle.Render(labelStyle.Render(finding.Title+": ")+finding.Detail))
+		}
+	}
+	parts = append(parts, m.renderHelp())
+
+	return containerStyle.Render(lipgloss.JoinVertical(lipgloss.Left, parts...))
+}
@@ -0,0 +1,58 @@
+package dashboard
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/adibhanna/focussessions/internal/models"
+)
+
+// cycleIntensity steps the running session's intensity, or the intensity
+// the next session starts at when none is running.
+func (m *Model) cycleIntensity() {
+	if m.timerRunning && m.activeSession != nil {
+		m.activeSession.Intensity = models.NextIntensity(m.activeSession.IntensityLevel())
+		m.syncElapsed()
+		m.activeSession.ElapsedSeconds = m.timerElapsed
+		m.storage.SaveSession(*m.activeSession)
+		return
+	}
+	m.nextIntensity = models.NextIntensity(m.nextIntensity)
+}
+
+// intensityBadge names a non-normal intensity, e.g. "🔥 deep", and is
+// empty for normal sessions.
+func intensityBadge(intensity string) string {
+	switch intensity {
+	case models.IntensityDeep:
+		return "🔥 deep"
+	case models.IntensityLight:
+		return "🍃 light"
+	}
+	return ""
+}
+
+// weightedText reports the intensity-weighted focus time, e.g.
+// "Weighted: 3h 30m", and is empty when it matches the actual time.
+func weightedText(weighted, total int) string {
+	if weighted == total {
+		return ""
+	}
+	return "Weighted: " + models.FormatMinutes(weighted)
+}
+
+// intensityText breaks focus time down by intensity, e.g.
+// "light 30m • normal 2h • deep 1h", and is empty when every session was
+// normal.
+func intensityText(minutes map[string]int) string {
+	if len(minutes) == 0 || (len(minutes) == 1 && minutes[models.IntensityNormal] > 0) {
+		return ""
+	}
+	var parts []string
+	for _, level := range models.Intensities {
+		if m, ok := minutes[level]; ok {
+			parts = append(parts, fmt.Sprintf("%s %s", level, models.FormatMinutes(m)))
+		}
+	}
+	return strings.Join(parts, " • ")
+}
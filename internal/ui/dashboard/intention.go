@@ -0,0 +1,63 @@
+package dashboard
+
+import (
+	"strings"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/adibhanna/focussessions/internal/models"
+	"github.com/adibhanna/focussessions/internal/ui/theme"
+)
+
+func newIntentionInput() textinput.Model {
+	input := textinput.New()
+	input.Placeholder = "ship the storage refactor"
+	input.CharLimit = 80
+	input.Width = 40
+	return input
+}
+
+// promptIntention asks for the session's intention before starting it. The
+// session starts on enter, with or without one.
+func (m Model) promptIntention() (tea.Model, tea.Cmd) {
+	m.promptingIntention = true
+	m.intentionInput.SetValue("")
+	return m, m.intentionInput.Focus()
+}
+
+func (m Model) updateIntentionPrompt(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "esc":
+		m.promptingIntention = false
+		m.intentionInput.Blur()
+		return m, nil
+
+	case "enter":
+		m.promptingIntention = false
+		m.intentionInput.Blur()
+		intention := strings.TrimSpace(m.intentionInput.Value())
+		return m.startNewSession(&models.Session{Intention: intention})
+	}
+
+	var cmd tea.Cmd
+	m.intentionInput, cmd = m.intentionInput.Update(msg)
+	return m, cmd
+}
+
+func (m Model) renderIntentionPrompt() string {
+	questionStyle := lipgloss.NewStyle().
+		Foreground(theme.Highlight)
+
+	helpStyle := lipgloss.NewStyle().
+		Foreground(theme.Faint).
+		MarginTop(1)
+
+	return lipgloss.NewStyle().MarginTop(2).Render(lipgloss.JoinVertical(
+		lipgloss.Left,
+		questionStyle.Render("What do you intend to get done?"),
+		m.intentionInput.View(),
+		helpStyle.Render("enter: start • esc: cancel"),
+	))
+}
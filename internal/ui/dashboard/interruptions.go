@@ -0,0 +1,102 @@
+package dashboard
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/adibhanna/focussessions/internal/models"
+	"github.com/adibhanna/focussessions/internal/ui/theme"
+)
+
+func newInterruptionInput() textinput.Model {
+	input := textinput.New()
+	input.Placeholder = "slack ping"
+	input.CharLimit = 40
+	input.Width = 30
+	return input
+}
+
+// openInterruptionLog asks why the running session was interrupted. The
+// timer keeps running while the prompt is open, and the interruption is
+// timestamped when the prompt opens.
+func (m Model) openInterruptionLog() (tea.Model, tea.Cmd) {
+	m.loggingInterruption = true
+	m.interruptedAt = m.now()
+	m.interruptionInput.SetValue("")
+	return m, m.interruptionInput.Focus()
+}
+
+func (m Model) updateInterruptionLog(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "esc":
+		m.loggingInterruption = false
+		m.interruptionInput.Blur()
+		return m, nil
+
+	case "enter":
+		m.loggingInterruption = false
+		m.interruptionInput.Blur()
+		if m.activeSession != nil {
+			m.activeSession.Interruptions = append(m.activeSession.Interruptions, models.Interruption{
+				At:     m.interruptedAt,
+				Reason: strings.TrimSpace(m.interruptionInput.Value()),
+			})
+			m.syncElapsed()
+			m.activeSession.ElapsedSeconds = m.timerElapsed
+			m.storage.SaveSession(*m.activeSession)
+		}
+		return m, nil
+	}
+
+	var cmd tea.Cmd
+	m.interruptionInput, cmd = m.interruptionInput.Update(msg)
+	return m, cmd
+}
+
+func (m Model) renderInterruptionLog() string {
+	questionStyle := lipgloss.NewStyle().
+		Foreground(theme.Highlight)
+
+	helpStyle := lipgloss.NewStyle().
+		Foreground(theme.Faint).
+		MarginTop(1)
+
+	return lipgloss.NewStyle().MarginTop(2).Render(lipgloss.JoinVertical(
+		lipgloss.Left,
+		questionStyle.Render(fmt.Sprintf("Interrupted at %s. Why?", m.interruptedAt.Format("3:04 PM"))),
+		m.interruptionInput.View(),
+		helpStyle.Render("enter: log • esc: cancel"),
+	))
+}
+
+// renderInterruptions is the daily details breakdown of interruptions by
+// reason, e.g. "slack ×2 (9:12 AM, 2:05 PM)". It is empty when nothing
+// interrupted the day's sessions.
+func renderInterruptions(sessions []models.Session) string {
+	counts := models.InterruptionBreakdown(sessions)
+	if len(counts) == 0 {
+		return ""
+	}
+
+	rowStyle := lipgloss.NewStyle().
+		Foreground(theme.Muted).
+		PaddingLeft(2)
+
+	out := "\nInterruptions:\n"
+	for _, c := range counts {
+		reason := c.Reason
+		if reason == "" {
+			reason = "no reason"
+		}
+		times := make([]string, len(c.Times))
+		for i, t := range c.Times {
+			times[i] = t.Format("3:04 PM")
+		}
+		out += rowStyle.Render(fmt.Sprintf("%s ×%d (%s)", reason, len(c.Times), strings.Join(times, ", "))) + "\n"
+	}
+	return out
+}
@@ -0,0 +1,71 @@
+package dashboard
+
+import (
+	"strings"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/adibhanna/focussessions/internal/ui/theme"
+)
+
+// refreshJournal loads the journal note of the day shown in the daily
+// details.
+func (m *Model) refreshJournal() {
+	if note, err := m.storage.GetJournal(m.todayStats.Date); err == nil {
+		m.journal = note
+	}
+}
+
+func (m Model) openJournal() (tea.Model, tea.Cmd) {
+	m.editingJournal = true
+	m.journalInput.SetValue(m.journal)
+	m.journalInput.CursorEnd()
+	return m, m.journalInput.Focus()
+}
+
+// updateJournal edits the day's journal note: enter saves it, esc leaves it
+// unchanged.
+func (m Model) updateJournal(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "enter":
+		note := strings.TrimSpace(m.journalInput.Value())
+		if err := m.storage.SaveJournal(m.todayStats.Date, note); err == nil {
+			m.journal = note
+		}
+		fallthrough
+	case "esc":
+		m.editingJournal = false
+		m.journalInput.Blur()
+		return m, nil
+	}
+
+	var cmd tea.Cmd
+	m.journalInput, cmd = m.journalInput.Update(msg)
+	return m, cmd
+}
+
+// renderJournal shows the day's journal note in the daily details, or the
+// note being edited.
+func (m Model) renderJournal() string {
+	if m.editingJournal {
+		return "\n" + m.journalInput.View()
+	}
+	if m.journal == "" {
+		return ""
+	}
+	return lipgloss.NewStyle().
+		Foreground(theme.Info).
+		Width(max(m.width-4, 20)).
+		Render("\n📝 " + m.journal)
+}
+
+func newJournalInput() textinput.Model {
+	input := textinput.New()
+	input.Placeholder = "what today's focus time produced"
+	input.Prompt = "Journal: "
+	input.CharLimit = 500
+	input.Width = 50
+	return input
+}
@@ -0,0 +1,260 @@
+package dashboard
+
+import (
+	"github.com/charmbracelet/bubbles/help"
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/adibhanna/focussessions/internal/ui/layout"
+	"github.com/adibhanna/focussessions/internal/ui/theme"
+)
+
+// viewKeys are the bindings available in the current view: for the help
+// bar the view's own keys, most useful first, and the tail that always
+// ends it, and for the help overlay every binding in groups.
+type viewKeys struct {
+	short []key.Binding
+	tail  []key.Binding
+	full  [][]key.Binding
+}
+
+func (k viewKeys) ShortHelp() []key.Binding {
+	return append(k.short[:len(k.short):len(k.short)], k.tail...)
+}
+
+func (k viewKeys) FullHelp() [][]key.Binding {
+	return k.full
+}
+
+// newHelpBar returns the help bar in the dashboard's colors.
+func newHelpBar() help.Model {
+	bar := help.New()
+	muted := lipgloss.NewStyle().Foreground(theme.Faint)
+	bar.Styles = help.Styles{
+		Ellipsis:       muted,
+		ShortKey:       lipgloss.NewStyle().Foreground(theme.Muted),
+		ShortDesc:      muted,
+		ShortSeparator: muted,
+		FullKey:        lipgloss.NewStyle().Foreground(theme.Success).Bold(true),
+		FullDesc:       lipgloss.NewStyle().Foreground(theme.Body),
+		FullSeparator:  muted,
+	}
+	return bar
+}
+
+// withHelp returns binding described as desc, for keys whose meaning
+// depends on the view.
+func withHelp(binding key.Binding, desc string) key.Binding {
+	binding.SetHelp(binding.Help().Key, desc)
+	return binding
+}
+
+// stepKeys describes ←/→ as moving through what the view shows, e.g.
+// "day" or "range".
+func stepKeys(desc string) key.Binding {
+	return key.NewBinding(
+		key.WithKeys("left", "right"),
+		key.WithHelp("←/→", desc),
+	)
+}
+
+// detailKeys sums up the four detail views in one entry for the help bar.
+var detailKeys = key.NewBinding(
+	key.WithKeys("d", "w", "m", "y"),
+	key.WithHelp("d/w/m/y", "details"),
+)
+
+// viewKeys returns the bindings that do something in the current view.
+func (m Model) viewKeys() viewKeys {
+	back := []key.Binding{keys.Back, keys.Help, keys.Quit}
+	nav := []key.Binding{keys.Back, keys.Home, keys.Help, keys.Quit}
+
+	switch m.viewState {
+	case StatsView:
+		return viewKeys{
+			short: []key.Binding{
+				detailKeys, keys.DateRange, keys.StatsFilter, keys.Insights, keys.Hours,
+				keys.Estimates, keys.Achievements, keys.Review, keys.YearReview, keys.Export,
+			},
+			tail: back,
+			full: [][]key.Binding{
+				{keys.Daily, keys.Weekly, keys.Monthly, keys.Yearly, keys.DateRange, keys.StatsFilter},
+				{keys.Insights, keys.Hours, keys.Estimates, keys.Achievements, keys.Review, keys.YearReview},
+				{keys.Export, keys.Back, keys.Home, keys.Settings, keys.Palette, keys.Help, keys.Quit},
+			},
+		}
+
+	case InsightsView, AchievementsView, EstimatesView:
+		return viewKeys{short: []key.Binding{keys.Home}, tail: back, full: [][]key.Binding{nav}}
+
+	case HoursView:
+		step := stepKeys("range")
+		return viewKeys{
+			short: []key.Binding{step, keys.Home},
+			tail:  back,
+			full:  [][]key.Binding{{step}, nav},
+		}
+
+	case YearReviewView:
+		step := stepKeys("year")
+		return viewKeys{
+			short: []key.Binding{step, keys.Export, keys.Home},
+			tail:  back,
+			full:  [][]key.Binding{{step, keys.Export}, nav},
+		}
+
+	case RangeView:
+		change := withHelp(keys.DateRange, "change range")
+		return viewKeys{
+			short: []key.Binding{change, keys.Home},
+			tail:  back,
+			full:  [][]key.Binding{{change}, nav},
+		}
+
+	case StatsDetailDaily:
+		step := stepKeys("day")
+		return viewKeys{
+			short: []key.Binding{step, keys.Filter, keys.Journal, keys.DayOff, keys.Export, keys.Home},
+			tail:  back,
+			full: [][]key.Binding{
+				{step, keys.Filter, keys.Journal, keys.DayOff, keys.Export},
+				nav,
+			},
+		}
+
+	case StatsDetailWeekly, StatsDetailMonthly, StatsDetailYearly:
+		period := map[ViewState]string{StatsDetailWeekly: "week", StatsDetailMonthly: "month", StatsDetailYearly: "year"}[m.viewState]
+		step := stepKeys(period)
+		return viewKeys{
+			short: []key.Binding{step, keys.Export, keys.Home},
+			tail:  back,
+			full:  [][]key.Binding{{step, keys.Export}, nav},
+		}
+
+	case PlannerView, TasksView, ReviewView:
+		// These views list their own keys under the content
+		return viewKeys{full: [][]key.Binding{nav}}
+	}
+
+	if m.zen {
+		leave := withHelp(keys.Back, "leave zen")
+		return viewKeys{
+			tail: []key.Binding{leave, keys.Quit},
+			full: [][]key.Binding{{leave, keys.Zen}, {keys.Help, keys.Quit}},
+		}
+	}
+	if m.timerRunning && m.onBreak {
+		end := withHelp(keys.Cancel, "end break")
+		return viewKeys{
+			short: []key.Binding{end, keys.Zen, keys.Stats},
+			tail:  []key.Binding{keys.Help, keys.Quit},
+			full:  [][]key.Binding{{end, keys.Zen, keys.Buddy}, {keys.Stats, keys.Settings, keys.Help, keys.Quit}},
+		}
+	}
+	if m.timerRunning {
+		short := []key.Binding{keys.Pause, keys.Resume, keys.Cancel}
+		session := []key.Binding{keys.Pause, keys.Resume, keys.Cancel, keys.Strict}
+		if m.strict() {
+			cancel := withHelp(keys.Cancel, "cancel (type "+strictWord+")")
+			short = []key.Binding{cancel}
+			session = []key.Binding{cancel}
+		}
+		return viewKeys{
+			short: append(short, keys.Distract, keys.Stats, keys.Level, keys.Label, keys.Zen),
+			tail:  []key.Binding{keys.Quit},
+			full: [][]key.Binding{
+				append(session, keys.Extend, keys.Shorten, keys.Undo),
+				{keys.Distract, keys.Interrupt, keys.Level, keys.Label},
+				{keys.Zen, keys.Buddy, keys.Stats, keys.Palette, keys.Help, keys.Quit},
+			},
+		}
+	}
+
+	start := []key.Binding{keys.Start}
+	if m.suggestion != nil {
+		start = append(start, keys.Continue)
+	}
+	return viewKeys{
+		short: append(start, keys.Break, keys.Stats, keys.Level, keys.Plan, keys.Tasks, keys.Zen, keys.Settings),
+		tail:  []key.Binding{keys.Help, keys.Quit},
+		full: [][]key.Binding{
+			append(start, keys.Break, keys.Level, keys.Strict, keys.Method, keys.Undo),
+			{keys.Plan, keys.Tasks, keys.Stats, keys.Zen, keys.Buddy, keys.Profile},
+			{keys.Settings, keys.Palette, keys.Help, keys.Quit},
+		},
+	}
+}
+
+// shortHelp renders the current view's help bar within width, leaving off
+// the view's least useful keys first so the tail stays in view.
+func (m Model) shortHelp(width int) string {
+	k := m.viewKeys()
+	bar := m.helpBar
+	for n := len(k.short); n > 0; n-- {
+		if text := bar.ShortHelpView(append(k.short[:n:n], k.tail...)); lipgloss.Width(text) <= width {
+			return text
+		}
+	}
+	bar.Width = width
+	return bar.ShortHelpView(k.tail)
+}
+
+// updateKeyHelp closes the help overlay on ? or esc and quits on q; other
+// keys are ignored while it is open.
+func (m Model) updateKeyHelp(msg tea.KeyMsg) (tea.Model, tea.Cmd, bool) {
+	switch {
+	case key.Matches(msg, keys.Help), key.Matches(msg, keys.Back):
+		m.helpBar.ShowAll = false
+		return m, nil, true
+	case key.Matches(msg, keys.Quit):
+		return m, nil, false
+	}
+	return m, nil, true
+}
+
+// renderKeyHelp draws every binding of the current view in a box over the
+// middle of the screen. The groups sit side by side when they fit, and one
+// under the other otherwise.
+func (m Model) renderKeyHelp() string {
+	boxStyle := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(theme.Primary).
+		Padding(1, 2)
+
+	titleStyle := lipgloss.NewStyle().
+		Bold(true).
+		Foreground(theme.Accent).
+		MarginBottom(1)
+
+	footerStyle := lipgloss.NewStyle().
+		Foreground(theme.Faint).
+		MarginTop(1)
+
+	// Short terminals drop the padding to fit more keys
+	if layout.Compact(m.height) {
+		boxStyle = boxStyle.Padding(0, 1)
+	}
+
+	// Leave room for the border and padding of the box
+	available := max(m.width-6, 10)
+
+	bar := m.helpBar
+	groups := m.viewKeys().FullHelp()
+	body := bar.FullHelpView(groups)
+	if lipgloss.Width(body) > available {
+		columns := make([]string, 0, len(groups))
+		for _, group := range groups {
+			columns = append(columns, bar.FullHelpView([][]key.Binding{group}))
+		}
+		body = lipgloss.JoinVertical(lipgloss.Left, columns...)
+	}
+
+	box := boxStyle.Render(lipgloss.JoinVertical(
+		lipgloss.Left,
+		titleStyle.Render("Keys"),
+		body,
+		footerStyle.Render("?/esc: close • q: quit"),
+	))
+	return lipgloss.Place(m.width, m.height, lipgloss.Center, lipgloss.Center, box)
+}
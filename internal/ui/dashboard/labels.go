@@ -0,0 +1,145 @@
+package dashboard
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/adibhanna/focussessions/internal/ui/theme"
+)
+
+const (
+	labelTag = iota
+	labelProject
+	labelIntention
+	labelEnergy
+)
+
+var labelNames = []string{"Tag", "Project", "Intention", "Energy"}
+
+func newLabelInputs() []textinput.Model {
+	inputs := make([]textinput.Model, len(labelNames))
+	for i := range inputs {
+		inputs[i] = textinput.New()
+		inputs[i].CharLimit = 32
+		inputs[i].Width = 30
+	}
+	inputs[labelTag].Placeholder = "writing"
+	inputs[labelProject].Placeholder = "thesis"
+	inputs[labelIntention].Placeholder = "ship the storage refactor"
+	inputs[labelIntention].CharLimit = 80
+	inputs[labelIntention].Width = 50
+	inputs[labelEnergy].Placeholder = "1-5"
+	inputs[labelEnergy].CharLimit = 1
+	inputs[labelEnergy].Width = 3
+	inputs[labelEnergy].Validate = func(s string) error {
+		if s == "" {
+			return nil
+		}
+		if _, err := parseEnergy(s); err != nil {
+			return err
+		}
+		return nil
+	}
+	return inputs
+}
+
+// parseEnergy reads a 1–5 energy rating; an empty value clears it.
+func parseEnergy(s string) (int, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return 0, nil
+	}
+	n, err := strconv.Atoi(s)
+	if err != nil || n < 1 || n > 5 {
+		return 0, fmt.Errorf("energy must be 1-5")
+	}
+	return n, nil
+}
+
+// openLabelEditor starts editing the labels of the running session. The
+// timer keeps ticking while the editor is open.
+func (m Model) openLabelEditor() (tea.Model, tea.Cmd) {
+	if m.activeSession == nil {
+		return m, nil
+	}
+
+	m.editingLabels = true
+	m.labelFocus = labelTag
+	m.labelInputs[labelTag].SetValue(m.activeSession.Tag)
+	m.labelInputs[labelProject].SetValue(m.activeSession.Project)
+	m.labelInputs[labelIntention].SetValue(m.activeSession.Intention)
+	m.labelInputs[labelEnergy].SetValue("")
+	if m.activeSession.Energy > 0 {
+		m.labelInputs[labelEnergy].SetValue(strconv.Itoa(m.activeSession.Energy))
+	}
+	for i := range m.labelInputs {
+		m.labelInputs[i].CursorEnd()
+		m.labelInputs[i].Blur()
+	}
+	return m, m.labelInputs[m.labelFocus].Focus()
+}
+
+func (m Model) updateLabelEditor(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "esc":
+		m.closeLabelEditor()
+		return m, nil
+
+	case "enter":
+		if m.activeSession != nil {
+			m.activeSession.Tag = strings.TrimSpace(m.labelInputs[labelTag].Value())
+			m.activeSession.Project = strings.TrimSpace(m.labelInputs[labelProject].Value())
+			m.activeSession.Intention = strings.TrimSpace(m.labelInputs[labelIntention].Value())
+			m.activeSession.Energy, _ = parseEnergy(m.labelInputs[labelEnergy].Value())
+			m.syncElapsed()
+			m.activeSession.ElapsedSeconds = m.timerElapsed
+			m.storage.SaveSession(*m.activeSession)
+		}
+		m.closeLabelEditor()
+		return m, nil
+
+	case "tab", "down":
+		m.labelInputs[m.labelFocus].Blur()
+		m.labelFocus = (m.labelFocus + 1) % len(m.labelInputs)
+		return m, m.labelInputs[m.labelFocus].Focus()
+
+	case "shift+tab", "up":
+		m.labelInputs[m.labelFocus].Blur()
+		m.labelFocus = (m.labelFocus + len(m.labelInputs) - 1) % len(m.labelInputs)
+		return m, m.labelInputs[m.labelFocus].Focus()
+	}
+
+	var cmd tea.Cmd
+	m.labelInputs[m.labelFocus], cmd = m.labelInputs[m.labelFocus].Update(msg)
+	return m, cmd
+}
+
+func (m *Model) closeLabelEditor() {
+	m.editingLabels = false
+	for i := range m.labelInputs {
+		m.labelInputs[i].Blur()
+	}
+}
+
+func (m Model) renderLabelEditor() string {
+	labelStyle := lipgloss.NewStyle().
+		Foreground(theme.Highlight).
+		Width(11)
+
+	helpStyle := lipgloss.NewStyle().
+		Foreground(theme.Faint).
+		MarginTop(1)
+
+	rows := make([]string, 0, len(m.labelInputs)+1)
+	for i, input := range m.labelInputs {
+		rows = append(rows, lipgloss.JoinHorizontal(lipgloss.Top, labelStyle.Render(labelNames[i]+":"), input.View()))
+	}
+	rows = append(rows, helpStyle.Render("tab: next field • enter: save • esc: cancel"))
+
+	return lipgloss.NewStyle().MarginTop(2).Render(lipgloss.JoinVertical(lipgloss.Left, rows...))
+}
@@ -0,0 +1,40 @@
+package dashboard
+
+import (
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/adibhanna/focussessions/internal/models"
+)
+
+// launchMsg starts the session requested on the command line once the
+// dashboard is running.
+type launchMsg struct {
+	minutes int // 0 for the configured session length
+	tag     string
+}
+
+// StartOnLaunch makes the dashboard start a session as soon as it opens,
+// lasting minutes (0 for the configured length) and tagged with tag. It
+// does nothing when a session is already open.
+func (m Model) StartOnLaunch(minutes int, tag string) Model {
+	m.launch = &launchMsg{minutes: minutes, tag: tag}
+	return m
+}
+
+func (m Model) startLaunch(msg launchMsg) (tea.Model, tea.Cmd) {
+	m.launch = nil
+	if m.activeSession != nil {
+		return m, nil
+	}
+	minutes := msg.minutes
+	if minutes == 0 {
+		minutes = m.config.SessionDuration
+	}
+	return m.startSession(&models.Session{Tag: msg.tag}, minutes)
+}
+
+// headless reports whether session was started without the dashboard and
+// has not been opened in one since.
+func headless(session *models.Session) bool {
+	return session.Ledger && session.HeartbeatAt.IsZero()
+}
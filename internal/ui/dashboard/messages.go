@@ -0,0 +1,25 @@
+package dashboard
+
+import (
+	"math/rand/v2"
+	"slices"
+)
+
+// loadMessages gathers the motivational messages from the config and
+// messages.txt.
+func (m *Model) loadMessages() {
+	messages := slices.Clone(m.config.Messages)
+	if extra, err := m.storage.GetMessages(); err == nil {
+		messages = append(messages, extra...)
+	}
+	m.messages = messages
+}
+
+// motivation picks a random message from the pool, or returns fallback
+// when the pool is empty.
+func (m Model) motivation(fallback string) string {
+	if len(m.messages) == 0 {
+		return fallback
+	}
+	return m.messages[rand.IntN(len(m.messages))]
+}
@@ -0,0 +1,98 @@
+package dashboard
+
+import (
+	"sort"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/adibhanna/focussessions/internal/envsnap"
+)
+
+// metadataOrder is the display order for known environment keys; unknown
+// keys follow alphabetically.
+var metadataOrder = []string{
+	envsnap.KeyGitRepo,
+	envsnap.KeyTmux,
+	envsnap.KeyHost,
+	envsnap.KeyTTY,
+	envsnap.KeyBattery,
+}
+
+// environmentMsg carries the environment captured for the session with id.
+type environmentMsg struct {
+	id       string
+	metadata map[string]string
+}
+
+// captureEnvironment snapshots the environment of the session just
+// started, when enabled. It runs outside Update, as it asks git, tmux and
+// others and may take a second.
+func (m Model) captureEnvironment() tea.Cmd {
+	if !m.config.CaptureEnvironment || m.activeSession == nil {
+		return nil
+	}
+	id := m.activeSession.ID
+	return func() tea.Msg {
+		return environmentMsg{id: id, metadata: envsnap.Capture()}
+	}
+}
+
+// updateEnvironment saves the captured environment with its session, if
+// that is still the one running.
+func (m Model) updateEnvironment(msg environmentMsg) (tea.Model, tea.Cmd) {
+	if m.activeSession == nil || m.activeSession.ID != msg.id || len(msg.metadata) == 0 {
+		return m, nil
+	}
+	m.activeSession.Metadata = msg.metadata
+	m.storage.SaveSession(*m.activeSession)
+	return m, nil
+}
+
+func formatMetadata(meta map[string]string) string {
+	if len(meta) == 0 {
+		return ""
+	}
+
+	var parts []string
+	seen := make(map[string]bool)
+	for _, k := range metadataOrder {
+		if v, ok := meta[k]; ok {
+			parts = append(parts, k+": "+v)
+			seen[k] = true
+		}
+	}
+
+	var rest []string
+	for k := range meta {
+		if !seen[k] {
+			rest = append(rest, k)
+		}
+	}
+	sort.Strings(rest)
+	for _, k := range rest {
+		parts = append(parts, k+": "+meta[k])
+	}
+
+	return strings.Join(parts, " • ")
+}
+
+func (m Model) updateFilter(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "enter":
+		m.historyFilter = strings.TrimSpace(m.filterInput.Value())
+		m.filtering = false
+		m.filterInput.Blur()
+		return m, nil
+	case "esc":
+		m.historyFilter = ""
+		m.filterInput.SetValue("")
+		m.filtering = false
+		m.filterInput.Blur()
+		return m, nil
+	}
+
+	var cmd tea.Cmd
+	m.filterInput, cmd = m.filterInput.Update(msg)
+	return m, cmd
+}
@@ -0,0 +1,33 @@
+package dashboard
+
+import "testing"
+
+func TestEnvironmentCapture(t *testing.T) {
+	t.Parallel()
+	m := newTestModel(t)
+	m.config.CaptureEnvironment = true
+	m = press(t, m, "s")
+	if m.activeSession.Metadata != nil {
+		t.Fatalf("environment captured while starting: %v", m.activeSession.Metadata)
+	}
+	if m.captureEnvironment() == nil {
+		t.Fatal("no command capturing the environment")
+	}
+
+	// A capture for a session that is no longer running is dropped
+	next, _ := m.Update(environmentMsg{id: "gone", metadata: map[string]string{"host": "old"}})
+	m = next.(Model)
+	if m.activeSession.Metadata != nil {
+		t.Errorf("metadata of another session kept: %v", m.activeSession.Metadata)
+	}
+
+	next, _ = m.Update(environmentMsg{id: m.activeSession.ID, metadata: map[string]string{"host": "desk"}})
+	m = next.(Model)
+	stored, err := m.storage.GetActiveSession()
+	if err != nil || stored == nil {
+		t.Fatalf("active session %v, %v", stored, err)
+	}
+	if stored.Metadata["host"] != "desk" {
+		t.Errorf("stored metadata %v, want host desk", stored.Metadata)
+	}
+}
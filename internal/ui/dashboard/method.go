@@ -0,0 +1,91 @@
+package dashboard
+
+import (
+	"fmt"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/adibhanna/focussessions/internal/models"
+	"github.com/adibhanna/focussessions/internal/ui/theme"
+)
+
+// openMethodPicker lists the built-in method presets, starting at the one
+// in use.
+func (m Model) openMethodPicker() (tea.Model, tea.Cmd) {
+	m.pickingMethod = true
+	m.methodCursor = 0
+	for i, method := range models.Methods {
+		if method.Name == m.config.Method {
+			m.methodCursor = i
+		}
+	}
+	return m, nil
+}
+
+// updateMethodPicker moves through the presets; enter switches to the
+// selected one, esc keeps the current lengths.
+func (m Model) updateMethodPicker(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "up", "k":
+		m.methodCursor = (m.methodCursor + len(models.Methods) - 1) % len(models.Methods)
+
+	case "down", "j":
+		m.methodCursor = (m.methodCursor + 1) % len(models.Methods)
+
+	case "esc":
+		m.pickingMethod = false
+
+	case "enter":
+		m.pickingMethod = false
+		models.Methods[m.methodCursor].Apply(&m.config)
+		m.storage.SaveConfig(m.config)
+		if !m.timerRunning {
+			m.timerDuration = m.config.SessionDuration * 60
+		}
+		m.refreshPace()
+	}
+	return m, nil
+}
+
+func (m Model) renderMethodPicker() string {
+	titleStyle := lipgloss.NewStyle().
+		Foreground(theme.Highlight).
+		MarginBottom(1)
+
+	itemStyle := lipgloss.NewStyle().
+		Foreground(theme.Muted)
+
+	selectedStyle := lipgloss.NewStyle().
+		Foreground(theme.Accent).
+		Bold(true)
+
+	blurbStyle := lipgloss.NewStyle().
+		Foreground(theme.Muted).
+		Width(min(60, max(m.width-8, 20))).
+		MarginTop(1)
+
+	helpStyle := lipgloss.NewStyle().
+		Foreground(theme.Faint).
+		MarginTop(1)
+
+	rows := []string{titleStyle.Render("Choose a focus method")}
+	for i, method := range models.Methods {
+		line := fmt.Sprintf("  %s • %d sessions a day", method.Label, method.Cycles)
+		style := itemStyle
+		if i == m.methodCursor {
+			line = "▸" + line[1:]
+			style = selectedStyle
+		}
+		if method.Name == m.config.Method {
+			line += " (current)"
+		}
+		rows = append(rows, style.Render(line))
+	}
+	rows = append(rows,
+		blurbStyle.Render(models.Methods[m.methodCursor].Blurb),
+		helpStyle.Render("↑/↓: choose • enter: use • esc: cancel"),
+	)
+
+	return lipgloss.NewStyle().MarginTop(2).Render(lipgloss.JoinVertical(lipgloss.Left, rows...))
+}
@@ -0,0 +1,73 @@
+package dashboard
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/adibhanna/focussessions/internal/models"
+	"github.com/adibhanna/focussessions/internal/notify"
+	"github.com/adibhanna/focussessions/internal/ui/theme"
+)
+
+// flashDuration is how long the countdown flashes at a milestone.
+const flashDuration = 2 * time.Second
+
+type clearFlashMsg struct{}
+
+// checkMilestones announces the milestones passed since previous, the
+// elapsed seconds at the last tick.
+func (m *Model) checkMilestones(previous int) tea.Cmd {
+	if m.onBreak {
+		return nil
+	}
+	var cmds []tea.Cmd
+	for _, milestone := range m.config.MilestonesFor(m.timerDuration) {
+		mark := m.timerDuration - milestone.Left
+		if previous < mark && m.timerElapsed >= mark {
+			cmds = append(cmds, m.alertMilestone(milestone))
+		}
+	}
+	return tea.Batch(cmds...)
+}
+
+func (m *Model) alertMilestone(milestone models.Milestone) tea.Cmd {
+	message := "⏳ " + milestone.Message
+	var cmds []tea.Cmd
+	if m.config.MilestoneAlert(models.AlertFlash) {
+		m.flashing = true
+		m.toast = message
+		cmds = append(cmds, clearToastAfter(), tea.Tick(flashDuration, func(time.Time) tea.Msg {
+			return clearFlashMsg{}
+		}))
+	}
+	// The bell and notifications keep quiet when muted in the settings
+	now := m.now()
+	if m.config.MilestoneAlert(models.AlertBell) && m.config.SoundAt(now) {
+		cmds = append(cmds, func() tea.Msg {
+			// stderr keeps the bell out of the way of the renderer
+			fmt.Fprint(os.Stderr, "\a")
+			return nil
+		})
+	}
+	if m.config.MilestoneAlert(models.AlertNotify) && m.config.NotifyAt(now) {
+		cmds = append(cmds, func() tea.Msg {
+			notify.Send("Focus Sessions", milestone.Message)
+			return nil
+		})
+	}
+	return tea.Batch(cmds...)
+}
+
+// flash swaps the countdown colors while a milestone is being announced.
+func (m Model) flash(style lipgloss.Style) lipgloss.Style {
+	if !m.flashing {
+		return style
+	}
+	return style.
+		Foreground(theme.OnHighlight).
+		Background(theme.Highlight)
+}
@@ -0,0 +1,107 @@
+package dashboard
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/adibhanna/focussessions/internal/models"
+	"github.com/adibhanna/focussessions/internal/mqtt"
+)
+
+// Timer states published over MQTT
+const (
+	stateIdle    = "idle"
+	stateRunning = "running"
+	statePaused  = "paused"
+	stateBreak   = "break"
+)
+
+// timerState is what is published over MQTT.
+type timerState struct {
+	State            string `json:"state"`
+	RemainingSeconds int    `json:"remaining_seconds"`
+	DurationSeconds  int    `json:"duration_seconds"`
+	Tag              string `json:"tag,omitempty"`
+	Project          string `json:"project,omitempty"`
+}
+
+// mqttResultMsg reports how publishing the timer state went.
+type mqttResultMsg struct{ err error }
+
+func (m Model) timerState() timerState {
+	if !m.timerRunning || m.leaving() {
+		return timerState{State: stateIdle}
+	}
+	state := timerState{
+		State:            stateRunning,
+		RemainingSeconds: max(m.timerDuration-m.timerElapsed, 0),
+		DurationSeconds:  m.timerDuration,
+	}
+	switch {
+	case m.onBreak:
+		state.State = stateBreak
+	case m.timerPaused:
+		state.State = statePaused
+	}
+	// Labels go out only as the MQTT scopes allow, like for the other
+	// integrations
+	if m.activeSession != nil && slices.Contains(m.config.IntegrationScopes(mqtt.Kind), models.ScopeLabels) {
+		state.Tag = m.activeSession.Tag
+		state.Project = m.activeSession.Project
+	}
+	return state
+}
+
+// mqttKey changes whenever the timer state is due to be published again:
+// when it changes, and each minute while a timer runs.
+func (m Model) mqttKey() string {
+	if m.config.MQTT == nil || m.config.MQTT.Broker == "" {
+		return ""
+	}
+	state := m.timerState()
+	return fmt.Sprintf("%s %d", state.State, state.RemainingSeconds/60)
+}
+
+// publishState sends the timer state to the configured broker, retained so
+// home automation picks it up when it reconnects.
+func (m Model) publishState() tea.Cmd {
+	settings := *m.config.MQTT
+	topic := settings.Topic
+	if topic == "" {
+		topic = models.DefaultMQTTTopic
+	}
+	broker := mqtt.Broker{
+		URL:      settings.Broker,
+		Username: settings.Username,
+		Password: settings.Password,
+		ClientID: "focussessions-" + m.host,
+	}
+	payload, err := m.statePayload()
+	if err != nil {
+		return nil
+	}
+	return func() tea.Msg {
+		return mqttResultMsg{err: broker.Publish(topic, payload, true)}
+	}
+}
+
+// statePayload returns the timer state as it is published.
+func (m Model) statePayload() ([]byte, error) {
+	return json.Marshal(m.timerState())
+}
+
+// updateMQTTResult reports the first failure to publish, and stays quiet
+// until publishing works again.
+func (m Model) updateMQTTResult(msg mqttResultMsg) (tea.Model, tea.Cmd) {
+	failed := msg.err != nil
+	if !failed || m.mqttFailed {
+		m.mqttFailed = failed
+		return m, nil
+	}
+	m.mqttFailed = true
+	m.toast = "📡 Couldn't publish the timer state: " + msg.err.Error()
+	return m, clearToastAfter()
+}
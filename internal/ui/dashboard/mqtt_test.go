@@ -0,0 +1,45 @@
+package dashboard
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/adibhanna/focussessions/internal/models"
+	"github.com/adibhanna/focussessions/internal/mqtt"
+)
+
+func TestStatePayloadScopes(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name   string
+		scopes map[string][]string
+		labels bool
+	}{
+		{"default scopes", nil, true},
+		{"durations only", map[string][]string{mqtt.Kind: {models.ScopeDurations}}, false},
+		{"nothing", map[string][]string{mqtt.Kind: {}}, false},
+		{"other integrations", map[string][]string{"webhook": {}}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			m := press(t, newTestModel(t), "s")
+			m.config.Scopes = tt.scopes
+			m.activeSession.Tag, m.activeSession.Project = "secret-tag", "secret-project"
+
+			payload, err := m.statePayload()
+			if err != nil {
+				t.Fatal(err)
+			}
+			sent := string(payload)
+			if !strings.Contains(sent, `"state":"running"`) {
+				t.Errorf("payload %s doesn't say the timer runs", sent)
+			}
+			for _, label := range []string{"secret-tag", "secret-project"} {
+				if strings.Contains(sent, label) != tt.labels {
+					t.Errorf("payload %s: has %s = %v, want %v", sent, label, !tt.labels, tt.labels)
+				}
+			}
+		})
+	}
+}
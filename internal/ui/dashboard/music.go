@@ -0,0 +1,24 @@
+package dashboard
+
+import (
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/adibhanna/focussessions/internal/media"
+)
+
+// controlMusic plays or pauses the music player as a session, or a break
+// when session is false, starts.
+func (m Model) controlMusic(session bool) tea.Cmd {
+	play, ok := m.config.MusicPlays(session)
+	if !ok {
+		return nil
+	}
+	return func() tea.Msg {
+		if play {
+			media.Play()
+		} else {
+			media.Pause()
+		}
+		return nil
+	}
+}
@@ -0,0 +1,45 @@
+package dashboard
+
+import (
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/adibhanna/focussessions/internal/ui/theme"
+)
+
+func (m *Model) loadOffDays() {
+	days, err := m.storage.GetOffDays()
+	if err != nil {
+		days = nil
+	}
+	m.offDays = days
+}
+
+// toggleOffDay marks the day in the daily details as a day off, or clears
+// the mark.
+func (m Model) toggleOffDay() (tea.Model, tea.Cmd) {
+	day := m.statsDay()
+	if _, off := m.offDays[day.Format("2006-01-02")]; off {
+		m.storage.ClearOffDays(day, day)
+	} else {
+		m.storage.SetOffDays(day, day, "")
+	}
+	m.loadOffDays()
+	return m, nil
+}
+
+// renderOffDay notes in the daily details that the day is marked off.
+func (m Model) renderOffDay() string {
+	reason, off := m.offDays[m.todayStats.Date]
+	if !off {
+		return ""
+	}
+	text := "🌴 Day off: streaks and averages skip it"
+	if reason != "" {
+		text = "🌴 Day off (" + reason + "): streaks and averages skip it"
+	}
+	return lipgloss.NewStyle().
+		Foreground(theme.Info).
+		Width(max(m.width-4, 20)).
+		Render("\n" + text)
+}
@@ -0,0 +1,56 @@
+package dashboard
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/adibhanna/focussessions/internal/ui/theme"
+)
+
+func (m *Model) refreshPace() {
+	pace, err := m.storage.GetPaceStats(m.now())
+	if err == nil {
+		m.pace = pace
+	}
+}
+
+// renderPace describes today's pace relative to the usual one for this
+// weekday, e.g. "+1 session vs your usual Tuesday by 3:00pm".
+func (m Model) renderPace() string {
+	if m.pace.Samples == 0 {
+		return ""
+	}
+
+	delta := float64(m.pace.Today) - m.pace.Typical
+	weekday := m.pace.At.Weekday().String()
+	by := m.pace.At.Format("3:04pm")
+
+	color := theme.Muted
+	var text string
+	switch {
+	case math.Abs(delta) < 0.05:
+		text = fmt.Sprintf("On pace with your usual %s by %s", weekday, by)
+	case delta > 0:
+		color = theme.Success
+		text = fmt.Sprintf("+%s vs your usual %s by %s", sessionCount(delta), weekday, by)
+	default:
+		color = theme.Danger
+		text = fmt.Sprintf("-%s vs your usual %s by %s", sessionCount(-delta), weekday, by)
+	}
+
+	return lipgloss.NewStyle().
+		Foreground(color).
+		Align(lipgloss.Center).
+		Render(text)
+}
+
+func sessionCount(n float64) string {
+	value := strconv.FormatFloat(math.Round(n*10)/10, 'f', -1, 64)
+	if value == "1" {
+		return "1 session"
+	}
+	return value + " sessions"
+}
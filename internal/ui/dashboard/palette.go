@@ -0,0 +1,299 @@
+package dashboard
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/adibhanna/focussessions/internal/ui/theme"
+)
+
+// paletteLengths are the session lengths offered by the command palette
+// besides the configured one.
+var paletteLengths = []int{25, 50, 90}
+
+// command is an action of the command palette. It is listed only while
+// available reports true, or always when available is nil.
+type command struct {
+	name      string
+	available func(m Model) bool
+	run       func(m Model) (tea.Model, tea.Cmd)
+}
+
+func newPaletteInput() textinput.Model {
+	input := textinput.New()
+	input.Placeholder = "type a command"
+	input.CharLimit = 40
+	input.Width = 40
+	return input
+}
+
+// press handles binding as if its key had been pressed.
+func (m Model) press(binding key.Binding) (tea.Model, tea.Cmd) {
+	return m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(binding.Keys()[0])})
+}
+
+// homeCommand goes to the home view and presses binding there.
+func homeCommand(name string, binding key.Binding, available func(m Model) bool) command {
+	return command{
+		name:      name,
+		available: available,
+		run: func(m Model) (tea.Model, tea.Cmd) {
+			home, _ := m.press(keys.Home)
+			return home.(Model).press(binding)
+		},
+	}
+}
+
+// statsCommand opens the stats overview and presses binding there, or just
+// opens it when binding is nil.
+func statsCommand(name string, binding *key.Binding) command {
+	return command{
+		name: name,
+		run: func(m Model) (tea.Model, tea.Cmd) {
+			m.openStats()
+			if binding == nil {
+				return m, nil
+			}
+			return m.press(*binding)
+		},
+	}
+}
+
+// commands lists every action of the palette, in the order shown before
+// anything is typed.
+func (m Model) commands() []command {
+	idle := func(m Model) bool { return !m.timerRunning }
+	focusing := func(m Model) bool { return m.timerRunning && !m.onBreak && m.activeSession != nil }
+
+	lengths := []int{m.config.SessionDuration}
+	for _, minutes := range paletteLengths {
+		if !slices.Contains(lengths, minutes) {
+			lengths = append(lengths, minutes)
+		}
+	}
+	var commands []command
+	for i, minutes := range lengths {
+		start := command{
+			name:      fmt.Sprintf("Start %dm session", minutes),
+			available: idle,
+			run: func(m Model) (tea.Model, tea.Cmd) {
+				home, _ := m.press(keys.Home)
+				return home.(Model).startSession(nil, minutes)
+			},
+		}
+		// The configured length starts as s does, asking for an intention
+		// when enabled
+		if i == 0 {
+			start.run = homeCommand("", keys.Start, nil).run
+		}
+		commands = append(commands, start)
+	}
+
+	return append(commands,
+		homeCommand("Continue last task", keys.Continue, func(m Model) bool { return !m.timerRunning && m.suggestion != nil }),
+		homeCommand("Take a break", keys.Break, idle),
+		homeCommand("Pause session", keys.Pause, func(m Model) bool { return m.timerRunning && !m.timerPaused && !m.strict() }),
+		homeCommand("Resume session", keys.Resume, func(m Model) bool { return m.timerRunning && m.timerPaused }),
+		homeCommand("Cancel session", keys.Cancel, focusing),
+		homeCommand("End break", keys.Cancel, func(m Model) bool { return m.timerRunning && m.onBreak }),
+		homeCommand("Add 5 minutes", keys.Extend, focusing),
+		homeCommand("Take off 5 minutes", keys.Shorten, focusing),
+		homeCommand("Log distraction", keys.Distract, focusing),
+		homeCommand("Log interruption", keys.Interrupt, focusing),
+		homeCommand("Edit session labels", keys.Label, focusing),
+		homeCommand("Cycle intensity", keys.Level, func(m Model) bool { return !m.onBreak }),
+		homeCommand("Undo last cancel or completion", keys.Undo, nil),
+		homeCommand("Toggle strict mode", keys.Strict, nil),
+		homeCommand("Toggle zen mode", keys.Zen, nil),
+		homeCommand("Toggle focus buddy", keys.Buddy, nil),
+		homeCommand("Choose focus method", keys.Method, nil),
+		homeCommand("Switch profile", keys.Profile, idle),
+		homeCommand("Plan today", keys.Plan, nil),
+		homeCommand("Open tasks", keys.Tasks, nil),
+		statsCommand("Open stats", nil),
+		statsCommand("Open daily stats", &keys.Daily),
+		statsCommand("Open weekly stats", &keys.Weekly),
+		statsCommand("Open monthly stats", &keys.Monthly),
+		statsCommand("Open yearly stats", &keys.Yearly),
+		statsCommand("Open insights", &keys.Insights),
+		statsCommand("Open focus by hour", &keys.Hours),
+		statsCommand("Open achievements", &keys.Achievements),
+		statsCommand("Compare estimated and actual pomodoros", &keys.Estimates),
+		statsCommand("Write weekly review", &keys.Review),
+		statsCommand("Open year in review", &keys.YearReview),
+		statsCommand("Stats for a date range", &keys.DateRange),
+		statsCommand("Filter stats by tag or project", &keys.StatsFilter),
+		statsCommand("Export stats (CSV, JSON, HTML…)", &keys.Export),
+		command{name: "Take the tour", available: idle, run: func(m Model) (tea.Model, tea.Cmd) {
+			m.viewState = HomeView
+			return m.StartTour(), nil
+		}},
+		command{name: "Show keys", run: func(m Model) (tea.Model, tea.Cmd) {
+			m.helpBar.ShowAll = true
+			return m, nil
+		}},
+		command{name: "Open settings", run: func(m Model) (tea.Model, tea.Cmd) { return m.press(keys.Settings) }},
+		command{name: "Quit", run: func(m Model) (tea.Model, tea.Cmd) { return m.press(keys.Quit) }},
+	)
+}
+
+// paletteMatches returns the available commands matching what was typed,
+// best match first.
+func (m Model) paletteMatches() []command {
+	query := strings.TrimSpace(m.paletteInput.Value())
+	type match struct {
+		command command
+		score   int
+	}
+	var matches []match
+	for _, c := range m.commands() {
+		if c.available != nil && !c.available(m) {
+			continue
+		}
+		if score, ok := fuzzyScore(query, c.name); ok {
+			matches = append(matches, match{c, score})
+		}
+	}
+	sort.SliceStable(matches, func(i, j int) bool { return matches[i].score > matches[j].score })
+
+	commands := make([]command, len(matches))
+	for i, match := range matches {
+		commands[i] = match.command
+	}
+	return commands
+}
+
+// fuzzyScore reports whether the letters of query appear in order in
+// target, ignoring case and spaces, and scores the match: letters at the
+// start of a word score highest, then letters right after the previous
+// one.
+func fuzzyScore(query, target string) (int, bool) {
+	query = strings.ToLower(strings.ReplaceAll(query, " ", ""))
+	runes := []rune(strings.ToLower(target))
+	score, last := 0, -1
+	i := 0
+	for _, q := range query {
+		for i < len(runes) && runes[i] != q {
+			i++
+		}
+		if i == len(runes) {
+			return 0, false
+		}
+		switch {
+		case i == 0 || !unicode.IsLetter(runes[i-1]):
+			score += 4
+		case i == last+1:
+			score += 3
+		default:
+			score++
+		}
+		last = i
+		i++
+	}
+	return score, true
+}
+
+// openPalette opens the command palette with nothing typed yet.
+func (m Model) openPalette() (tea.Model, tea.Cmd) {
+	m.paletteOpen = true
+	m.paletteCursor = 0
+	m.paletteInput.SetValue("")
+	return m, m.paletteInput.Focus()
+}
+
+func (m *Model) closePalette() {
+	m.paletteOpen = false
+	m.paletteInput.Blur()
+}
+
+// updatePalette filters the commands as they are typed; ↑/↓ choose one,
+// enter runs it and esc closes the palette.
+func (m Model) updatePalette(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	matches := m.paletteMatches()
+	switch msg.String() {
+	case "esc", "ctrl+c":
+		m.closePalette()
+		return m, nil
+
+	case "up", "ctrl+p":
+		if len(matches) > 0 {
+			m.paletteCursor = (m.paletteCursor + len(matches) - 1) % len(matches)
+		}
+		return m, nil
+
+	case "down", "ctrl+n":
+		if len(matches) > 0 {
+			m.paletteCursor = (m.paletteCursor + 1) % len(matches)
+		}
+		return m, nil
+
+	case "enter":
+		if len(matches) == 0 {
+			return m, nil
+		}
+		m.closePalette()
+		return matches[min(m.paletteCursor, len(matches)-1)].run(m)
+	}
+
+	var cmd tea.Cmd
+	m.paletteInput, cmd = m.paletteInput.Update(msg)
+	m.paletteCursor = 0
+	return m, cmd
+}
+
+// renderPalette draws the palette in a box over the middle of the screen,
+// with as many matches as fit below the input.
+func (m Model) renderPalette() string {
+	boxStyle := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(theme.Primary).
+		Padding(0, 1).
+		Width(min(50, max(m.width-4, 20)))
+
+	itemStyle := lipgloss.NewStyle().
+		Foreground(theme.Muted)
+
+	selectedStyle := lipgloss.NewStyle().
+		Foreground(theme.Accent).
+		Bold(true)
+
+	helpStyle := lipgloss.NewStyle().
+		Foreground(theme.Faint).
+		MarginTop(1)
+
+	matches := m.paletteMatches()
+	cursor := min(m.paletteCursor, max(len(matches)-1, 0))
+
+	// Keep the cursor in view when there are more matches than rows
+	first, last := 0, len(matches)
+	if rows := max(m.height-10, 3); last > rows {
+		first = min(max(cursor-rows/2, 0), last-rows)
+		last = first + rows
+	}
+
+	rows := []string{m.paletteInput.View(), ""}
+	if len(matches) == 0 {
+		rows = append(rows, itemStyle.Render("  No matching commands"))
+	}
+	for i := first; i < last; i++ {
+		line := "  " + matches[i].name
+		style := itemStyle
+		if i == cursor {
+			line = "▸ " + matches[i].name
+			style = selectedStyle
+		}
+		rows = append(rows, style.Render(line))
+	}
+	rows = append(rows, helpStyle.Render("↑/↓: choose • enter: run • esc: close"))
+
+	box := boxStyle.Render(lipgloss.JoinVertical(lipgloss.Left, rows...))
+	return lipgloss.Place(m.width, m.height, lipgloss.Center, lipgloss.Center, box)
+}
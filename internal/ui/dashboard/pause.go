@@ -0,0 +1,68 @@
+package dashboard
+
+import (
+	"fmt"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/adibhanna/focussessions/internal/models"
+)
+
+// pauseExpiredMsg is sent when the pause of session id may have run out.
+type pauseExpiredMsg struct{ id string }
+
+// watchPause wakes the dashboard when the running session's pause runs
+// out, if the config limits pauses.
+func (m Model) watchPause() tea.Cmd {
+	if m.activeSession == nil {
+		return nil
+	}
+	deadline, ok := m.config.PauseDeadline(*m.activeSession)
+	if !ok {
+		return nil
+	}
+	id := m.activeSession.ID
+	return tea.Tick(max(deadline.Sub(m.now()), 0), func(time.Time) tea.Msg {
+		return pauseExpiredMsg{id: id}
+	})
+}
+
+// updatePauseExpired abandons the paused session once its pause has run
+// out.
+func (m Model) updatePauseExpired(msg pauseExpiredMsg) (tea.Model, tea.Cmd) {
+	if m.activeSession == nil || m.activeSession.ID != msg.id || !m.timerPaused {
+		return m, nil
+	}
+	reason := m.config.PauseExceeded(*m.activeSession, m.now())
+	if reason == "" {
+		return m, m.watchPause()
+	}
+
+	m.activeSession.Unpause(m.now())
+	m.activeSession.Abandoned = true
+	next, cmd := m.cancelSession()
+	m = next.(Model)
+	m.toast = fmt.Sprintf("⏱  Session abandoned: it %s", reason)
+	return m, tea.Batch(cmd, clearToastAfter())
+}
+
+// expirePaused abandons a session left paused for too long while the
+// dashboard was closed and returns a note saying so, or "" when there was
+// none.
+func expirePaused(store Store, config models.Config, now time.Time) string {
+	session, reason, err := store.ExpirePaused(config, now)
+	if err != nil || session == nil {
+		return ""
+	}
+	started := session.StartTime.Local().Format("Jan 2 3:04pm")
+	return fmt.Sprintf("Abandoned the session started %s: it %s", started, reason)
+}
+
+// stoppedLabel describes a session that ended without completing.
+func stoppedLabel(session models.Session) string {
+	if session.Abandoned {
+		return "Abandoned"
+	}
+	return "Stopped early"
+}
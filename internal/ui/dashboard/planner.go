@@ -0,0 +1,227 @@
+package dashboard
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/adibhanna/focussessions/internal/models"
+	"github.com/adibhanna/focussessions/internal/ui/theme"
+)
+
+// planStep is how much +/- change a planned block's duration, in minutes.
+const planStep = 5
+
+func (m *Model) refreshPlan() {
+	progress, err := m.storage.GetPlanProgress(m.now().Format("2006-01-02"))
+	if err == nil {
+		m.plan = progress
+	}
+	if m.planCursor >= len(m.plan.Plan.Blocks) {
+		m.planCursor = max(len(m.plan.Plan.Blocks)-1, 0)
+	}
+}
+
+func (m Model) openPlanner() (tea.Model, tea.Cmd) {
+	m.viewState = PlannerView
+	m.refreshPlan()
+	return m, nil
+}
+
+// savePlan stores the edited plan and recomputes its progress.
+func (m *Model) savePlan(plan models.DayPlan) {
+	m.storage.SaveDayPlan(plan)
+	m.refreshPlan()
+}
+
+// updatePlanner handles keys in the planner view. It reports false for keys
+// it leaves to the global bindings.
+func (m Model) updatePlanner(msg tea.KeyMsg) (Model, tea.Cmd, bool) {
+	if m.editingPlanTag {
+		switch msg.String() {
+		case "enter":
+			plan := m.plan.Plan
+			plan.Blocks = append([]models.PlannedBlock(nil), plan.Blocks...)
+			plan.Blocks[m.planCursor].Tag = strings.TrimSpace(m.planTagInput.Value())
+			m.savePlan(plan)
+			fallthrough
+		case "esc":
+			m.editingPlanTag = false
+			m.planTagInput.Blur()
+			return m, nil, true
+		}
+		var cmd tea.Cmd
+		m.planTagInput, cmd = m.planTagInput.Update(msg)
+		return m, cmd, true
+	}
+
+	plan := m.plan.Plan
+	plan.Blocks = append([]models.PlannedBlock(nil), plan.Blocks...)
+	hasBlock := m.planCursor < len(plan.Blocks)
+
+	switch msg.String() {
+	case "up", "k":
+		if m.planCursor > 0 {
+			m.planCursor--
+		}
+	case "down", "j":
+		if m.planCursor < len(plan.Blocks)-1 {
+			m.planCursor++
+		}
+	case "a":
+		block := models.PlannedBlock{Duration: m.config.SessionDuration}
+		if hasBlock {
+			block = plan.Blocks[m.planCursor]
+		}
+		plan.Blocks = append(plan.Blocks, block)
+		m.savePlan(plan)
+		m.planCursor = len(plan.Blocks) - 1
+	case "+", "=":
+		if hasBlock {
+			plan.Blocks[m.planCursor].Duration = min(plan.Blocks[m.planCursor].Duration+planStep, 180)
+			m.savePlan(plan)
+		}
+	case "-":
+		if hasBlock {
+			plan.Blocks[m.planCursor].Duration = max(plan.Blocks[m.planCursor].Duration-planStep, planStep)
+			m.savePlan(plan)
+		}
+	case "t":
+		if hasBlock {
+			m.editingPlanTag = true
+			m.planTagInput.SetValue(plan.Blocks[m.planCursor].Tag)
+			m.planTagInput.CursorEnd()
+			return m, m.planTagInput.Focus(), true
+		}
+	case "x", "delete":
+		if hasBlock {
+			plan.Blocks = append(plan.Blocks[:m.planCursor], plan.Blocks[m.planCursor+1:]...)
+			m.savePlan(plan)
+		}
+	case "esc", "b", "h":
+		m.viewState = HomeView
+	default:
+		return m, nil, false
+	}
+	return m, nil, true
+}
+
+func (m Model) renderPlannerView() string {
+	containerStyle := lipgloss.NewStyle().
+		Width(m.width).
+		Height(m.height).
+		Padding(2)
+
+	titleStyle := lipgloss.NewStyle().
+		Bold(true).
+		Foreground(theme.Accent).
+		MarginBottom(1)
+
+	summaryStyle := lipgloss.NewStyle().
+		Foreground(theme.Highlight).
+		MarginBottom(1)
+
+	doneStyle := lipgloss.NewStyle().
+		Foreground(theme.Success)
+
+	selectedStyle := lipgloss.NewStyle().
+		Foreground(theme.Primary).
+		Bold(true)
+
+	emptyStyle := lipgloss.NewStyle().
+		Foreground(theme.Muted)
+
+	helpStyle := lipgloss.NewStyle().
+		Foreground(theme.Faint).
+		MarginTop(2)
+
+	title := titleStyle.Render("📋 Today's Plan - " + m.now().Format("Monday, January 2"))
+
+	var rows []string
+	if len(m.plan.Plan.Blocks) == 0 {
+		rows = append(rows, emptyStyle.Render("No sessions planned yet. Press 'a' to add one."))
+	} else {
+		rows = append(rows, summaryStyle.Render(m.planSummary()))
+	}
+	for i, block := range m.plan.Plan.Blocks {
+		mark := "[ ]"
+		if m.plan.Done[i] {
+			mark = "[✓]"
+		}
+		line := fmt.Sprintf("%s %d. %3d min", mark, i+1, block.Duration)
+		if block.Tag != "" {
+			line += "  #" + block.Tag
+		}
+
+		switch {
+		case i == m.planCursor:
+			line = selectedStyle.Render("> " + line)
+		case m.plan.Done[i]:
+			line = "  " + doneStyle.Render(line)
+		default:
+			line = "  " + line
+		}
+		rows = append(rows, line)
+	}
+
+	help := "a: add • +/-: duration • t: tag • x: remove • ↑/↓: select • b: back • q: quit"
+	if m.editingPlanTag {
+		rows = append(rows, "", "Tag: "+m.planTagInput.View())
+		help = "enter: save tag • esc: cancel"
+	}
+
+	content := lipgloss.JoinVertical(
+		lipgloss.Left,
+		title,
+		lipgloss.JoinVertical(lipgloss.Left, rows...),
+		helpStyle.Render(help),
+	)
+	return containerStyle.Render(content)
+}
+
+// planSummary describes plan-vs-actual, e.g. "2/5 planned blocks done • 3
+// remaining • 1h 40m of 4h 10m".
+func (m Model) planSummary() string {
+	return fmt.Sprintf("%d/%d planned blocks done • %d remaining • %s of %s",
+		m.plan.DoneCount(),
+		len(m.plan.Plan.Blocks),
+		m.plan.Remaining(),
+		models.FormatMinutes(m.plan.ActualMinutes),
+		models.FormatMinutes(m.plan.PlannedMinutes),
+	)
+}
+
+// renderPlanStatus is the home view's one-line plan summary, empty when
+// there is no plan for today.
+func (m Model) renderPlanStatus() string {
+	if len(m.plan.Plan.Blocks) == 0 {
+		return ""
+	}
+
+	text := fmt.Sprintf("📋 Plan: %d/%d blocks done", m.plan.DoneCount(), len(m.plan.Plan.Blocks))
+	if next := m.plan.Next(); next >= 0 {
+		block := m.plan.Plan.Blocks[next]
+		text += fmt.Sprintf(" • %d remaining • next: %dm", m.plan.Remaining(), block.Duration)
+		if block.Tag != "" {
+			text += " #" + block.Tag
+		}
+	} else {
+		text += " • plan complete!"
+	}
+
+	return lipgloss.NewStyle().
+		Foreground(theme.Info).
+		Align(lipgloss.Center).
+		Render(text)
+}
+
+func newPlanTagInput() textinput.Model {
+	input := textinput.New()
+	input.Placeholder = "writing"
+	input.CharLimit = 32
+	input.Width = 30
+	return input
+}
@@ -0,0 +1,105 @@
+package dashboard
+
+import (
+	"cmp"
+	"io"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/adibhanna/focussessions/internal/hooks"
+	"github.com/adibhanna/focussessions/internal/models"
+	"github.com/adibhanna/focussessions/internal/notify"
+	"github.com/adibhanna/focussessions/internal/plugins"
+	"github.com/adibhanna/focussessions/internal/scripts"
+)
+
+// pluginCommandsMsg carries what the plugins or scripts answered an event
+// with.
+type pluginCommandsMsg struct {
+	commands []plugins.Command
+}
+
+// runPlugins sends the event in payload to every plugin. Their answers
+// come back as a pluginCommandsMsg; failing plugins are ignored.
+func (m Model) runPlugins(payload hooks.Payload) tea.Cmd {
+	event, err := plugins.NewEvent(m.config, payload, m.now())
+	if err != nil {
+		return nil
+	}
+	dir := m.storage.PluginsDir()
+	return func() tea.Msg {
+		commands, _ := plugins.RunAll(dir, event)
+		if len(commands) == 0 {
+			return nil
+		}
+		return pluginCommandsMsg{commands: commands}
+	}
+}
+
+// runScripts calls the handlers of the scripts for the event in payload.
+// The commands they send come back as a pluginCommandsMsg; what they print
+// is dropped, and failing scripts are ignored.
+func (m Model) runScripts(payload hooks.Payload) tea.Cmd {
+	store, dir, now := m.storage, m.storage.ScriptsDir(), m.now()
+	return func() tea.Msg {
+		commands, _ := scripts.RunAll(dir, store, payload, now, io.Discard)
+		if len(commands) == 0 {
+			return nil
+		}
+		return pluginCommandsMsg{commands: commands}
+	}
+}
+
+// runPluginCommands carries out the commands in order.
+func (m Model) runPluginCommands(commands []plugins.Command) (tea.Model, tea.Cmd) {
+	var cmds []tea.Cmd
+	for _, command := range commands {
+		next, cmd := m.runPluginCommand(command)
+		m = next.(Model)
+		cmds = append(cmds, cmd)
+	}
+	return m, tea.Batch(cmds...)
+}
+
+// runPluginCommand does what command asks when it makes sense right now:
+// sessions only start when none is running, and strict sessions can't be
+// paused.
+func (m Model) runPluginCommand(command plugins.Command) (tea.Model, tea.Cmd) {
+	switch command.Command {
+	case plugins.CommandToast:
+		m.toast = command.Text
+		return m, clearToastAfter()
+
+	case plugins.CommandNotify:
+		if !m.config.NotifyAt(m.now()) {
+			return m, nil
+		}
+		title := cmp.Or(command.Title, "Focus Sessions")
+		return m, func() tea.Msg {
+			notify.Send(title, command.Text)
+			return nil
+		}
+
+	case plugins.CommandStart:
+		if m.timerRunning {
+			return m, nil
+		}
+		return m.startSession(&models.Session{Tag: command.Tag}, cmp.Or(command.Minutes, m.config.SessionDuration))
+
+	case plugins.CommandPause:
+		if m.timerRunning && !m.timerPaused && !m.strict() {
+			return m.pause()
+		}
+
+	case plugins.CommandResume:
+		if m.timerRunning && m.timerPaused {
+			return m.resume()
+		}
+
+	case plugins.CommandBreak:
+		if !m.timerRunning {
+			return m.startBreak()
+		}
+	}
+	return m, nil
+}
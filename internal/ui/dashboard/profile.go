@@ -0,0 +1,90 @@
+package dashboard
+
+import (
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/adibhanna/focussessions/internal/storage"
+	"github.com/adibhanna/focussessions/internal/ui/theme"
+)
+
+// openProfilePicker lists the profiles to switch to, starting at the
+// current one.
+func (m Model) openProfilePicker() (tea.Model, tea.Cmd) {
+	profiles, err := storage.Profiles()
+	if err != nil {
+		return m, nil
+	}
+	m.pickingProfile = true
+	m.profiles = profiles
+	m.profileCursor = 0
+	for i, name := range profiles {
+		if name == m.storage.Profile() {
+			m.profileCursor = i
+		}
+	}
+	return m, nil
+}
+
+// updateProfilePicker moves through the profiles; enter quits the
+// dashboard so it can be reopened on the selected profile.
+func (m Model) updateProfilePicker(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "up", "k":
+		m.profileCursor = (m.profileCursor + len(m.profiles) - 1) % len(m.profiles)
+
+	case "down", "j":
+		m.profileCursor = (m.profileCursor + 1) % len(m.profiles)
+
+	case "esc":
+		m.pickingProfile = false
+
+	case "enter":
+		m.pickingProfile = false
+		if name := m.profiles[m.profileCursor]; name != m.storage.Profile() {
+			m.switchProfile = name
+			return m, tea.Quit
+		}
+	}
+	return m, nil
+}
+
+func (m Model) renderProfilePicker() string {
+	titleStyle := lipgloss.NewStyle().
+		Foreground(theme.Highlight).
+		MarginBottom(1)
+
+	itemStyle := lipgloss.NewStyle().
+		Foreground(theme.Muted)
+
+	selectedStyle := lipgloss.NewStyle().
+		Foreground(theme.Accent).
+		Bold(true)
+
+	helpStyle := lipgloss.NewStyle().
+		Foreground(theme.Faint).
+		MarginTop(1)
+
+	rows := []string{titleStyle.Render("Switch profile")}
+	for i, name := range m.profiles {
+		line := "  " + name
+		style := itemStyle
+		if i == m.profileCursor {
+			line = "▸ " + name
+			style = selectedStyle
+		}
+		if name == m.storage.Profile() {
+			line += " (current)"
+		}
+		rows = append(rows, style.Render(line))
+	}
+	rows = append(rows, helpStyle.Render("↑/↓: choose • enter: switch • esc: cancel"))
+
+	return lipgloss.NewStyle().MarginTop(2).Render(lipgloss.JoinVertical(lipgloss.Left, rows...))
+}
+
+// SwitchProfile returns the profile to reopen the dashboard on, or an empty
+// string when the dashboard wasn't closed to switch profiles.
+func (m Model) SwitchProfile() string {
+	return m.switchProfile
+}
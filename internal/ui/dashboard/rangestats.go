@@ -0,0 +1,240 @@
+package dashboard
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/adibhanna/focussessions/internal/models"
+	"github.com/adibhanna/focussessions/internal/ui/theme"
+)
+
+const (
+	rangeFrom = iota
+	rangeTo
+)
+
+var rangeFieldNames = []string{"From", "To"}
+
+func newRangeInputs() []textinput.Model {
+	inputs := make([]textinput.Model, len(rangeFieldNames))
+	for i := range inputs {
+		inputs[i] = textinput.New()
+		inputs[i].Placeholder = "YYYY-MM-DD"
+		inputs[i].CharLimit = 10
+		inputs[i].Width = 12
+	}
+	return inputs
+}
+
+// openRangePicker opens the date range view with the picker showing the
+// last range asked for, or this month so far.
+func (m Model) openRangePicker() (tea.Model, tea.Cmd) {
+	m.viewState = RangeView
+	m.pickingRange = true
+	m.rangeErr = ""
+
+	first, last := m.rangeStats.First, m.rangeStats.Last
+	if first.IsZero() {
+		now := m.now()
+		first = time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.Local)
+		last = time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.Local)
+	}
+	m.rangeInputs[rangeFrom].SetValue(first.Format("2006-01-02"))
+	m.rangeInputs[rangeTo].SetValue(last.Format("2006-01-02"))
+	for i := range m.rangeInputs {
+		m.rangeInputs[i].CursorEnd()
+		m.rangeInputs[i].Blur()
+	}
+	m.rangeFocus = rangeFrom
+	return m, m.rangeInputs[m.rangeFocus].Focus()
+}
+
+// updateRangePicker edits the range: enter moves from the first date to the
+// last and then shows the stats, esc leaves the picker.
+func (m Model) updateRangePicker(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "esc":
+		m.closeRangePicker()
+		if m.rangeStats.First.IsZero() {
+			m.viewState = StatsView
+		}
+		return m, nil
+
+	case "enter":
+		if m.rangeFocus == rangeFrom {
+			return m.focusRangeField(rangeTo)
+		}
+		if err := m.loadRangeStats(); err != nil {
+			m.rangeErr = err.Error()
+			return m, nil
+		}
+		m.closeRangePicker()
+		return m, nil
+
+	case "tab", "down", "shift+tab", "up":
+		return m.focusRangeField(1 - m.rangeFocus)
+	}
+
+	var cmd tea.Cmd
+	m.rangeInputs[m.rangeFocus], cmd = m.rangeInputs[m.rangeFocus].Update(msg)
+	return m, cmd
+}
+
+func (m Model) focusRangeField(field int) (tea.Model, tea.Cmd) {
+	m.rangeInputs[m.rangeFocus].Blur()
+	m.rangeFocus = field
+	return m, m.rangeInputs[m.rangeFocus].Focus()
+}
+
+func (m *Model) closeRangePicker() {
+	m.pickingRange = false
+	m.rangeErr = ""
+	for i := range m.rangeInputs {
+		m.rangeInputs[i].Blur()
+	}
+}
+
+// loadRangeStats reads the dates in the picker and aggregates the sessions
+// between them.
+func (m *Model) loadRangeStats() error {
+	var days [2]time.Time
+	for i, input := range m.rangeInputs {
+		value := strings.TrimSpace(input.Value())
+		day, err := time.ParseInLocation("2006-01-02", value, time.Local)
+		if err != nil {
+			return fmt.Errorf("invalid date %q (use YYYY-MM-DD)", value)
+		}
+		days[i] = day
+	}
+	if days[rangeTo].Before(days[rangeFrom]) {
+		return fmt.Errorf("%s is before %s", days[rangeTo].Format("2006-01-02"), days[rangeFrom].Format("2006-01-02"))
+	}
+
+	stats, err := m.storage.GetRangeStats(days[rangeFrom], days[rangeTo])
+	if err != nil {
+		return err
+	}
+	m.rangeStats = stats
+	return nil
+}
+
+func (m Model) renderRangeView() string {
+	containerStyle := lipgloss.NewStyle().
+		Width(m.width).
+		Height(m.height).
+		Padding(2)
+
+	titleStyle := lipgloss.NewStyle().
+		Bold(true).
+		Foreground(theme.Accent).
+		MarginBottom(2).
+		Align(lipgloss.Center)
+
+	title := titleStyle.Render("📆 Date Range Stats")
+	if first := m.rangeStats.First; !first.IsZero() && !m.pickingRange {
+		title = titleStyle.Render(fmt.Sprintf("📆 %s – %s",
+			first.Format("Jan 2, 2006"), m.rangeStats.Last.Format("Jan 2, 2006")))
+	}
+
+	var body string
+	if m.pickingRange {
+		body = m.renderRangePicker()
+	} else {
+		body = m.renderRangeStatsDetail()
+	}
+
+	content := lipgloss.JoinVertical(
+		lipgloss.Left,
+		title,
+		body,
+		m.renderHelp(),
+	)
+
+	return containerStyle.Render(content)
+}
+
+func (m Model) renderRangePicker() string {
+	labelStyle := lipgloss.NewStyle().
+		Foreground(theme.Highlight).
+		Width(6)
+
+	errStyle := lipgloss.NewStyle().
+		Foreground(theme.Error).
+		MarginTop(1)
+
+	rows := make([]string, 0, len(m.rangeInputs)+1)
+	for i, input := range m.rangeInputs {
+		rows = append(rows, lipgloss.JoinHorizontal(lipgloss.Top, labelStyle.Render(rangeFieldNames[i]+":"), input.View()))
+	}
+	if m.rangeErr != "" {
+		rows = append(rows, errStyle.Render(m.rangeErr))
+	}
+	return lipgloss.JoinVertical(lipgloss.Left, rows...)
+}
+
+func (m Model) renderRangeStatsDetail() string {
+	statsStyle := lipgloss.NewStyle().
+		Foreground(theme.Highlight).
+		MarginBottom(1)
+
+	dayStyle := lipgloss.NewStyle().
+		Foreground(theme.Muted).
+		PaddingLeft(2)
+
+	stats := m.rangeStats
+	summary := statsStyle.Render(fmt.Sprintf(
+		"Total Sessions: %d | Total Time: %s | Active Days: %d of %d",
+		stats.SessionsCount,
+		models.FormatMinutes(stats.TotalMinutes),
+		stats.ActiveDays,
+		stats.Days,
+	) + qualityLine(
+		avgFocusText(stats.AverageFocus),
+		weightedText(stats.WeightedMinutes, stats.TotalMinutes),
+		falseStartsText(stats.FalseStarts),
+		partialText(stats.PartialSessions, stats.PartialMinutes),
+	) + qualityLine(intensityText(stats.IntensityMinutes)))
+
+	avgStats := statsStyle.Render(fmt.Sprintf(
+		"Average: %.1f sessions per %s",
+		m.averagePerDay(stats.SessionsCount, stats.First, stats.Last.AddDate(0, 0, 1)),
+		m.averageDayName(),
+	))
+
+	var days string
+	if len(stats.DailyStats) == 0 {
+		days = dayStyle.Render("No sessions in this range.")
+	} else {
+		// Leave room for the title, totals and help around the breakdown
+		rows := stats.DailyStats
+		if limit := max(m.height-18, 3); len(rows) > limit {
+			rows = rows[len(rows)-limit:]
+		}
+		days = "\nDaily Breakdown:\n"
+		if len(rows) < len(stats.DailyStats) {
+			days += dayStyle.Render(fmt.Sprintf("… %d earlier days", len(stats.DailyStats)-len(rows))) + "\n"
+		}
+		for _, day := range rows {
+			date, _ := time.Parse("2006-01-02", day.Date)
+			dayInfo := fmt.Sprintf(
+				"%s: %d sessions (%s)",
+				date.Format("Mon Jan 2"),
+				day.SessionsCount,
+				models.FormatMinutes(day.TotalMinutes),
+			) + rowQuality(day.AverageFocus, day.Distractions, day.SessionsCount)
+			days += dayStyle.Render(dayInfo) + "\n"
+		}
+	}
+
+	return lipgloss.JoinVertical(
+		lipgloss.Left,
+		summary,
+		avgStats,
+		days,
+	)
+}
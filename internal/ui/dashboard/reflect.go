@@ -0,0 +1,197 @@
+package dashboard
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/adibhanna/focussessions/internal/models"
+	"github.com/adibhanna/focussessions/internal/ui/layout"
+	"github.com/adibhanna/focussessions/internal/ui/theme"
+)
+
+func newReflectNotes() textinput.Model {
+	input := textinput.New()
+	input.Placeholder = "what helped, what got in the way"
+	input.CharLimit = 200
+	input.Width = 40
+	return input
+}
+
+// startReflection opens the post-session form for the session that just
+// completed. The form lives on the home view, so it leaves zen mode and any
+// stats view.
+func (m Model) startReflection(session models.Session) (tea.Model, tea.Cmd) {
+	m.viewState = HomeView
+	m.zen = false
+	m.reflection = &session
+	m.reflectRating = 0
+	m.reflectNotes.SetValue("")
+	m.reflectNotes.Blur()
+	return m, nil
+}
+
+// updateReflection handles keys while the form is open: 1-5 or ←/→ rate,
+// tab moves between the rating and the notes, enter saves and esc skips.
+func (m Model) updateReflection(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "esc":
+		return m.closeReflection()
+
+	case "enter":
+		m.reflection.Focus = m.reflectRating
+		m.reflection.Notes = strings.TrimSpace(m.reflectNotes.Value())
+		if m.reflection.Focus > 0 || m.reflection.Notes != "" {
+			m.storage.SaveSession(*m.reflection)
+			m.refreshStats()
+		}
+		return m.closeReflection()
+
+	case "tab", "shift+tab", "down", "up":
+		if m.reflectNotes.Focused() {
+			m.reflectNotes.Blur()
+			return m, nil
+		}
+		return m, m.reflectNotes.Focus()
+	}
+
+	if m.reflectNotes.Focused() {
+		var cmd tea.Cmd
+		m.reflectNotes, cmd = m.reflectNotes.Update(msg)
+		return m, cmd
+	}
+
+	switch k := msg.String(); k {
+	case "left", "h":
+		m.reflectRating = max(m.reflectRating-1, 1)
+	case "right", "l":
+		m.reflectRating = min(m.reflectRating+1, 5)
+	case "1", "2", "3", "4", "5":
+		m.reflectRating = int(k[0] - '0')
+	}
+	return m, nil
+}
+
+// closeReflection dismisses the form, then continues into the break when
+// auto-continue is on.
+func (m Model) closeReflection() (tea.Model, tea.Cmd) {
+	m.reflection = nil
+	m.reflectNotes.Blur()
+	if m.config.AutoContinue {
+		return m.scheduleChain(chainBreak)
+	}
+	return m, nil
+}
+
+// refreshStats reloads the stats shown for the current day, week, month
+// and year.
+func (m *Model) refreshStats() {
+	m.statsAt = time.Time{}
+	now := m.now()
+	if todayStats, err := m.storage.GetDayStats(now.Format("2006-01-02")); err == nil {
+		m.todayStats = todayStats
+	}
+	weekYear, week := m.storage.WeekOf(now)
+	if weekStats, err := m.storage.GetWeekStats(weekYear, week); err == nil {
+		m.weekStats = weekStats
+	}
+	if monthStats, err := m.storage.GetMonthStats(now.Year(), int(now.Month())); err == nil {
+		m.monthStats = monthStats
+	}
+	if yearStats, err := m.storage.GetYearStats(now.Year()); err == nil {
+		m.yearStats = yearStats
+	}
+	m.refreshComparisons()
+}
+
+func (m Model) renderReflection() string {
+	questionStyle := lipgloss.NewStyle().
+		Foreground(theme.Highlight)
+
+	starStyle := lipgloss.NewStyle().
+		Foreground(theme.Accent)
+
+	helpStyle := lipgloss.NewStyle().
+		Foreground(theme.Faint).
+		MarginTop(1)
+
+	stars := strings.Repeat("★", m.reflectRating) + strings.Repeat("☆", 5-m.reflectRating)
+	rating := starStyle.Render(stars)
+	if !m.reflectNotes.Focused() {
+		rating = "> " + rating
+	}
+
+	return lipgloss.NewStyle().MarginTop(2).Render(lipgloss.JoinVertical(
+		lipgloss.Left,
+		questionStyle.Render("How focused were you? (1-5)"),
+		rating,
+		questionStyle.Render("Notes:"),
+		m.reflectNotes.View(),
+		helpStyle.Render(layout.Widest(layout.Inner(m.width, 4),
+			"1-5: rate • tab: notes • enter: save • esc: skip",
+			"enter: save • esc: skip",
+		)),
+	))
+}
+
+// avgFocusText reports an average focus score, e.g. "Avg Focus: 3.8/5",
+// and is empty when nothing was rated.
+func avgFocusText(avg float64) string {
+	if avg == 0 {
+		return ""
+	}
+	return fmt.Sprintf("Avg Focus: %.1f/5", avg)
+}
+
+// qualityLine joins the non-empty focus figures into an extra line for a
+// detail view's totals, or returns an empty string when there are none.
+func qualityLine(parts ...string) string {
+	var set []string
+	for _, p := range parts {
+		if p != "" {
+			set = append(set, p)
+		}
+	}
+	if len(set) == 0 {
+		return ""
+	}
+	return "\n" + strings.Join(set, " | ")
+}
+
+// rowQuality extends a breakdown row with its average focus score and
+// distractions per session, e.g. " • ★ 3.8 • ⚡ 1.5/session".
+func rowQuality(avgFocus float64, distractions, sessions int) string {
+	var row string
+	if avgFocus > 0 {
+		row += fmt.Sprintf(" • ★ %.1f", avgFocus)
+	}
+	if distractions > 0 && sessions > 0 {
+		row += fmt.Sprintf(" • ⚡ %.1f/session", float64(distractions)/float64(sessions))
+	}
+	return row
+}
+
+// focusLine is the summary card line for an average focus score.
+func focusLine(avg float64) string {
+	if avg == 0 {
+		return ""
+	}
+	return fmt.Sprintf("\nFocus: %.1f/5", avg)
+}
+
+// formatReflection renders a session's rating and notes for the history,
+// e.g. "★★★★☆ kept getting pinged".
+func formatReflection(s models.Session) string {
+	var parts []string
+	if s.Focus > 0 {
+		parts = append(parts, strings.Repeat("★", s.Focus)+strings.Repeat("☆", 5-s.Focus))
+	}
+	if s.Notes != "" {
+		parts = append(parts, s.Notes)
+	}
+	return strings.Join(parts, " ")
+}
@@ -0,0 +1,89 @@
+package dashboard
+
+import (
+	"fmt"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/adibhanna/focussessions/internal/models"
+	"github.com/adibhanna/focussessions/internal/ui/theme"
+)
+
+// openResume holds the timer of a session left open when the dashboard
+// last quit, and asks whether to resume, complete or discard it.
+func (m *Model) openResume() {
+	m.resuming = true
+	m.timerPaused = true
+	m.timerElapsed = min(m.activeSession.ElapsedSeconds, m.timerDuration)
+}
+
+// updateResume resolves the startup prompt: r resumes the session where it
+// was left, without counting the time away; c completes it counting the
+// time away; d stops it where it was left. q quits and asks again next
+// time.
+func (m Model) updateResume(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	session := m.activeSession
+	switch msg.String() {
+	case "r", "enter":
+		m.resuming = false
+		if session.Paused {
+			return m, m.watchPause()
+		}
+		if session.Ledger {
+			// The time away is a pause, so the ledger picks up where it was
+			now := m.now()
+			away := now.Sub(session.StartTime) - session.PausedFor(now) - time.Duration(session.ElapsedSeconds)*time.Second
+			session.PausedSeconds += max(int(away.Seconds()), 0)
+		}
+		m.timerPaused = false
+		m.heartbeat()
+		m.storage.SaveSession(*session)
+		m.startRun()
+		return m, tickCmd()
+
+	case "c":
+		m.resuming = false
+		session.Unpause(m.now())
+		m.timerElapsed = min(session.ElapsedAt(m.now()), m.timerDuration)
+		return m.completeSession()
+
+	case "d":
+		// The timer stays held, so the time away isn't added
+		m.resuming = false
+		session.Unpause(m.now())
+		return m.cancelSession()
+
+	case "q", "ctrl+c":
+		m.shouldQuit = true
+		return m, tea.Quit
+	}
+	return m, nil
+}
+
+func (m Model) renderResume() string {
+	questionStyle := lipgloss.NewStyle().
+		Foreground(theme.Highlight)
+
+	helpStyle := lipgloss.NewStyle().
+		Foreground(theme.Faint).
+		MarginTop(1)
+
+	session := m.activeSession
+	started := session.StartTime.Local().Format("3:04pm")
+	if session.Date != m.now().Format("2006-01-02") {
+		started = session.StartTime.Local().Format("Jan 2 3:04pm")
+	}
+	left := session.HeartbeatAt
+	if left.IsZero() {
+		left = session.StartTime
+	}
+	away := models.FormatMinutes(int(m.now().Sub(left).Minutes()))
+	done := models.FormatMinutes(session.ElapsedSeconds / 60)
+
+	return lipgloss.NewStyle().MarginTop(2).Render(lipgloss.JoinVertical(lipgloss.Left,
+		questionStyle.Render(fmt.Sprintf("The session started %s is still open: %s done, then %s away.", started, done, away)),
+		helpStyle.Render("r: resume where you left off • c: mark complete • d: discard"),
+	))
+}
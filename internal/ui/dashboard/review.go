@@ -0,0 +1,233 @@
+package dashboard
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/adibhanna/focussessions/internal/models"
+	"github.com/adibhanna/focussessions/internal/ui/theme"
+)
+
+// Steps of the weekly review.
+const (
+	reviewTotals = iota
+	reviewDays
+	reviewGoals
+	reviewNote
+)
+
+// reviewDay is one day of the week under review.
+type reviewDay struct {
+	date     time.Time
+	sessions int
+	minutes  int
+}
+
+func (m Model) openReview() (tea.Model, tea.Cmd) {
+	m.viewState = ReviewView
+	m.reviewStep = reviewTotals
+	m.refreshBurndown()
+	note, _ := m.storage.GetWeekReview(m.weekStats.Year, m.weekStats.Week)
+	m.reviewInput.SetValue(note)
+	m.reviewInput.CursorEnd()
+	return m, nil
+}
+
+// updateReview walks through the review: enter moves on, esc goes back a
+// step, and on the last step enter saves the note.
+func (m Model) updateReview(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "enter":
+		if m.reviewStep == reviewNote {
+			m.storage.SaveWeekReview(m.weekStats.Year, m.weekStats.Week, m.reviewInput.Value())
+			m.reviewInput.Blur()
+			m.viewState = StatsView
+			m.toast = "📝 Weekly review saved"
+			return m, clearToastAfter()
+		}
+		m.reviewStep++
+		if m.reviewStep == reviewNote {
+			return m, m.reviewInput.Focus()
+		}
+		return m, nil
+
+	case "esc":
+		m.reviewInput.Blur()
+		if m.reviewStep == reviewTotals {
+			m.viewState = StatsView
+			return m, nil
+		}
+		m.reviewStep--
+		return m, nil
+
+	case "ctrl+c":
+		m.shouldQuit = true
+		return m, tea.Quit
+	}
+
+	if m.reviewStep == reviewNote {
+		var cmd tea.Cmd
+		m.reviewInput, cmd = m.reviewInput.Update(msg)
+		return m, cmd
+	}
+	return m, nil
+}
+
+// reviewDays returns the days of the current week up to today.
+func (m Model) reviewDays() []reviewDay {
+	now := m.now()
+	byDate := make(map[string]models.DayStats, len(m.weekStats.DailyStats))
+	for _, day := range m.weekStats.DailyStats {
+		byDate[day.Date] = day
+	}
+
+	var days []reviewDay
+	for day := models.WeekStart(now, m.storage.FirstWeekday()); !day.After(now); day = day.AddDate(0, 0, 1) {
+		stats := byDate[day.Format("2006-01-02")]
+		days = append(days, reviewDay{date: day, sessions: stats.SessionsCount, minutes: stats.TotalMinutes})
+	}
+	return days
+}
+
+func (m Model) renderReviewView() string {
+	containerStyle := lipgloss.NewStyle().
+		Width(m.width).
+		Height(m.height).
+		Padding(2)
+
+	titleStyle := lipgloss.NewStyle().
+		Bold(true).
+		Foreground(theme.Accent).
+		MarginBottom(1)
+
+	headingStyle := lipgloss.NewStyle().
+		Foreground(theme.Highlight).
+		MarginBottom(1)
+
+	helpStyle := lipgloss.NewStyle().
+		Foreground(theme.Faint).
+		MarginTop(2)
+
+	title := titleStyle.Render(fmt.Sprintf("🔁 Weekly Review - Week %d, %d (%d/4)", m.weekStats.Week, m.weekStats.Year, m.reviewStep+1))
+
+	var heading, body string
+	help := "enter: next • esc: back"
+	switch m.reviewStep {
+	case reviewTotals:
+		heading = "How the week went"
+		body = m.reviewTotalsText()
+		help = "enter: next • esc: leave"
+	case reviewDays:
+		heading = "Best and worst days"
+		body = m.reviewDaysText()
+	case reviewGoals:
+		heading = "Goals"
+		body = m.reviewGoalsText()
+	case reviewNote:
+		heading = "What went well, and what will you change next week?"
+		body = m.reviewInput.View()
+		help = "enter: save review • esc: back"
+	}
+
+	content := lipgloss.JoinVertical(
+		lipgloss.Left,
+		title,
+		headingStyle.Render(heading),
+		body,
+		helpStyle.Render(help),
+	)
+	return containerStyle.Render(content)
+}
+
+func (m Model) reviewTotalsText() string {
+	lines := []string{
+		fmt.Sprintf("%s • %s of focus", pluralSessions(m.weekStats.SessionsCount), models.FormatMinutes(m.weekStats.TotalMinutes)),
+		comparisonText(m.weekDelta, "week"),
+	}
+	if focus := avgFocusText(m.weekStats.AverageFocus); focus != "" {
+		lines = append(lines, focus)
+	}
+	if m.weekStats.Distractions > 0 {
+		lines = append(lines, pluralDistractions(m.weekStats.Distractions))
+	}
+	return strings.Join(lines, "\n")
+}
+
+func (m Model) reviewDaysText() string {
+	days := m.reviewDays()
+	best, worst := days[0], days[0]
+	for _, day := range days[1:] {
+		if day.minutes > best.minutes {
+			best = day
+		}
+		if day.minutes < worst.minutes {
+			worst = day
+		}
+	}
+
+	var lines []string
+	for _, day := range days {
+		lines = append(lines, fmt.Sprintf("%-9s %s • %s", day.date.Format("Monday"), pluralSessions(day.sessions), models.FormatMinutes(day.minutes)))
+	}
+	lines = append(lines, "")
+	if best.minutes == 0 {
+		lines = append(lines, "No focus time yet this week.")
+	} else {
+		lines = append(lines, fmt.Sprintf("Best: %s with %s", best.date.Format("Monday"), models.FormatMinutes(best.minutes)))
+		if len(days) > 1 {
+			lines = append(lines, fmt.Sprintf("Worst: %s with %s", worst.date.Format("Monday"), models.FormatMinutes(worst.minutes)))
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+func (m Model) reviewGoalsText() string {
+	var missed []string
+	met := 0
+	changed := false
+	for _, day := range m.reviewDays() {
+		// Judge each day by the goal in effect then
+		goal := m.config.GoalOn(day.date.Format("2006-01-02"))
+		changed = changed || goal != m.config.DailySessionGoal
+		if day.sessions >= goal {
+			met++
+		} else {
+			missed = append(missed, fmt.Sprintf("%s (%d/%d)", day.date.Format("Mon"), day.sessions, goal))
+		}
+	}
+
+	summary := fmt.Sprintf("Daily goal of %d sessions met on %d of %d days", m.config.DailySessionGoal, met, met+len(missed))
+	if changed {
+		summary = fmt.Sprintf("Daily goal met on %d of %d days (the goal changed this week)", met, met+len(missed))
+	}
+	lines := []string{summary}
+	if len(missed) > 0 {
+		lines = append(lines, "Missed: "+strings.Join(missed, ", "))
+	}
+	for _, target := range m.burndown {
+		if target.Minutes < target.Target {
+			lines = append(lines, fmt.Sprintf("%s: %s of %s", target.Project, models.FormatMinutes(target.Minutes), models.FormatMinutes(target.Target)))
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+func pluralSessions(n int) string {
+	if n == 1 {
+		return "1 session"
+	}
+	return fmt.Sprintf("%d sessions", n)
+}
+
+func newReviewInput() textinput.Model {
+	input := textinput.New()
+	input.Placeholder = "mornings worked, meetings broke up the afternoons"
+	input.CharLimit = 500
+	input.Width = 50
+	return input
+}
@@ -0,0 +1,50 @@
+package dashboard
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/adibhanna/focussessions/internal/insights"
+	"github.com/adibhanna/focussessions/internal/ui/layout"
+	"github.com/adibhanna/focussessions/internal/ui/theme"
+)
+
+// refreshSchedule recomputes the window in which focus quality peaks, used
+// to suggest when to put tomorrow's hardest session.
+func (m *Model) refreshSchedule() {
+	now := m.now()
+	to := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location()).AddDate(0, 0, 1)
+	from := to.AddDate(0, 0, -insightsWindowDays)
+
+	sessions, err := m.storage.GetSessionsInRange(from, to)
+	if err != nil {
+		return
+	}
+	m.peak, m.hasPeak = insights.PeakWindow(sessions)
+}
+
+// dayIsDone reports whether today's focus work is over: the work day has
+// ended or the goal is met, and no session is running.
+func (m Model) dayIsDone() bool {
+	if m.timerRunning {
+		return false
+	}
+	return m.now().Hour() >= m.config.WorkEndHour || m.todayStats.SessionsCount >= m.config.DailySessionGoal
+}
+
+// renderScheduleHint closes the day with a suggestion for tomorrow, e.g.
+// "🌙 Tomorrow: hardest session at 9am (focus peaks 9–11am)".
+func (m Model) renderScheduleHint() string {
+	if !m.hasPeak || !m.dayIsDone() {
+		return ""
+	}
+
+	return lipgloss.NewStyle().
+		Foreground(theme.Primary).
+		Align(lipgloss.Center).
+		Width(layout.Fit(60, layout.Inner(m.width, 4), 10)).
+		MarginTop(1).
+		Render(fmt.Sprintf("🌙 Tomorrow: hardest session at %s (focus peaks %s)", m.peak.StartLabel(), m.peak.Label()))
+}
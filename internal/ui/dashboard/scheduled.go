@@ -0,0 +1,66 @@
+package dashboard
+
+import (
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/adibhanna/focussessions/internal/models"
+	"github.com/adibhanna/focussessions/internal/ui/theme"
+)
+
+// scheduledMsg is sent when a scheduled session comes due.
+type scheduledMsg struct{ start models.ScheduledStart }
+
+// watchSchedule wakes the dashboard when the next scheduled session comes
+// due, if any are configured.
+func (m Model) watchSchedule() tea.Cmd {
+	start, ok := m.config.NextScheduledStart(m.now())
+	if !ok {
+		return nil
+	}
+	return tea.Tick(max(start.At.Sub(m.now()), 0), func(time.Time) tea.Msg {
+		return scheduledMsg{start: start}
+	})
+}
+
+// updateScheduled offers to start a session that came due, unless one is
+// already running, and waits for the next.
+func (m Model) updateScheduled(msg scheduledMsg) (tea.Model, tea.Cmd) {
+	if !m.timerRunning {
+		start := msg.start
+		m.scheduled = &start
+	}
+	return m, m.watchSchedule()
+}
+
+// updateScheduledOffer handles the keys of an offered scheduled session:
+// enter starts it and esc skips it. Other keys are not handled.
+func (m Model) updateScheduledOffer(msg tea.KeyMsg) (tea.Model, tea.Cmd, bool) {
+	switch msg.String() {
+	case "enter":
+		start := *m.scheduled
+		minutes := start.Duration
+		if minutes == 0 {
+			minutes = m.config.SessionDuration
+		}
+		next, cmd := m.startSession(&models.Session{Tag: start.Tag}, minutes)
+		return next, cmd, true
+
+	case "esc":
+		m.scheduled = nil
+		return m, nil, true
+	}
+	return m, nil, false
+}
+
+func (m Model) renderScheduledOffer() string {
+	if m.scheduled == nil || m.timerRunning {
+		return ""
+	}
+	return lipgloss.NewStyle().
+		Foreground(theme.Highlight).
+		Align(lipgloss.Center).
+		Render("⏰ Scheduled: " + m.scheduled.Label() + " • enter: start • esc: skip")
+}
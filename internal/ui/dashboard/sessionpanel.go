@@ -0,0 +1,100 @@
+package dashboard
+
+import (
+	"fmt"
+
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/adibhanna/focussessions/internal/models"
+	"github.com/adibhanna/focussessions/internal/ui/theme"
+)
+
+// sessionIcon marks how a session in the panel went: finished, running,
+// paused or stopped early.
+func sessionIcon(session models.Session) string {
+	switch {
+	case session.Active && session.Paused:
+		return "‖"
+	case session.Active:
+		return "▶"
+	case session.Completed:
+		return "✓"
+	}
+	return "✗"
+}
+
+// sessionPanelLine describes one of today's sessions as its icon, when it
+// ran and how many minutes it took. The running session counts the time on
+// the timer, which is ahead of what was last saved.
+func (m Model) sessionPanelLine(session models.Session) string {
+	if session.Active {
+		return fmt.Sprintf("%s %s–now %dm",
+			sessionIcon(session),
+			session.StartTime.Format("3:04pm"),
+			m.timerElapsed/60,
+		)
+	}
+	end := session.EndTime
+	if end.IsZero() {
+		end = session.StartTime
+	}
+	return fmt.Sprintf("%s %s–%s %dm",
+		sessionIcon(session),
+		session.StartTime.Format("3:04pm"),
+		end.Format("3:04pm"),
+		session.ActualMinutes(),
+	)
+}
+
+// panelSessions returns today's sessions, with the one on the timer last in
+// place of the copy saved when it started.
+func (m Model) panelSessions() []models.Session {
+	sessions := make([]models.Session, 0, len(m.todayStats.Sessions)+1)
+	for _, session := range m.todayStats.Sessions {
+		if m.activeSession != nil && session.ID == m.activeSession.ID {
+			continue
+		}
+		sessions = append(sessions, session)
+	}
+	if m.activeSession != nil {
+		sessions = append(sessions, *m.activeSession)
+	}
+	return sessions
+}
+
+// renderSessionPanel lists today's sessions in a box to sit beside the
+// timer, newest last, or returns "" when there are none yet.
+func (m Model) renderSessionPanel() string {
+	sessions := m.panelSessions()
+	if len(sessions) == 0 {
+		return ""
+	}
+
+	panelStyle := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(theme.Primary).
+		Padding(0, 1).
+		MarginLeft(4)
+
+	titleStyle := lipgloss.NewStyle().
+		Bold(true).
+		Foreground(theme.Accent).
+		MarginBottom(1)
+
+	rowStyle := lipgloss.NewStyle().
+		Foreground(theme.Muted)
+
+	rows := []string{titleStyle.Render("Today")}
+
+	// Keep the latest sessions when they don't all fit beside the timer
+	shown := sessions
+	if limit := max(m.height-20, 3); len(shown) > limit {
+		shown = shown[len(shown)-limit:]
+		rows = append(rows, rowStyle.Render(fmt.Sprintf("… %d earlier", len(sessions)-len(shown))))
+	}
+	for _, session := range shown {
+		rows = append(rows, rowStyle.Render(m.sessionPanelLine(session)))
+	}
+
+	return panelStyle.Render(lipgloss.JoinVertical(lipgloss.Left, rows...))
+}
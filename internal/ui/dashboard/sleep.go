@@ -0,0 +1,98 @@
+package dashboard
+
+import (
+	"fmt"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/adibhanna/focussessions/internal/models"
+	"github.com/adibhanna/focussessions/internal/ui/theme"
+)
+
+// sleepThreshold is the gap between ticks taken to mean the machine slept.
+const sleepThreshold = 2 * time.Minute
+
+// sleepGap is a stretch of a running session during which the machine
+// slept.
+type sleepGap struct {
+	from, to time.Time
+}
+
+// checkSleep notes a gap in the ticks of a running session, and reports
+// whether it opened the prompt asking what to do with it. Breaks aren't
+// asked about.
+func (m *Model) checkSleep() bool {
+	now, mono := m.now(), m.clock.Monotonic()
+	last, lastMono := m.lastTickAt, m.lastTickMono
+	m.lastTickAt, m.lastTickMono = now, mono
+	if last.IsZero() || m.onBreak || m.activeSession == nil || m.sleep != nil {
+		return false
+	}
+	// The monotonic clock stops while asleep and the wall clock doesn't, so
+	// the time asleep is how far the wall clock got ahead. A busy machine
+	// delaying ticks moves both.
+	asleep := now.Round(0).Sub(last.Round(0)) - (mono - lastMono)
+	if asleep < sleepThreshold {
+		return false
+	}
+	m.sleep = &sleepGap{from: now.Add(-asleep), to: now}
+	return true
+}
+
+// updateSleep resolves the sleep prompt: c counts the time asleep as
+// focus, d leaves it out, and p pauses the session from when it slept.
+func (m Model) updateSleep(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	gap := *m.sleep
+	switch msg.String() {
+	case "c", "esc":
+		// The monotonic clock stopped while asleep; the wall clock didn't
+		m.sleep = nil
+		m.timerElapsed = m.activeSession.ElapsedAt(m.now())
+		m.startRun()
+		m.saveSleepChoice()
+		return m, nil
+
+	case "d":
+		m.sleep = nil
+		m.activeSession.PausedSeconds += int(gap.to.Sub(gap.from).Seconds())
+		m.timerElapsed = m.activeSession.ElapsedAt(m.now())
+		m.startRun()
+		m.saveSleepChoice()
+		return m, nil
+
+	case "p":
+		m.sleep = nil
+		if m.strict() {
+			return m, m.refusePause()
+		}
+		m.activeSession.Pause(gap.from)
+		m.timerElapsed = m.activeSession.ElapsedAt(m.now())
+		m.timerPaused = true
+		m.saveSleepChoice()
+		return m, m.watchPause()
+	}
+	return m, nil
+}
+
+func (m *Model) saveSleepChoice() {
+	m.activeSession.ElapsedSeconds = m.timerElapsed
+	m.heartbeat()
+	m.storage.SaveSession(*m.activeSession)
+}
+
+func (m Model) renderSleep() string {
+	questionStyle := lipgloss.NewStyle().
+		Foreground(theme.Highlight)
+
+	helpStyle := lipgloss.NewStyle().
+		Foreground(theme.Faint).
+		MarginTop(1)
+
+	slept := models.FormatMinutes(int(m.sleep.to.Sub(m.sleep.from).Minutes()))
+	return lipgloss.NewStyle().MarginTop(2).Render(lipgloss.JoinVertical(lipgloss.Left,
+		questionStyle.Render(fmt.Sprintf("💤 Your computer slept for %s during this session.", slept)),
+		helpStyle.Render("c: count it • d: leave it out • p: pause from when it slept"),
+	))
+}
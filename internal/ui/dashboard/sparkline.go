@@ -0,0 +1,47 @@
+package dashboard
+
+import (
+	"time"
+
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/adibhanna/focussessions/internal/ui/chart"
+	"github.com/adibhanna/focussessions/internal/ui/theme"
+)
+
+// sparklineDays is how many days, including today, the home view's
+// sparkline covers.
+const sparklineDays = 14
+
+func (m *Model) refreshSparkline() {
+	now := m.now()
+	to := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location()).AddDate(0, 0, 1)
+	counts, err := m.storage.GetDailySessionCounts(to.AddDate(0, 0, -sparklineDays), to)
+	if err == nil {
+		m.recentCounts = counts
+	}
+}
+
+// renderSparkline shows completed sessions per day over the last two weeks,
+// today last. It is empty until a session has been completed in that time.
+func (m Model) renderSparkline() string {
+	values := make([]float64, len(m.recentCounts))
+	any := false
+	for i, c := range m.recentCounts {
+		values[i] = float64(c)
+		any = any || c > 0
+	}
+	if !any {
+		return ""
+	}
+
+	labelStyle := lipgloss.NewStyle().
+		Foreground(theme.Faint)
+
+	lineStyle := lipgloss.NewStyle().
+		Foreground(theme.Primary)
+
+	return lipgloss.NewStyle().Align(lipgloss.Center).Render(
+		labelStyle.Render("14d ") + lineStyle.Render(chart.Sparkline(values)) + labelStyle.Render(" today"),
+	)
+}
@@ -0,0 +1,37 @@
+package dashboard
+
+import (
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/adibhanna/focussessions/internal/models"
+	"github.com/adibhanna/focussessions/internal/speech"
+)
+
+// speakWarning is how many seconds before the end of a session the
+// "five minutes remaining" announcement is made.
+const speakWarning = 5 * 60
+
+// speak reads text aloud when the event is enabled in the config and
+// sounds may play.
+func (m Model) speak(event, text string) tea.Cmd {
+	if !m.config.Speech[event] || !m.config.SoundAt(m.now()) {
+		return nil
+	}
+	return func() tea.Msg {
+		speech.Say(text)
+		return nil
+	}
+}
+
+// speakCountdown announces that five minutes remain when the timer crosses
+// that mark between two ticks.
+func (m Model) speakCountdown(previous int) tea.Cmd {
+	if m.onBreak || m.timerDuration <= speakWarning {
+		return nil
+	}
+	mark := m.timerDuration - speakWarning
+	if previous < mark && m.timerElapsed >= mark {
+		return m.speak(models.SpeechFiveMinutesLeft, "Five minutes remaining")
+	}
+	return nil
+}
@@ -0,0 +1,25 @@
+package dashboard
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/adibhanna/focussessions/internal/models"
+	"github.com/adibhanna/focussessions/internal/outbox"
+)
+
+// cleanupStale settles an active session that should have ended long ago
+// and returns a note saying what happened to it, or "" when there was none.
+func cleanupStale(store Store, config models.Config, now time.Time) string {
+	session, err := store.CleanupStale(config, now)
+	if err != nil || session == nil {
+		return ""
+	}
+
+	started := session.StartTime.Local().Format("Jan 2 3:04pm")
+	if !session.Completed {
+		return fmt.Sprintf("Cancelled the session left open since %s", started)
+	}
+	outbox.Publish(store, config, outbox.EventSessionCompleted, *session, now)
+	return fmt.Sprintf("Completed the session left open since %s (%s)", started, models.FormatMinutes(session.ElapsedSeconds/60))
+}
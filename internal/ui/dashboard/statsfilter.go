@@ -0,0 +1,130 @@
+package dashboard
+
+import (
+	"fmt"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/adibhanna/focussessions/internal/models"
+	"github.com/adibhanna/focussessions/internal/ui/theme"
+)
+
+// openStatsFilter lists the filters the stats can be restricted to: every
+// session, then each tag and each project, starting at the one in use.
+func (m Model) openStatsFilter() (tea.Model, tea.Cmd) {
+	filters := []models.StatsFilter{{}}
+	if tags, err := m.storage.GetTags(); err == nil {
+		for _, tag := range tags {
+			if tag.Sessions > 0 {
+				filters = append(filters, models.StatsFilter{Tag: tag.Tag})
+			}
+		}
+	}
+	if projects, err := m.storage.GetProjects(); err == nil {
+		for _, project := range projects {
+			filters = append(filters, models.StatsFilter{Project: project})
+		}
+	}
+
+	m.statsFilters = filters
+	m.filterCursor = 0
+	current := m.storage.StatsFilter()
+	for i, filter := range filters {
+		if filter == current {
+			m.filterCursor = i
+		}
+	}
+	m.pickingFilter = true
+	return m, nil
+}
+
+// updateStatsFilter moves through the filters; enter applies the selected
+// one to every stats view, esc keeps the current one.
+func (m Model) updateStatsFilter(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "up", "k":
+		m.filterCursor = (m.filterCursor + len(m.statsFilters) - 1) % len(m.statsFilters)
+
+	case "down", "j":
+		m.filterCursor = (m.filterCursor + 1) % len(m.statsFilters)
+
+	case "esc":
+		m.pickingFilter = false
+
+	case "enter":
+		m.pickingFilter = false
+		m.setStatsFilter(m.statsFilters[m.filterCursor])
+	}
+	return m, nil
+}
+
+// setStatsFilter restricts the stats to filter and reloads them.
+func (m *Model) setStatsFilter(filter models.StatsFilter) {
+	if filter == m.storage.StatsFilter() {
+		return
+	}
+	m.storage.SetStatsFilter(filter)
+	m.refreshStats()
+}
+
+// clearStatsFilter counts every session again when leaving the stats, so
+// the home view's progress isn't filtered.
+func (m *Model) clearStatsFilter() {
+	m.setStatsFilter(models.StatsFilter{})
+}
+
+func (m Model) renderStatsFilter() string {
+	titleStyle := lipgloss.NewStyle().
+		Foreground(theme.Highlight).
+		MarginBottom(1)
+
+	itemStyle := lipgloss.NewStyle().
+		Foreground(theme.Muted)
+
+	selectedStyle := lipgloss.NewStyle().
+		Foreground(theme.Accent).
+		Bold(true)
+
+	helpStyle := lipgloss.NewStyle().
+		Foreground(theme.Faint).
+		MarginTop(1)
+
+	// Keep the cursor in view when there are more filters than rows
+	first, last := 0, len(m.statsFilters)
+	if rows := max(m.height-12, 3); last > rows {
+		first = min(max(m.filterCursor-rows/2, 0), last-rows)
+		last = first + rows
+	}
+
+	current := m.storage.StatsFilter()
+	rows := []string{titleStyle.Render("Show stats for")}
+	for i := first; i < last; i++ {
+		filter := m.statsFilters[i]
+		line := "  " + filter.String()
+		style := itemStyle
+		if i == m.filterCursor {
+			line = "▸" + line[1:]
+			style = selectedStyle
+		}
+		if filter == current {
+			line += " (current)"
+		}
+		rows = append(rows, style.Render(line))
+	}
+	rows = append(rows, helpStyle.Render("↑/↓: choose • enter: show • esc: cancel"))
+
+	return lipgloss.NewStyle().MarginTop(2).Render(lipgloss.JoinVertical(lipgloss.Left, rows...))
+}
+
+// renderFilterBadge notes above the help line that the stats only count
+// the sessions matching the filter.
+func (m Model) renderFilterBadge() string {
+	filter := m.storage.StatsFilter()
+	if filter.IsZero() {
+		return ""
+	}
+	return lipgloss.NewStyle().
+		Foreground(theme.Info).
+		Render(fmt.Sprintf("🔎 Only %s", filter))
+}
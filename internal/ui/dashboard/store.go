@@ -0,0 +1,67 @@
+package dashboard
+
+import (
+	"time"
+
+	"github.com/adibhanna/focussessions/internal/achievements"
+	"github.com/adibhanna/focussessions/internal/export"
+	"github.com/adibhanna/focussessions/internal/models"
+	"github.com/adibhanna/focussessions/internal/outbox"
+	"github.com/adibhanna/focussessions/internal/scripts"
+	"github.com/adibhanna/focussessions/internal/storage"
+	"github.com/adibhanna/focussessions/internal/ui/settings"
+)
+
+// Store is what the dashboard reads and saves its data through, including
+// what it hands on to settings, exports, scripts, achievements and the
+// outbox. storage.Storage is the one the app uses.
+type Store interface {
+	settings.Store
+	achievements.Store
+	export.Store
+	scripts.Store
+	outbox.Queue
+
+	SaveSession(session models.Session) error
+	SaveSessionProgress(session models.Session) error
+	GetActiveSession() (*models.Session, error)
+	GetContinueSuggestion() (*models.Session, error)
+	DeactivateAllSessions() error
+	ExpirePaused(config models.Config, now time.Time) (*models.Session, string, error)
+	CleanupStale(config models.Config, now time.Time) (*models.Session, error)
+	CheckHandoff(id, host string, now time.Time) error
+
+	GetYearStats(year int) (models.YearStats, error)
+	StatsFilter() models.StatsFilter
+	SetStatsFilter(filter models.StatsFilter)
+	GetDailySessionCounts(from, to time.Time) ([]int, error)
+	GetYearInReview(year int) (models.YearInReview, error)
+	GetRangeStats(first, last time.Time) (models.RangeStats, error)
+	GetPaceStats(now time.Time) (models.PaceStats, error)
+	GetHourlyMinutes(from, to time.Time) ([24]int, error)
+	CompareWeek(year, week int) (models.Comparison, error)
+	CompareMonth(year, month int) (models.Comparison, error)
+	SetOffDays(first, last time.Time, reason string) error
+	ClearOffDays(first, last time.Time) error
+
+	SaveWeekReview(year, week int, note string) error
+	GetWeekReview(year, week int) (string, error)
+	SaveJournal(date, note string) error
+	GetJournal(date string) (string, error)
+	SaveTasks(tasks []models.Task) error
+	GetTaskProgress() ([]models.TaskProgress, error)
+	GetTags() ([]storage.TagUsage, error)
+	GetProjects() ([]string, error)
+	GetProjectBurndown(now time.Time) ([]models.ProjectBurndown, error)
+	SaveDayPlan(plan models.DayPlan) error
+	GetPlanProgress(date string) (models.PlanProgress, error)
+	GetMessages() ([]string, error)
+
+	Profile() string
+	ScriptsDir() string
+	PluginsDir() string
+	HooksDir() string
+	BlockStatePath() string
+}
+
+var _ Store = (*storage.Storage)(nil)
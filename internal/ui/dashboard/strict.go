@@ -0,0 +1,123 @@
+package dashboard
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/adibhanna/focussessions/internal/ui/theme"
+)
+
+// strictWord has to be typed to cancel a strict session.
+const strictWord = "abandon"
+
+func newStrictInput() textinput.Model {
+	input := textinput.New()
+	input.CharLimit = 20
+	input.Width = 20
+	return input
+}
+
+// strict reports whether the running session is strict: it can't be paused
+// and cancelling it takes typing strictWord.
+func (m Model) strict() bool {
+	return m.timerRunning && !m.onBreak && m.activeSession != nil && m.activeSession.Strict
+}
+
+// toggleStrict makes the running session strict, or toggles strict mode for
+// the sessions started next. A strict session stays strict until it ends.
+func (m *Model) toggleStrict() tea.Cmd {
+	if !m.timerRunning || m.onBreak || m.activeSession == nil {
+		m.nextStrict = !m.nextStrict
+		return nil
+	}
+	if m.activeSession.Strict {
+		m.toast = "🔒 A strict session stays strict until it ends"
+		return clearToastAfter()
+	}
+
+	m.activeSession.Strict = true
+	if m.timerPaused {
+		// Strict sessions don't pause, so pick up where it left off
+		m.timerPaused = false
+		m.activeSession.Unpause(m.now())
+		m.startRun()
+	}
+	m.syncElapsed()
+	m.activeSession.ElapsedSeconds = m.timerElapsed
+	m.heartbeat()
+	m.storage.SaveSession(*m.activeSession)
+	return tickCmd()
+}
+
+// refusePause explains why a strict session keeps running.
+func (m *Model) refusePause() tea.Cmd {
+	m.toast = "🔒 Strict session: no pausing until it ends"
+	return clearToastAfter()
+}
+
+// openStrictCancel asks for strictWord before cancelling a strict session.
+// The timer keeps running while the prompt is open.
+func (m Model) openStrictCancel() (tea.Model, tea.Cmd) {
+	m.cancellingStrict = true
+	m.strictMismatch = false
+	m.strictInput.SetValue("")
+	return m, m.strictInput.Focus()
+}
+
+func (m Model) updateStrictCancel(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "esc":
+		m.cancellingStrict = false
+		m.strictInput.Blur()
+		return m, nil
+
+	case "enter":
+		if !strings.EqualFold(strings.TrimSpace(m.strictInput.Value()), strictWord) {
+			m.strictMismatch = true
+			m.strictInput.SetValue("")
+			return m, nil
+		}
+		m.cancellingStrict = false
+		m.strictInput.Blur()
+		return m.cancelSession()
+	}
+
+	var cmd tea.Cmd
+	m.strictInput, cmd = m.strictInput.Update(msg)
+	return m, cmd
+}
+
+func (m Model) renderStrictCancel() string {
+	questionStyle := lipgloss.NewStyle().
+		Foreground(theme.Highlight)
+
+	mismatchStyle := lipgloss.NewStyle().
+		Foreground(theme.Danger)
+
+	helpStyle := lipgloss.NewStyle().
+		Foreground(theme.Faint).
+		MarginTop(1)
+
+	parts := []string{
+		questionStyle.Render(fmt.Sprintf("🔒 Strict session. Type %q to cancel it:", strictWord)),
+		m.strictInput.View(),
+	}
+	if m.strictMismatch {
+		parts = append(parts, mismatchStyle.Render("That's not it. Keep going, or try again."))
+	}
+	parts = append(parts, helpStyle.Render("enter: cancel session • esc: keep focusing"))
+
+	return lipgloss.NewStyle().MarginTop(2).Render(lipgloss.JoinVertical(lipgloss.Left, parts...))
+}
+
+// strictBadge marks strict sessions, and is empty for others.
+func strictBadge(strict bool) string {
+	if strict {
+		return "🔒 strict"
+	}
+	return ""
+}
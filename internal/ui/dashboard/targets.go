@@ -0,0 +1,54 @@
+package dashboard
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/adibhanna/focussessions/internal/models"
+	"github.com/adibhanna/focussessions/internal/ui/theme"
+)
+
+func (m *Model) refreshBurndown() {
+	burndown, err := m.storage.GetProjectBurndown(m.now())
+	if err == nil {
+		m.burndown = burndown
+	}
+}
+
+// renderBurndown lists progress towards each weekly project target, e.g.
+// "6h of 10h on thesis, 2 days left".
+func (m Model) renderBurndown() string {
+	if len(m.burndown) == 0 {
+		return ""
+	}
+
+	titleStyle := lipgloss.NewStyle().
+		Bold(true).
+		Foreground(theme.Highlight).
+		MarginTop(1)
+
+	var lines []string
+	lines = append(lines, titleStyle.Render("🎯 Weekly Project Targets"))
+	for _, b := range m.burndown {
+		color := theme.Muted
+		status := fmt.Sprintf("%s left", pluralDays(b.DaysLeft))
+		if b.Remaining() == 0 {
+			color = theme.Success
+			status = "target reached"
+		}
+
+		line := fmt.Sprintf("%s of %s on %s, %s",
+			models.FormatMinutes(b.Minutes), models.FormatMinutes(b.Target), b.Project, status)
+		lines = append(lines, lipgloss.NewStyle().Foreground(color).Render(line))
+	}
+	return strings.Join(lines, "\n")
+}
+
+func pluralDays(n int) string {
+	if n == 1 {
+		return "1 day"
+	}
+	return fmt.Sprintf("%d days", n)
+}
@@ -0,0 +1,235 @@
+package dashboard
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/google/uuid"
+
+	"github.com/adibhanna/focussessions/internal/models"
+	"github.com/adibhanna/focussessions/internal/ui/theme"
+)
+
+// maxEstimate caps the pomodoros a task can be estimated at.
+const maxEstimate = 20
+
+func (m *Model) refreshTasks() {
+	tasks, err := m.storage.GetTaskProgress()
+	if err == nil {
+		m.tasks = tasks
+	}
+	if m.taskCursor >= len(m.tasks) {
+		m.taskCursor = max(len(m.tasks)-1, 0)
+	}
+}
+
+func (m Model) openTasks() (tea.Model, tea.Cmd) {
+	m.viewState = TasksView
+	m.refreshTasks()
+	return m, nil
+}
+
+// saveTasks stores the edited task list and reloads its progress.
+func (m *Model) saveTasks(tasks []models.Task) {
+	m.storage.SaveTasks(tasks)
+	m.refreshTasks()
+}
+
+// updateTasks handles keys in the task list. It reports false for keys it
+// leaves to the global bindings.
+func (m Model) updateTasks(msg tea.KeyMsg) (tea.Model, tea.Cmd, bool) {
+	tasks := make([]models.Task, len(m.tasks))
+	for i, progress := range m.tasks {
+		tasks[i] = progress.Task
+	}
+
+	if m.addingTask {
+		switch msg.String() {
+		case "enter":
+			if title := strings.TrimSpace(m.taskInput.Value()); title != "" {
+				tasks = append(tasks, models.Task{ID: uuid.New().String(), Title: title, CreatedAt: m.now()})
+				m.saveTasks(tasks)
+				m.taskCursor = len(tasks) - 1
+			}
+			fallthrough
+		case "esc":
+			m.addingTask = false
+			m.taskInput.Blur()
+			return m, nil, true
+		}
+		var cmd tea.Cmd
+		m.taskInput, cmd = m.taskInput.Update(msg)
+		return m, cmd, true
+	}
+
+	hasTask := m.taskCursor < len(tasks)
+
+	switch msg.String() {
+	case "up", "k":
+		if m.taskCursor > 0 {
+			m.taskCursor--
+		}
+	case "down", "j":
+		if m.taskCursor < len(tasks)-1 {
+			m.taskCursor++
+		}
+	case "a":
+		m.addingTask = true
+		m.taskInput.SetValue("")
+		return m, m.taskInput.Focus(), true
+	case "+", "=":
+		if hasTask {
+			tasks[m.taskCursor].Estimate = min(tasks[m.taskCursor].Estimate+1, maxEstimate)
+			m.saveTasks(tasks)
+		}
+	case "-":
+		if hasTask {
+			tasks[m.taskCursor].Estimate = max(tasks[m.taskCursor].Estimate-1, 0)
+			m.saveTasks(tasks)
+		}
+	case " ":
+		if hasTask {
+			task := &tasks[m.taskCursor]
+			task.Done = !task.Done
+			task.DoneAt = time.Time{}
+			if task.Done {
+				task.DoneAt = m.now()
+			}
+			m.saveTasks(tasks)
+		}
+	case "x", "delete":
+		if hasTask {
+			tasks = append(tasks[:m.taskCursor], tasks[m.taskCursor+1:]...)
+			m.saveTasks(tasks)
+		}
+	case "enter":
+		// Start a session against the task
+		if hasTask && !tasks[m.taskCursor].Done && !m.timerRunning {
+			task := tasks[m.taskCursor]
+			m.viewState = HomeView
+			next, cmd := m.startSession(&models.Session{TaskID: task.ID, Intention: task.Title}, m.config.SessionDuration)
+			return next, cmd, true
+		}
+	case "esc", "b", "h":
+		m.viewState = HomeView
+	default:
+		return m, nil, false
+	}
+	return m, nil, true
+}
+
+func (m Model) renderTasksView() string {
+	containerStyle := lipgloss.NewStyle().
+		Width(m.width).
+		Height(m.height).
+		Padding(2)
+
+	titleStyle := lipgloss.NewStyle().
+		Bold(true).
+		Foreground(theme.Accent).
+		MarginBottom(1)
+
+	summaryStyle := lipgloss.NewStyle().
+		Foreground(theme.Highlight).
+		MarginBottom(1)
+
+	doneStyle := lipgloss.NewStyle().
+		Foreground(theme.Success)
+
+	selectedStyle := lipgloss.NewStyle().
+		Foreground(theme.Primary).
+		Bold(true)
+
+	emptyStyle := lipgloss.NewStyle().
+		Foreground(theme.Muted)
+
+	helpStyle := lipgloss.NewStyle().
+		Foreground(theme.Faint).
+		MarginTop(2)
+
+	title := titleStyle.Render("✅ Tasks")
+
+	var rows []string
+	if len(m.tasks) == 0 {
+		rows = append(rows, emptyStyle.Render("No tasks yet. Press 'a' to add one."))
+	} else {
+		rows = append(rows, summaryStyle.Render(m.taskSummary()))
+	}
+	for i, task := range m.tasks {
+		mark := "[ ]"
+		if task.Done {
+			mark = "[✓]"
+		}
+		line := fmt.Sprintf("%s %s  🍅 %s", mark, task.Title, pomodoroCount(task))
+		if task.FocusMinutes > 0 {
+			line += " • " + models.FormatMinutes(task.FocusMinutes)
+		}
+
+		switch {
+		case i == m.taskCursor:
+			line = selectedStyle.Render("> " + line)
+		case task.Done:
+			line = "  " + doneStyle.Render(line)
+		default:
+			line = "  " + line
+		}
+		rows = append(rows, line)
+	}
+
+	help := "a: add • enter: start session • +/-: estimate • space: done • x: remove • ↑/↓: select • b: back"
+	if m.addingTask {
+		rows = append(rows, "", "Task: "+m.taskInput.View())
+		help = "enter: add task • esc: cancel"
+	}
+
+	content := lipgloss.JoinVertical(
+		lipgloss.Left,
+		title,
+		lipgloss.JoinVertical(lipgloss.Left, rows...),
+		helpStyle.Render(help),
+	)
+	return containerStyle.Render(content)
+}
+
+// taskSummary describes the list, e.g. "3 open • 1 done • 6/9 pomodoros
+// on estimated tasks".
+func (m Model) taskSummary() string {
+	open, done, actual, estimate := 0, 0, 0, 0
+	for _, task := range m.tasks {
+		if task.Done {
+			done++
+		} else {
+			open++
+		}
+		if task.Estimate > 0 {
+			actual += task.Pomodoros
+			estimate += task.Estimate
+		}
+	}
+	summary := fmt.Sprintf("%d open • %d done", open, done)
+	if estimate > 0 {
+		summary += fmt.Sprintf(" • %d/%d pomodoros on estimated tasks", actual, estimate)
+	}
+	return summary
+}
+
+// pomodoroCount shows the sessions spent on a task against its estimate,
+// e.g. "2/4", or just the sessions when it has none.
+func pomodoroCount(task models.TaskProgress) string {
+	if task.Estimate == 0 {
+		return fmt.Sprint(task.Pomodoros)
+	}
+	return fmt.Sprintf("%d/%d", task.Pomodoros, task.Estimate)
+}
+
+func newTaskInput() textinput.Model {
+	input := textinput.New()
+	input.Placeholder = "Write chapter 3"
+	input.CharLimit = 80
+	input.Width = 40
+	return input
+}
@@ -0,0 +1,98 @@
+package dashboard
+
+import (
+	"fmt"
+	"os"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// appTitle names the terminal window, after the countdown while a timer
+// runs.
+const appTitle = "Focus Sessions"
+
+// windowTitle shows the time left in the terminal tab or taskbar, e.g.
+// "⏳ 23:45 — Focus Sessions".
+func (m Model) windowTitle() string {
+	if !m.timerRunning {
+		return appTitle
+	}
+	icon := "⏳"
+	switch {
+	case m.timerPaused:
+		icon = "⏸"
+	case m.onBreak:
+		icon = "☕"
+	}
+	remaining := max(m.timerDuration-m.timerElapsed, 0)
+	return fmt.Sprintf("%s %02d:%02d — %s", icon, remaining/60, remaining%60, appTitle)
+}
+
+// States of the OSC 9;4 progress sequence understood by Windows Terminal,
+// WezTerm and ConEmu
+const (
+	progressHidden = 0
+	progressNormal = 1
+	progressPaused = 4
+)
+
+// taskbarProgress returns the escape sequence showing how far the timer
+// is on the terminal's taskbar icon, or one hiding it when no timer runs
+// or the dashboard is about to quit. It is empty with TaskbarProgress off.
+func (m Model) taskbarProgress() string {
+	if !m.config.TaskbarProgress {
+		return ""
+	}
+	state, percent := progressHidden, 0
+	if m.timerRunning && !m.leaving() && m.timerDuration > 0 {
+		state = progressNormal
+		if m.timerPaused {
+			state = progressPaused
+		}
+		percent = min(m.timerElapsed*100/m.timerDuration, 100)
+	}
+	return fmt.Sprintf("\x1b]9;4;%d;%d\x07", state, percent)
+}
+
+// leaving reports whether the dashboard is quitting, to exit the program
+// or to open the settings or another profile.
+func (m Model) leaving() bool {
+	return m.shouldQuit || m.openSettings || m.switchProfile != ""
+}
+
+// Update handles msg and keeps the terminal title, taskbar progress and
+// MQTT state in step with the timer, updating them only when they change.
+func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	next, cmd := m.update(msg)
+	updated, ok := next.(Model)
+	if !ok {
+		return next, cmd
+	}
+
+	if title := updated.windowTitle(); title != updated.title {
+		updated.title = title
+		cmd = tea.Batch(cmd, tea.SetWindowTitle(title))
+	}
+	if progress := updated.taskbarProgress(); progress != updated.progress {
+		updated.progress = progress
+		write := func() tea.Msg {
+			// stderr keeps the sequence out of the way of the renderer
+			fmt.Fprint(os.Stderr, progress)
+			return nil
+		}
+		// Hide the progress before quitting, not alongside it
+		cmd = tea.Sequence(write, cmd)
+	}
+	if key := updated.mqttKey(); key != updated.published {
+		updated.published = key
+		switch {
+		case key == "":
+		case updated.leaving():
+			// Likewise publish idle before quitting
+			cmd = tea.Sequence(updated.publishState(), cmd)
+		default:
+			cmd = tea.Batch(cmd, updated.publishState())
+		}
+	}
+	return updated, cmd
+}
@@ -0,0 +1,134 @@
+package dashboard
+
+import (
+	"fmt"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/adibhanna/focussessions/internal/ui/theme"
+)
+
+// demoMinutes is the length of the tour's demo session.
+const demoMinutes = 1
+
+// tourStep is how far the onboarding tour has got.
+type tourStep int
+
+const (
+	tourNone    tourStep = iota
+	tourWelcome          // Explains the home view
+	tourDemo             // Offers the demo session
+	tourRunning          // While the demo session runs, and after it
+	tourStats            // Shows the stats overview
+)
+
+// StartTour makes the dashboard open with the onboarding tour, for a first
+// launch.
+func (m Model) StartTour() Model {
+	m.tour = tourWelcome
+	return m
+}
+
+// updateTour moves the tour on with enter and ends it with esc; other keys
+// work as usual while it is shown.
+func (m Model) updateTour(msg tea.KeyMsg) (tea.Model, tea.Cmd, bool) {
+	switch msg.String() {
+	case "esc":
+		return m.endTour("Tour skipped • ? shows the keys of any view"), clearToastAfter(), true
+
+	case "enter":
+		switch m.tour {
+		case tourWelcome:
+			m.tour = tourDemo
+			return m, nil, true
+
+		case tourDemo:
+			m.tour = tourRunning
+			if m.timerRunning {
+				return m, nil, true
+			}
+			next, cmd := m.startSession(nil, demoMinutes)
+			return next, cmd, true
+
+		case tourRunning:
+			m.tour = tourStats
+			m.openStats()
+			return m, nil, true
+
+		case tourStats:
+			return m.endTour("You're all set! s starts a session, g opens the settings"), clearToastAfter(), true
+		}
+	}
+	return m, nil, false
+}
+
+// endTour closes the tour on the home view with note as a toast.
+func (m Model) endTour(note string) Model {
+	m.tour = tourNone
+	m.viewState = HomeView
+	m.clearStatsFilter()
+	m.toast = note
+	return m
+}
+
+// tourText returns the title and explanation of the current step.
+func (m Model) tourText() (string, string) {
+	switch m.tour {
+	case tourWelcome:
+		return "Welcome to Focus Sessions!", fmt.Sprintf(
+			"This is the home view: the timer of the session you're in, and today's sessions against your goal of %d. The bar at the bottom lists the keys you can press; ? shows all of them.",
+			m.config.DailySessionGoal,
+		)
+	case tourDemo:
+		return "Try a session", fmt.Sprintf(
+			"Press enter to start a %d-minute demo session. It counts like any other, so it will be the first in your stats.",
+			demoMinutes,
+		)
+	case tourRunning:
+		if m.timerRunning && !m.onBreak {
+			text := "p pauses it and c cancels it."
+			if m.config.Reflect {
+				text += " When it ends you're asked how focused you were."
+			}
+			return "Your demo session is running", text + " Wait for it, or press enter for the stats now."
+		}
+		return "Session over", "That's your first session. Press enter to see it in the stats."
+	case tourStats:
+		return "Your stats", "Today, this week and this month at a glance. d, w, m and y open the details, and t comes back here from the home view."
+	}
+	return "", ""
+}
+
+// renderTour draws the current view with the tour's card under it.
+func (m Model) renderTour() string {
+	cardStyle := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(theme.Primary).
+		Padding(0, 1).
+		Width(max(m.width-2, 10))
+
+	titleStyle := lipgloss.NewStyle().
+		Bold(true).
+		Foreground(theme.Accent)
+
+	textStyle := lipgloss.NewStyle().
+		Foreground(theme.Body)
+
+	helpStyle := lipgloss.NewStyle().
+		Foreground(theme.Faint)
+
+	title, text := m.tourText()
+	card := cardStyle.Render(lipgloss.JoinVertical(
+		lipgloss.Left,
+		titleStyle.Render(fmt.Sprintf("%s (%d/4)", title, m.tour)),
+		textStyle.Render(text),
+		helpStyle.Render("enter: next • esc: skip the tour"),
+	))
+
+	// The view gives up the rows the card takes
+	view := m
+	view.tour = tourNone
+	view.height = max(m.height-lipgloss.Height(card), 1)
+	return lipgloss.JoinVertical(lipgloss.Left, view.View(), card)
+}
@@ -0,0 +1,104 @@
+package dashboard
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/adibhanna/focussessions/internal/models"
+	"github.com/adibhanna/focussessions/internal/ui/chart"
+	"github.com/adibhanna/focussessions/internal/ui/layout"
+	"github.com/adibhanna/focussessions/internal/ui/theme"
+)
+
+// loadTrend loads the minutes per day charted in the monthly and yearly
+// details, from from's day up to the end of period or today, whichever
+// comes first.
+func (m *Model) loadTrend(from, end time.Time) {
+	now := m.now()
+	tomorrow := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location()).AddDate(0, 0, 1)
+	if tomorrow.Before(end) {
+		end = tomorrow
+	}
+
+	minutes, err := m.storage.GetDailyMinutes(from, end)
+	if err != nil {
+		minutes = nil
+	}
+	m.trend = minutes
+	m.trendFrom = from
+}
+
+func (m *Model) loadMonthTrend() {
+	now := m.statsDay()
+	from := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, now.Location())
+	m.loadTrend(from, from.AddDate(0, 1, 0))
+}
+
+func (m *Model) loadYearTrend() {
+	now := m.statsDay()
+	from := time.Date(now.Year(), time.January, 1, 0, 0, 0, 0, now.Location())
+	m.loadTrend(from, from.AddDate(1, 0, 0))
+}
+
+// renderTrend charts minutes per day, with the first and last day under the
+// chart and the peak and average beside it.
+func (m Model) renderTrend() string {
+	if len(m.trend) == 0 {
+		return ""
+	}
+
+	titleStyle := lipgloss.NewStyle().
+		Foreground(theme.Highlight).
+		MarginTop(1)
+
+	chartStyle := lipgloss.NewStyle().
+		Foreground(theme.Primary).
+		PaddingLeft(2)
+
+	axisStyle := lipgloss.NewStyle().
+		Foreground(theme.Faint).
+		PaddingLeft(2)
+
+	values := make([]float64, len(m.trend))
+	peak, peakDay, total := 0, 0, 0
+	for i, v := range m.trend {
+		values[i] = float64(v)
+		total += v
+		if v > peak {
+			peak, peakDay = v, i
+		}
+	}
+
+	width := layout.Fit(len(values), layout.Inner(m.width, 2)-2, 10)
+	height := 4
+	if layout.Compact(m.height) {
+		height = 2
+	}
+	rows := chart.Bars(values, width, height)
+	chartWidth := lipgloss.Width(rows[0])
+
+	first := m.trendFrom.Format("Jan 2")
+	last := m.trendFrom.AddDate(0, 0, len(m.trend)-1).Format("Jan 2")
+	axis := first
+	if gap := chartWidth - len(first) - len(last); gap > 0 {
+		axis += fmt.Sprintf("%*s", gap+len(last), last)
+	}
+
+	caption := "Minutes per day"
+	if peak > 0 {
+		caption = fmt.Sprintf("Minutes per day • peak %s (%s) • avg %s",
+			models.FormatMinutes(peak),
+			m.trendFrom.AddDate(0, 0, peakDay).Format("Jan 2"),
+			models.FormatMinutes(total/len(m.trend)),
+		)
+	}
+
+	parts := []string{titleStyle.Render(caption)}
+	for _, row := range rows {
+		parts = append(parts, chartStyle.Render(row))
+	}
+	parts = append(parts, axisStyle.Render(axis))
+	return lipgloss.JoinVertical(lipgloss.Left, parts...)
+}
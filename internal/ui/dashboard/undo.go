@@ -0,0 +1,119 @@
+package dashboard
+
+import (
+	"time"
+
+	"github.com/adibhanna/focussessions/internal/models"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// undoWindow is how long a cancelled or completed session can be undone.
+const undoWindow = 30 * time.Second
+
+// Session actions that can be undone
+const (
+	undoCancel   = "cancelled"
+	undoComplete = "completed"
+)
+
+// undoEntry is an undoable action, holding the session as it was before
+// the action for a cancel and as it was saved for a completion. resume is
+// set when a cancelled session was running rather than paused.
+type undoEntry struct {
+	seq     int
+	action  string
+	session models.Session
+	resume  bool
+}
+
+// undoExpiredMsg drops the undo entry seq once undoWindow has passed.
+type undoExpiredMsg struct{ seq int }
+
+// pushUndo makes entry undoable for undoWindow.
+func (m *Model) pushUndo(entry undoEntry) tea.Cmd {
+	m.undoSeq++
+	seq := m.undoSeq
+	entry.seq = seq
+	m.undoStack = append(m.undoStack, entry)
+	return tea.Tick(undoWindow, func(time.Time) tea.Msg {
+		return undoExpiredMsg{seq: seq}
+	})
+}
+
+func (m Model) updateUndoExpired(msg undoExpiredMsg) (tea.Model, tea.Cmd) {
+	stack := make([]undoEntry, 0, len(m.undoStack))
+	for _, entry := range m.undoStack {
+		if entry.seq != msg.seq {
+			stack = append(stack, entry)
+		}
+	}
+	m.undoStack = stack
+	return m, nil
+}
+
+// undo takes back the latest undoable action. A cancelled session picks up
+// where it stopped, which waits until no other timer is running. A completed
+// session stops counting as completed.
+func (m Model) undo() (tea.Model, tea.Cmd) {
+	if len(m.undoStack) == 0 {
+		return m, nil
+	}
+	entry := m.undoStack[len(m.undoStack)-1]
+	if entry.action == undoCancel && m.timerRunning {
+		m.toast = "Finish or cancel the running session to bring back the cancelled one"
+		return m, clearToastAfter()
+	}
+	m.undoStack = m.undoStack[:len(m.undoStack)-1]
+
+	session := entry.session
+	var cmd tea.Cmd
+	switch entry.action {
+	case undoCancel:
+		if entry.resume {
+			session.Unpause(m.now())
+		}
+		m.activeSession = &session
+		m.heartbeat()
+		m.storage.SaveSession(session)
+		m.timerRunning = true
+		m.timerPaused = session.Paused
+		m.timerDuration = session.Duration * 60
+		m.timerElapsed = session.ElapsedAt(m.now())
+		m.startRun()
+		cmd = m.blockSites()
+		if !m.timerPaused {
+			cmd = tea.Batch(cmd, tickCmd())
+		}
+
+	case undoComplete:
+		session.Completed = false
+		m.storage.SaveSession(session)
+		m.toast = "Session no longer counts as completed"
+		cmd = clearToastAfter()
+	}
+
+	todayStats, _ := m.storage.GetDayStats(m.now().Format("2006-01-02"))
+	m.todayStats = todayStats
+	weekYear, week := m.storage.WeekOf(m.now())
+	m.weekStats, _ = m.storage.GetWeekStats(weekYear, week)
+	m.suggestion, _ = m.storage.GetContinueSuggestion()
+	m.refreshPace()
+	m.refreshBurndown()
+	m.refreshPlan()
+	m.refreshSparkline()
+	m.refreshStreak()
+	return m, cmd
+}
+
+// undoNote offers to undo the latest action, and is empty when there's
+// nothing to undo.
+func (m Model) undoNote() string {
+	if len(m.undoStack) == 0 {
+		return ""
+	}
+	entry := m.undoStack[len(m.undoStack)-1]
+	if entry.action == undoCancel && m.timerRunning {
+		return ""
+	}
+	return "Session " + entry.action + " • U: undo"
+}
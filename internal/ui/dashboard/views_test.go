@@ -0,0 +1,347 @@
+package dashboard
+
+import (
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/adibhanna/focussessions/internal/clock"
+	"github.com/adibhanna/focussessions/internal/models"
+	"github.com/adibhanna/focussessions/internal/storage"
+	"github.com/adibhanna/focussessions/internal/ui/golden"
+)
+
+// fixedNow is the date every view is rendered at: a Wednesday afternoon.
+var fixedNow = time.Date(2025, time.March, 12, 15, 4, 0, 0, time.UTC)
+
+func TestMain(m *testing.M) {
+	time.Local = time.UTC
+	os.Exit(m.Run())
+}
+
+// newTestModel returns a dashboard over a fresh data directory holding a
+// few sessions from the fixed week, with its clock stopped at fixedNow.
+func newTestModel(t *testing.T) Model {
+	t.Helper()
+	store, err := storage.Open(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for i, day := range []int{10, 11, 12, 12} {
+		start := time.Date(2025, time.March, day, 9+i, 0, 0, 0, time.UTC)
+		session := models.Session{
+			ID:             fmt.Sprintf("session-%d", i),
+			StartTime:      start,
+			EndTime:        start.Add(60 * time.Minute),
+			Duration:       60,
+			Completed:      i != 1,
+			Date:           start.Format("2006-01-02"),
+			Month:          start.Format("2006-01"),
+			Year:           start.Year(),
+			ElapsedSeconds: 3600,
+			Tag:            "writing",
+			Focus:          []int{4, 0, 5, 3}[i],
+			Distractions:   []int{1, 0, 0, 2}[i],
+			Intensity:      []string{"", "", models.IntensityDeep, models.IntensityLight}[i],
+		}
+		if i == 3 {
+			session.Notes = "kept getting pinged"
+			session.Interruptions = []models.Interruption{
+				{At: start.Add(10 * time.Minute), Reason: "slack"},
+				{At: start.Add(25 * time.Minute), Reason: "doorbell"},
+				{At: start.Add(40 * time.Minute), Reason: "Slack"},
+			}
+		}
+		session.Week = getWeekNumber(start)
+		if err := store.SaveSession(session); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	m, err := New(store, clock.NewManual(fixedNow))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return m
+}
+
+func press(t *testing.T, m Model, keys ...string) Model {
+	t.Helper()
+	for _, k := range keys {
+		msg := tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(k)}
+		switch k {
+		case "enter":
+			msg = tea.KeyMsg{Type: tea.KeyEnter}
+		case "esc":
+			msg = tea.KeyMsg{Type: tea.KeyEsc}
+		case "left":
+			msg = tea.KeyMsg{Type: tea.KeyLeft}
+		case "down":
+			msg = tea.KeyMsg{Type: tea.KeyDown}
+		case "ctrl+p":
+			msg = tea.KeyMsg{Type: tea.KeyCtrlP}
+		}
+		next, _ := m.Update(msg)
+		m = next.(Model)
+	}
+	return m
+}
+
+// testClock returns the manual clock newTestModel gave m.
+func testClock(m Model) *clock.Manual {
+	return m.clock.(*clock.Manual)
+}
+
+// advance moves the clock on by d, ticking the timer every minute as it
+// would while running.
+func advance(m Model, d time.Duration) Model {
+	for d > 0 {
+		step := min(int(d), int(time.Minute))
+		d -= time.Duration(step)
+		next, _ := m.Update(tickMsg(testClock(m).Advance(time.Duration(step))))
+		m = next.(Model)
+	}
+	return m
+}
+
+// step moves the wall clock by d while the monotonic clock stands still,
+// as when the machine sleeps or the time is set, and ticks the timer.
+func step(m Model, d time.Duration) Model {
+	next, _ := m.Update(tickMsg(testClock(m).Step(d)))
+	return next.(Model)
+}
+
+// idle moves the clock on by d with a single tick at the end, as when the
+// dashboard is left open with no timer running.
+func idle(m Model, d time.Duration) Model {
+	next, _ := m.Update(tickMsg(testClock(m).Advance(d)))
+	return next.(Model)
+}
+
+func TestViews(t *testing.T) {
+	t.Parallel()
+	views := []struct {
+		name string
+		keys []string
+	}{
+		{"home", nil},
+		{"running", []string{"s"}},
+		{"break", []string{"a"}},
+		{"zen", []string{"z"}},
+		{"keys", []string{"?"}},
+		{"runningkeys", []string{"s", "?"}},
+		{"palette", []string{"ctrl+p"}},
+		{"palettesearch", []string{"ctrl+p", "stats", "down"}},
+		{"palettestart", []string{"ctrl+p", "start 25", "enter"}},
+		{"tour", []string{"ctrl+p", "tour", "enter"}},
+		{"tourdemo", []string{"ctrl+p", "tour", "enter", "enter"}},
+		{"tourrunning", []string{"ctrl+p", "tour", "enter", "enter", "enter"}},
+		{"tourstats", []string{"ctrl+p", "tour", "enter", "enter", "enter", "enter"}},
+		{"planner", []string{"o", "a", "a"}},
+		{"tasks", []string{"T", "a", "Write chapter 3"}},
+		{"stats", []string{"t"}},
+		{"statskeys", []string{"t", "?"}},
+		{"daily", []string{"t", "d"}},
+		{"journal", []string{"t", "d", "n", "Shipped the first draft"}},
+		{"dayoff", []string{"t", "d", "O"}},
+		{"daybefore", []string{"t", "d", "left", "left"}},
+		{"weekly", []string{"t", "w"}},
+		{"weekbefore", []string{"t", "w", "left"}},
+		{"monthly", []string{"t", "m"}},
+		{"yearly", []string{"t", "y"}},
+		{"insights", []string{"t", "i"}},
+		{"hours", []string{"t", "H"}},
+		{"achievements", []string{"t", "A"}},
+		{"estimates", []string{"T", "a", "Write chapter 3", "enter", "+", "+", " ", "t", "E"}},
+		{"review", []string{"t", "R"}},
+		{"yearreview", []string{"t", "Y"}},
+		{"rangepicker", []string{"t", "D"}},
+		{"statsfilter", []string{"t", "F"}},
+		{"filtered", []string{"t", "F", "j", "enter", "w"}},
+		{"range", []string{"t", "D", "enter", "enter"}},
+	}
+
+	for _, view := range views {
+		for _, size := range golden.Sizes {
+			name := fmt.Sprintf("%s-%dx%d", view.name, size.Width, size.Height)
+			t.Run(name, func(t *testing.T) {
+				t.Parallel()
+				m := newTestModel(t)
+				next, _ := m.Update(tea.WindowSizeMsg{Width: size.Width, Height: size.Height})
+				m = press(t, next.(Model), view.keys...)
+
+				got := m.View()
+				golden.Assert(t, name, got)
+				golden.AssertFits(t, got, size.Width)
+			})
+		}
+	}
+}
+
+// TestTransitions drives the timer through time with the test clock, and
+// checks where each state machine ends up.
+func TestTransitions(t *testing.T) {
+	t.Parallel()
+	transitions := []struct {
+		name string
+		run  func(t *testing.T, m Model) Model
+	}{
+		{"halfway", func(t *testing.T, m Model) Model {
+			return advance(press(t, m, "s"), 30*time.Minute)
+		}},
+		{"paused", func(t *testing.T, m Model) Model {
+			return press(t, advance(press(t, m, "s"), 10*time.Minute), "p")
+		}},
+		{"complete", func(t *testing.T, m Model) Model {
+			m = advance(press(t, m, "s"), time.Hour)
+			if m.timerRunning || m.activeSession != nil {
+				t.Errorf("session still running after its hour")
+			}
+			return m
+		}},
+		{"breakover", func(t *testing.T, m Model) Model {
+			m = advance(press(t, m, "a"), 10*time.Minute)
+			if m.onBreak {
+				t.Errorf("break still running after 10 minutes")
+			}
+			return m
+		}},
+		{"slept", func(t *testing.T, m Model) Model {
+			m = step(advance(press(t, m, "s"), 5*time.Minute), 20*time.Minute)
+			if m.sleep == nil {
+				t.Errorf("no sleep prompt after a 20-minute gap")
+			}
+			return m
+		}},
+	}
+
+	for _, tr := range transitions {
+		for _, size := range golden.Sizes {
+			name := fmt.Sprintf("%s-%dx%d", tr.name, size.Width, size.Height)
+			t.Run(name, func(t *testing.T) {
+				t.Parallel()
+				m := newTestModel(t)
+				next, _ := m.Update(tea.WindowSizeMsg{Width: size.Width, Height: size.Height})
+				m = tr.run(t, next.(Model))
+
+				got := m.View()
+				golden.Assert(t, name, got)
+				golden.AssertFits(t, got, size.Width)
+			})
+		}
+	}
+}
+
+// TestStatsTransitions moves the clock on with the test clock, and checks
+// what the stats show for a session just finished and for a new day, week,
+// month and year.
+func TestStatsTransitions(t *testing.T) {
+	t.Parallel()
+	transitions := []struct {
+		name string
+		run  func(t *testing.T, m Model) Model
+	}{
+		{"statsaftersession", func(t *testing.T, m Model) Model {
+			m = press(t, advance(press(t, m, "s"), time.Hour), "esc", "t")
+			if m.todayStats.SessionsCount != 3 {
+				t.Errorf("%d sessions today, want 3", m.todayStats.SessionsCount)
+			}
+			return m
+		}},
+		{"statsnextday", func(t *testing.T, m Model) Model {
+			// 15:04 on Wednesday to 0:04 on Thursday
+			m = press(t, idle(press(t, m, "t", "t"), 9*time.Hour), "t", "d")
+			if m.todayStats.Date != "2025-03-13" || m.todayStats.SessionsCount != 0 {
+				t.Errorf("today is %s with %d sessions, want 2025-03-13 with none", m.todayStats.Date, m.todayStats.SessionsCount)
+			}
+			return m
+		}},
+		{"statsnextweek", func(t *testing.T, m Model) Model {
+			// To Monday
+			return press(t, idle(m, 5*24*time.Hour), "t", "w")
+		}},
+		{"statsnextmonth", func(t *testing.T, m Model) Model {
+			return press(t, idle(m, 20*24*time.Hour), "t", "m")
+		}},
+		{"statsnextyear", func(t *testing.T, m Model) Model {
+			return press(t, idle(m, 295*24*time.Hour), "t", "y")
+		}},
+	}
+
+	for _, tr := range transitions {
+		for _, size := range golden.Sizes {
+			name := fmt.Sprintf("%s-%dx%d", tr.name, size.Width, size.Height)
+			t.Run(name, func(t *testing.T) {
+				t.Parallel()
+				m := newTestModel(t)
+				next, _ := m.Update(tea.WindowSizeMsg{Width: size.Width, Height: size.Height})
+				m = tr.run(t, next.(Model))
+
+				got := m.View()
+				golden.Assert(t, name, got)
+				golden.AssertFits(t, got, size.Width)
+			})
+		}
+	}
+}
+
+// TestWallClockSteps checks that the running timer follows the monotonic
+// clock when the wall clock is set back or forward, and only counts a jump
+// ahead when told to.
+func TestWallClockSteps(t *testing.T) {
+	start := func(t *testing.T) Model {
+		m := newTestModel(t)
+		next, _ := m.Update(tea.WindowSizeMsg{Width: 80, Height: 24})
+		return advance(press(t, next.(Model), "s"), 10*time.Minute)
+	}
+
+	t.Run("set back", func(t *testing.T) {
+		m := advance(step(start(t), -time.Hour), time.Minute)
+		if m.timerElapsed != 11*60 || m.sleep != nil {
+			t.Errorf("elapsed %ds with sleep prompt %v, want 660s and none", m.timerElapsed, m.sleep != nil)
+		}
+	})
+
+	t.Run("set forward, left out", func(t *testing.T) {
+		m := step(start(t), 30*time.Minute)
+		if m.timerElapsed != 10*60 || m.sleep == nil {
+			t.Fatalf("elapsed %ds with sleep prompt %v, want 600s and the prompt", m.timerElapsed, m.sleep != nil)
+		}
+		m = advance(press(t, m, "d"), time.Minute)
+		if m.timerElapsed != 11*60 {
+			t.Errorf("elapsed %ds, want 660s", m.timerElapsed)
+		}
+	})
+
+	t.Run("set forward, counted", func(t *testing.T) {
+		m := press(t, step(start(t), 30*time.Minute), "c")
+		m = advance(m, time.Minute)
+		if m.timerElapsed != 41*60 {
+			t.Errorf("elapsed %ds, want 2460s", m.timerElapsed)
+		}
+	})
+}
+
+func TestClockStyles(t *testing.T) {
+	t.Parallel()
+	for _, font := range models.ClockFonts {
+		for _, size := range golden.Sizes {
+			name := fmt.Sprintf("clock-%s-%dx%d", font, size.Width, size.Height)
+			t.Run(name, func(t *testing.T) {
+				t.Parallel()
+				m := newTestModel(t)
+				m.config.ClockFont = font
+				next, _ := m.Update(tea.WindowSizeMsg{Width: size.Width, Height: size.Height})
+				m = press(t, next.(Model), "s", "z")
+
+				got := m.View()
+				golden.Assert(t, name, got)
+				golden.AssertFits(t, got, size.Width)
+			})
+		}
+	}
+}
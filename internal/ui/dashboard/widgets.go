@@ -0,0 +1,108 @@
+package dashboard
+
+import (
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/adibhanna/focussessions/internal/models"
+	"github.com/adibhanna/focussessions/internal/ui/theme"
+)
+
+// streakDays is how far back the home view's streak is counted.
+const streakDays = 366
+
+// refreshStreak counts the days in a row with focus time, for the streak
+// widget.
+func (m *Model) refreshStreak() {
+	now := m.now()
+	to := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location()).AddDate(0, 0, 1)
+	from := to.AddDate(0, 0, -streakDays)
+
+	daily, err := m.storage.GetDailyMinutes(from, to)
+	if err != nil {
+		return
+	}
+	off, err := m.storage.GetOffDaysIn(from, to)
+	if err != nil {
+		return
+	}
+	m.streak = models.CurrentStreak(daily, off)
+}
+
+// renderWidgets renders the home view's widgets in the configured order.
+// The timer always shows, since it holds the countdown and its status:
+// without the timer widget it comes first with a plain clock in place of
+// the big one.
+func (m Model) renderWidgets() []string {
+	widgets := m.config.Widgets()
+	if !slices.Contains(widgets, models.WidgetTimer) {
+		widgets = append([]string{models.WidgetTimer}, widgets...)
+	}
+
+	var parts []string
+	for _, widget := range widgets {
+		var part string
+		switch widget {
+		case models.WidgetTimer:
+			part = m.renderCenterTimer()
+		case models.WidgetToday:
+			part = m.renderSimpleProgress()
+		case models.WidgetStreak:
+			part = m.renderStreak()
+		case models.WidgetSparkline:
+			part = m.renderSparkline()
+		case models.WidgetPace:
+			part = m.renderPace()
+		case models.WidgetPlan:
+			part = m.renderPlanStatus()
+		case models.WidgetSchedule:
+			part = m.renderUpcoming()
+		}
+		if part != "" {
+			parts = append(parts, part)
+		}
+	}
+	return parts
+}
+
+// bigClock reports whether the countdown is drawn in large digits.
+func (m Model) bigClock() bool {
+	return slices.Contains(m.config.Widgets(), models.WidgetTimer)
+}
+
+// renderStreak shows the days in a row with focus time, e.g.
+// "🔥 5-day streak". It is empty until there is a streak.
+func (m Model) renderStreak() string {
+	if m.streak == 0 {
+		return ""
+	}
+	return lipgloss.NewStyle().
+		Foreground(theme.Accent).
+		Align(lipgloss.Center).
+		MarginTop(1).
+		Render(fmt.Sprintf("🔥 %d-day streak", m.streak))
+}
+
+// renderUpcoming shows the next scheduled session, then the suggestion for
+// tomorrow once the day is done.
+func (m Model) renderUpcoming() string {
+	var rows []string
+	if start, ok := m.config.NextScheduledStart(m.now()); ok {
+		label := start.Label()
+		if now := m.now(); start.At.YearDay() != now.YearDay() || start.At.Year() != now.Year() {
+			label = start.At.Format("Mon ") + label
+		}
+		rows = append(rows, lipgloss.NewStyle().
+			Foreground(theme.Muted).
+			Align(lipgloss.Center).
+			MarginTop(1).
+			Render("📅 Next scheduled: "+label))
+	}
+	if hint := m.renderScheduleHint(); hint != "" {
+		rows = append(rows, hint)
+	}
+	return lipgloss.JoinVertical(lipgloss.Center, rows...)
+}
@@ -0,0 +1,68 @@
+package dashboard
+
+import (
+	"fmt"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/adibhanna/focussessions/internal/export"
+	"github.com/adibhanna/focussessions/internal/ui/theme"
+)
+
+func (m *Model) loadYearReview(year int) {
+	review, err := m.storage.GetYearInReview(year)
+	if err != nil {
+		review.Year = year
+	}
+	m.yearReview = review
+}
+
+// cycleYearReview moves to the previous (step -1) or next (step 1) year,
+// stopping at the current one.
+func (m *Model) cycleYearReview(step int) {
+	year := m.yearReview.Year + step
+	if year > m.now().Year() {
+		return
+	}
+	m.loadYearReview(year)
+}
+
+// exportYearReview saves the year in review card as a text file in
+// ~/Downloads and reports where it went below the view.
+func (m Model) exportYearReview() (tea.Model, tea.Cmd) {
+	card := export.RenderYearInReview(m.yearReview)
+	path, err := export.Write([]byte(card), export.Downloads, "", export.Text, m.now())
+	if err != nil {
+		m.exportMessage = fmt.Sprintf("Export failed: %v", err)
+	} else {
+		m.exportMessage = fmt.Sprintf("[OK] Exported to %s", path)
+	}
+	m.showExportMsg = true
+	return m, m.clearExportMsgAfterDelay()
+}
+
+func (m Model) renderYearReviewView() string {
+	containerStyle := lipgloss.NewStyle().
+		Width(m.width).
+		Height(m.height).
+		Padding(2)
+
+	titleStyle := lipgloss.NewStyle().
+		Bold(true).
+		Foreground(theme.Accent).
+		MarginBottom(1)
+
+	lineStyle := lipgloss.NewStyle().
+		Foreground(theme.Highlight)
+
+	title := titleStyle.Render(fmt.Sprintf("✨ Your %d in Focus", m.yearReview.Year))
+
+	parts := []string{title}
+	for _, line := range export.YearInReviewLines(m.yearReview) {
+		parts = append(parts, lineStyle.Render(line))
+	}
+	parts = append(parts, m.renderHelp())
+
+	return containerStyle.Render(lipgloss.JoinVertical(lipgloss.Left, parts...))
+}
@@ -0,0 +1,35 @@
+package dashboard
+
+import (
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/adibhanna/focussessions/internal/ui/theme"
+)
+
+// renderZenView shows nothing but the countdown, centered. With ZenDim set
+// it is drawn in muted grey instead of the usual colors.
+func (m Model) renderZenView() string {
+	timerStyle := lipgloss.NewStyle().
+		Bold(true).
+		Foreground(theme.OnPrimary).
+		Background(theme.Primary).
+		Padding(2, 4)
+	if m.config.ZenDim {
+		timerStyle = lipgloss.NewStyle().
+			Foreground(theme.Dimmer).
+			Padding(2, 4)
+	}
+	if m.timerPaused {
+		timerStyle = timerStyle.Faint(true)
+	}
+
+	remaining := m.timerDuration
+	if m.timerRunning {
+		remaining = m.timerDuration - m.timerElapsed
+	}
+	minutes, seconds := remaining/60, remaining%60
+
+	// The countdown takes the screen but for the padding around it
+	display := m.renderClock(minutes, seconds, m.height-6, m.width-8)
+	return lipgloss.Place(m.width, m.height, lipgloss.Center, lipgloss.Center, m.flash(timerStyle).Render(display))
+}
@@ -0,0 +1,360 @@
+// Package exportwizard is a step-by-step export flow (period, format,
+// filters, destination) shared by the dashboard and stats views.
+package exportwizard
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/spinner"
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/adibhanna/focussessions/internal/clipboard"
+	"github.com/adibhanna/focussessions/internal/export"
+	"github.com/adibhanna/focussessions/internal/ui/theme"
+)
+
+type step int
+
+const (
+	stepPeriod step = iota
+	stepFormat
+	stepFilter
+	stepDestination
+	stepCustomPath
+	stepRunning
+	stepDone
+)
+
+var stepTitles = map[step]string{
+	stepPeriod:      "Which period?",
+	stepFormat:      "Which format?",
+	stepFilter:      "Which sessions?",
+	stepDestination: "Save or copy where?",
+	stepCustomPath:  "Save to path",
+}
+
+var filterNames = []string{"All sessions", "Completed only"}
+
+type resultMsg struct {
+	path string
+	card string // set when a stats card was copied instead of written
+	err  error
+}
+
+type Model struct {
+	storage export.Store
+	step    step
+	cursor  int
+
+	opts        export.Options
+	destination export.Destination
+	pathInput   textinput.Model
+	spinner     spinner.Model
+
+	done      bool
+	cancelled bool
+	card      string
+	message   string
+	err       error
+}
+
+func New(storage export.Store) Model {
+	pathInput := textinput.New()
+	pathInput.Placeholder = "~/Documents/focus.csv"
+	pathInput.CharLimit = 256
+	pathInput.Width = 50
+
+	s := spinner.New()
+	s.Spinner = spinner.Dot
+	s.Style = lipgloss.NewStyle().Foreground(theme.Primary)
+
+	return Model{
+		storage:   storage,
+		pathInput: pathInput,
+		spinner:   s,
+	}
+}
+
+func (m Model) Init() tea.Cmd {
+	return nil
+}
+
+func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case resultMsg:
+		m.step = stepDone
+		m.done = true
+		m.err = msg.err
+		m.card = msg.card
+		if msg.err != nil {
+			m.message = fmt.Sprintf("Export failed: %v", msg.err)
+		} else if msg.card != "" {
+			m.message = "[OK] Stats card copied to the clipboard"
+		} else if msg.path == "" {
+			m.message = fmt.Sprintf("[OK] Copied to the clipboard (%s)", m.opts.Format)
+		} else {
+			m.message = fmt.Sprintf("[OK] Exported to %s", msg.path)
+		}
+		return m, nil
+
+	case spinner.TickMsg:
+		if m.step != stepRunning {
+			return m, nil
+		}
+		var cmd tea.Cmd
+		m.spinner, cmd = m.spinner.Update(msg)
+		return m, cmd
+
+	case tea.KeyMsg:
+		if m.step == stepCustomPath {
+			return m.updatePathInput(msg)
+		}
+		if m.step >= stepRunning {
+			return m, nil
+		}
+
+		switch msg.String() {
+		case "esc", "b", "backspace":
+			return m.back(), nil
+		case "up", "k":
+			if m.cursor > 0 {
+				m.cursor--
+			}
+		case "down", "j":
+			if m.cursor < len(m.options())-1 {
+				m.cursor++
+			}
+		case "enter", " ":
+			return m.choose()
+		}
+	}
+
+	return m, nil
+}
+
+func (m Model) updatePathInput(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "esc":
+		m.pathInput.Blur()
+		return m.back(), nil
+	case "enter":
+		if strings.TrimSpace(m.pathInput.Value()) == "" {
+			return m, nil
+		}
+		m.pathInput.Blur()
+		return m.run()
+	}
+
+	var cmd tea.Cmd
+	m.pathInput, cmd = m.pathInput.Update(msg)
+	return m, cmd
+}
+
+// back returns to the previous step, cancelling the wizard from the first.
+func (m Model) back() Model {
+	switch m.step {
+	case stepPeriod:
+		m.cancelled = true
+		m.done = true
+	case stepCustomPath:
+		m.step = stepDestination
+		m.cursor = int(export.CustomPath)
+	default:
+		m.step--
+		m.cursor = m.selected(m.step)
+	}
+	return m
+}
+
+// selected returns the option previously chosen at step s.
+func (m Model) selected(s step) int {
+	switch s {
+	case stepFormat:
+		return int(m.opts.Format)
+	case stepFilter:
+		if m.opts.CompletedOnly {
+			return 1
+		}
+		return 0
+	case stepDestination:
+		return int(m.destination)
+	}
+	return int(m.opts.Period)
+}
+
+func (m Model) choose() (tea.Model, tea.Cmd) {
+	switch m.step {
+	case stepPeriod:
+		m.opts.Period = export.Period(m.cursor)
+	case stepFormat:
+		m.opts.Format = export.Format(m.cursor)
+		if m.opts.Format == export.Card {
+			// A card only counts completed sessions and goes to the clipboard
+			return m.run()
+		}
+	case stepFilter:
+		m.opts.CompletedOnly = m.cursor == 1
+	case stepDestination:
+		m.destination = export.Destination(m.cursor)
+		if m.destination == export.CustomPath {
+			m.step = stepCustomPath
+			return m, m.pathInput.Focus()
+		}
+		return m.run()
+	}
+
+	m.step++
+	m.cursor = m.selected(m.step)
+	return m, nil
+}
+
+func (m Model) run() (tea.Model, tea.Cmd) {
+	m.step = stepRunning
+	return m, tea.Batch(m.spinner.Tick, m.export())
+}
+
+func (m Model) export() tea.Cmd {
+	store, opts := m.storage, m.opts
+	dest, custom := m.destination, m.pathInput.Value()
+	return func() tea.Msg {
+		now := time.Now()
+		data, err := export.Render(store, opts, now)
+		if err != nil {
+			return resultMsg{err: err}
+		}
+		if opts.Format == export.Card {
+			return resultMsg{card: string(data), err: clipboard.Copy(string(data))}
+		}
+		if dest == export.Clipboard {
+			return resultMsg{err: clipboard.Copy(string(data))}
+		}
+		path, err := export.Write(data, dest, custom, opts.Format, now)
+		return resultMsg{path: path, err: err}
+	}
+}
+
+func (m Model) options() []string {
+	var names []string
+	switch m.step {
+	case stepPeriod:
+		for _, p := range export.Periods() {
+			names = append(names, p.String())
+		}
+	case stepFormat:
+		for _, f := range export.Formats() {
+			names = append(names, f.String())
+		}
+	case stepFilter:
+		names = filterNames
+	case stepDestination:
+		for _, d := range export.Destinations() {
+			names = append(names, d.String())
+		}
+	}
+	return names
+}
+
+// Done reports whether the wizard finished, either by exporting or by
+// being cancelled.
+func (m Model) Done() bool {
+	return m.done
+}
+
+// Cancelled reports whether the user backed out without exporting.
+func (m Model) Cancelled() bool {
+	return m.cancelled
+}
+
+// Result returns the message to show once the export has finished.
+func (m Model) Result() string {
+	return m.message
+}
+
+func (m Model) View() string {
+	titleStyle := lipgloss.NewStyle().
+		Bold(true).
+		Foreground(theme.Accent).
+		MarginBottom(1)
+
+	questionStyle := lipgloss.NewStyle().
+		Bold(true).
+		Foreground(theme.Highlight)
+
+	summaryStyle := lipgloss.NewStyle().
+		Foreground(theme.Muted)
+
+	selectedStyle := lipgloss.NewStyle().
+		Foreground(theme.Success).
+		Bold(true)
+
+	helpStyle := lipgloss.NewStyle().
+		Foreground(theme.Faint).
+		MarginTop(1)
+
+	var b strings.Builder
+	b.WriteString(titleStyle.Render("Export Stats"))
+	b.WriteString("\n")
+	if summary := m.summary(); summary != "" {
+		b.WriteString(summaryStyle.Render(summary))
+		b.WriteString("\n\n")
+	}
+
+	switch m.step {
+	case stepRunning:
+		b.WriteString(m.spinner.View() + " Exporting...")
+		return b.String()
+	case stepDone:
+		if m.card != "" {
+			b.WriteString(m.card)
+			b.WriteString("\n")
+		}
+		b.WriteString(m.message)
+		return b.String()
+	case stepCustomPath:
+		b.WriteString(questionStyle.Render(stepTitles[m.step]))
+		b.WriteString("\n\n")
+		b.WriteString(m.pathInput.View())
+		b.WriteString("\n")
+		b.WriteString(summaryStyle.Render("A directory gets a timestamped file name"))
+		b.WriteString("\n")
+		b.WriteString(helpStyle.Render("enter: export • esc: back"))
+		return b.String()
+	}
+
+	b.WriteString(questionStyle.Render(fmt.Sprintf("Step %d of 4 · %s", m.step+1, stepTitles[m.step])))
+	b.WriteString("\n\n")
+	for i, option := range m.options() {
+		if i == m.cursor {
+			b.WriteString(selectedStyle.Render("> " + option))
+		} else {
+			b.WriteString("  " + option)
+		}
+		b.WriteString("\n")
+	}
+
+	help := "↑/↓: choose • enter: next • esc: back"
+	if m.step == stepPeriod {
+		help = "↑/↓: choose • enter: next • esc: cancel"
+	}
+	b.WriteString(helpStyle.Render(help))
+	return b.String()
+}
+
+// summary lists the choices made so far.
+func (m Model) summary() string {
+	var parts []string
+	if m.step > stepPeriod {
+		parts = append(parts, m.opts.Period.String())
+	}
+	if m.step > stepFormat {
+		parts = append(parts, m.opts.Format.String())
+	}
+	if m.step > stepFilter {
+		parts = append(parts, filterNames[m.selected(stepFilter)])
+	}
+	return strings.Join(parts, " · ")
+}
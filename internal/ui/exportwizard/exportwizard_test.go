@@ -0,0 +1,41 @@
+package exportwizard
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/adibhanna/focussessions/internal/storage"
+	"github.com/adibhanna/focussessions/internal/ui/golden"
+)
+
+func TestView(t *testing.T) {
+	steps := []struct {
+		name string
+		keys []tea.KeyType
+	}{
+		{"period", nil},
+		{"format", []tea.KeyType{tea.KeyDown, tea.KeyEnter}},
+		{"filter", []tea.KeyType{tea.KeyEnter, tea.KeyDown, tea.KeyEnter}},
+		{"destination", []tea.KeyType{tea.KeyEnter, tea.KeyEnter, tea.KeyEnter}},
+	}
+
+	for _, step := range steps {
+		t.Run(step.name, func(t *testing.T) {
+			t.Setenv("HOME", t.TempDir())
+			store, err := storage.New()
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var m tea.Model = New(store)
+			for _, k := range step.keys {
+				m, _ = m.Update(tea.KeyMsg{Type: k})
+			}
+
+			got := m.View()
+			golden.Assert(t, "exportwizard-"+step.name, got)
+			golden.AssertFits(t, got, golden.Sizes[0].Width)
+		})
+	}
+}
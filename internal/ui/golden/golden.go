@@ -0,0 +1,64 @@
+// Package golden compares rendered views with snapshots stored under the
+// calling package's testdata directory. Run the tests with -update to
+// rewrite the snapshots after an intentional change, then review the diff.
+package golden
+
+import (
+	"flag"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+var update = flag.Bool("update", false, "rewrite golden files instead of comparing against them")
+
+// Size is a terminal size views are rendered at.
+type Size struct {
+	Width, Height int
+}
+
+// Sizes are the terminal sizes every view is checked at: the smallest
+// supported terminal, a classic 80x24 and a large window.
+var Sizes = []Size{
+	{40, 12},
+	{80, 24},
+	{120, 40},
+}
+
+// Assert compares got with testdata/<name>.golden.
+func Assert(t testing.TB, name, got string) {
+	t.Helper()
+
+	path := filepath.Join("testdata", name+".golden")
+	if *update {
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(got), 0644); err != nil {
+			t.Fatal(err)
+		}
+		return
+	}
+
+	want, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("%v (run go test -update to create it)", err)
+	}
+	if string(want) != got {
+		t.Errorf("%s changed; run go test -update and review the diff\n--- got ---\n%s\n--- want ---\n%s", path, got, want)
+	}
+}
+
+// AssertFits fails if any line of view is wider than width.
+func AssertFits(t testing.TB, view string, width int) {
+	t.Helper()
+
+	for i, line := range strings.Split(view, "\n") {
+		if w := lipgloss.Width(line); w > width {
+			t.Errorf("line %d is %d cells wide, terminal is %d: %q", i+1, w, width, line)
+		}
+	}
+}
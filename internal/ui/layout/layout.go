@@ -0,0 +1,86 @@
+// Package layout holds the terminal size breakpoints shared by the views and
+// a few helpers for sizing content to fit them. Views should pick layouts by
+// breakpoint rather than comparing widths against ad-hoc numbers.
+package layout
+
+import (
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+// Breakpoint is a terminal width class. Each breakpoint applies to widths
+// strictly greater than its threshold.
+type Breakpoint int
+
+const (
+	XSmall Breakpoint = iota // up to 60 columns
+	Small                    // more than 60 columns
+	Medium                   // more than 80 columns
+	Large                    // more than 100 columns
+	XLarge                   // more than 200 columns
+)
+
+var thresholds = []int{
+	Small:  60,
+	Medium: 80,
+	Large:  100,
+	XLarge: 200,
+}
+
+// MinWidth and MinHeight are the smallest terminal size the views are
+// designed for; below it content may wrap or be cut off.
+const (
+	MinWidth  = 40
+	MinHeight = 10
+)
+
+// CompactHeight is the terminal height below which views drop padding and
+// big elements in favor of single-line rows.
+const CompactHeight = 20
+
+// For returns the breakpoint for a width.
+func For(width int) Breakpoint {
+	bp := XSmall
+	for b := Small; b <= XLarge; b++ {
+		if width > thresholds[b] {
+			bp = b
+		}
+	}
+	return bp
+}
+
+// Compact reports whether height calls for the compact layout.
+func Compact(height int) bool {
+	return height < CompactHeight
+}
+
+// Inner returns the width left inside a container with the given
+// horizontal padding on each side, never less than zero.
+func Inner(width, padding int) int {
+	return max(width-2*padding, 0)
+}
+
+// Fit returns preferred clamped to available, but never below minimum.
+func Fit(preferred, available, minimum int) int {
+	return max(min(preferred, available), minimum)
+}
+
+// Bar renders a horizontal bar of width cells with the given fraction
+// filled, using full and empty as the cell glyphs.
+func Bar(fraction float64, width int, full, empty string) string {
+	filled := int(fraction * float64(width))
+	filled = max(0, min(filled, width))
+	return strings.Repeat(full, filled) + strings.Repeat(empty, width-filled)
+}
+
+// Widest returns the first option that fits within width cells, or the last
+// option when none does. List options from most to least detailed.
+func Widest(width int, options ...string) string {
+	for _, option := range options {
+		if lipgloss.Width(option) <= width {
+			return option
+		}
+	}
+	return options[len(options)-1]
+}
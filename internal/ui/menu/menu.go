@@ -10,6 +10,7 @@ import (
 
 	"github.com/adibhanna/focussessions/internal/models"
 	"github.com/adibhanna/focussessions/internal/storage"
+	"github.com/adibhanna/focussessions/internal/ui/theme"
 )
 
 type MenuChoice int
@@ -171,12 +172,12 @@ func (m Model) View() string {
 
 	titleStyle := lipgloss.NewStyle().
 		Bold(true).
-		Foreground(lipgloss.Color("#FF7CCB")).
+		Foreground(theme.Accent).
 		MarginBottom(2).
 		Align(lipgloss.Center)
 
 	statsStyle := lipgloss.NewStyle().
-		Foreground(lipgloss.Color("#FDFF8C")).
+		Foreground(theme.Highlight).
 		MarginBottom(2).
 		Align(lipgloss.Center)
 
@@ -185,20 +186,20 @@ func (m Model) View() string {
 		MarginTop(1)
 
 	selectedStyle := lipgloss.NewStyle().
-		Foreground(lipgloss.Color("#FF7CCB")).
+		Foreground(theme.Accent).
 		Bold(true)
 
 	normalStyle := lipgloss.NewStyle().
-		Foreground(lipgloss.Color("#888"))
+		Foreground(theme.Muted)
 
 	activeStyle := lipgloss.NewStyle().
-		Foreground(lipgloss.Color("#4CAF50")).
+		Foreground(theme.Success).
 		MarginBottom(1).
 		Align(lipgloss.Center)
 
 	currentDate := time.Now().Format("Monday, January 2, 2006")
 	dateStyle := lipgloss.NewStyle().
-		Foreground(lipgloss.Color("#888")).
+		Foreground(theme.Muted).
 		MarginBottom(1).
 		Align(lipgloss.Center)
 
@@ -252,7 +253,7 @@ func (m Model) View() string {
 
 func (m Model) renderProgressBar() string {
 	progressStyle := lipgloss.NewStyle().
-		Foreground(lipgloss.Color("#666")).
+		Foreground(theme.Faint).
 		MarginBottom(2)
 
 	completed := m.todayStats.SessionsCount
@@ -279,7 +280,7 @@ func (m Model) renderProgressBar() string {
 
 func (m Model) renderHelp() string {
 	helpStyle := lipgloss.NewStyle().
-		Foreground(lipgloss.Color("#666")).
+		Foreground(theme.Faint).
 		MarginTop(2)
 
 	return helpStyle.Render("↑/↓: navigate • enter: select • q: quit")
@@ -0,0 +1,223 @@
+package settings
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/adibhanna/focussessions/internal/models"
+)
+
+// kind is how a field is edited: typing a number, flipping a toggle or
+// cycling through the options of a select.
+type kind int
+
+const (
+	numberField kind = iota
+	toggleField
+	selectField
+)
+
+// field is one setting of the form. Its value is held as text while it is
+// edited: digits for numbers, "on" or "off" for toggles and one of the
+// options for selects.
+type field struct {
+	label string
+	hint  string // Shown under the form while the field is focused
+	kind  kind
+
+	min, max int    // Range of a number
+	unit     string // Unit of a number, e.g. "minutes"
+
+	options []string // Choices of a select, in order
+
+	get func(c models.Config) string
+	set func(c *models.Config, value string)
+}
+
+// section groups related fields under a heading.
+type section struct {
+	title  string
+	fields []field
+}
+
+// number returns a field typed as digits between min and max.
+func number(label, hint, unit string, min, max int, value func(c *models.Config) *int) field {
+	return field{
+		label: label,
+		hint:  hint,
+		kind:  numberField,
+		min:   min,
+		max:   max,
+		unit:  unit,
+		get:   func(c models.Config) string { return strconv.Itoa(*value(&c)) },
+		set: func(c *models.Config, text string) {
+			*value(c), _ = strconv.Atoi(text)
+		},
+	}
+}
+
+// toggle returns a field switched on or off.
+func toggle(label, hint string, value func(c *models.Config) *bool) field {
+	return field{
+		label: label,
+		hint:  hint,
+		kind:  toggleField,
+		get:   func(c models.Config) string { return onOff(*value(&c)) },
+		set:   func(c *models.Config, text string) { *value(c) = text == "on" },
+	}
+}
+
+// choice returns a field taking one of options. get reports the option in
+// use, so unset values show their default.
+func choice(label, hint string, options []string, get func(c models.Config) string, value func(c *models.Config) *string) field {
+	return field{
+		label:   label,
+		hint:    hint,
+		kind:    selectField,
+		options: options,
+		get:     get,
+		set:     func(c *models.Config, text string) { *value(c) = text },
+	}
+}
+
+// alert returns a toggle for announcing milestones with kind.
+func alert(label, hint, kind string) field {
+	return field{
+		label: label,
+		hint:  hint,
+		kind:  toggleField,
+		get:   func(c models.Config) string { return onOff(c.MilestoneAlert(kind)) },
+		set:   func(c *models.Config, text string) { c.SetMilestoneAlert(kind, text == "on") },
+	}
+}
+
+// sections lays out the form. Options without a field here are still
+// only set by editing config.json.
+var sections = []section{
+	{"Sessions", []field{
+		number("Session Duration", "Length of a focus session, 1-180 minutes", "minutes", 1, 180,
+			func(c *models.Config) *int { return &c.SessionDuration }),
+		number("Daily Session Goal", "Sessions to complete each day, 1-24", "sessions", 1, 24,
+			func(c *models.Config) *int { return &c.DailySessionGoal }),
+		toggle("Ask for Intention", "Ask for a one-line intention when starting",
+			func(c *models.Config) *bool { return &c.PromptIntention }),
+		toggle("Reflect After", "Rate your focus and take notes after a session",
+			func(c *models.Config) *bool { return &c.Reflect }),
+		toggle("Strict Sessions", "Start every session strict: no pausing, cancel by typing",
+			func(c *models.Config) *bool { return &c.Strict }),
+		number("Minimum Session", "Shorter sessions are false starts, 0 counts all (minutes)", "minutes", 0, 60,
+			func(c *models.Config) *int { return &c.MinSessionMinutes }),
+		number("Partial Credit", "Stopped sessions this long count as focus time, 0 for none", "minutes", 0, 180,
+			func(c *models.Config) *int { return &c.PartialCredit }),
+	}},
+	{"Breaks", []field{
+		number("Break Duration", "Length of a break, 1-60 minutes", "minutes", 1, 60,
+			func(c *models.Config) *int { return &c.BreakDuration }),
+		toggle("Auto-continue", "Chain sessions and breaks automatically",
+			func(c *models.Config) *bool { return &c.AutoContinue }),
+		number("Auto-continue Delay", "Countdown before an automatic start, 0-60 seconds", "seconds", 0, 60,
+			func(c *models.Config) *int { return &c.AutoContinueDelay }),
+		toggle("Breathing Guide", "Show a box breathing animation during breaks",
+			func(c *models.Config) *bool { return &c.BreathingGuide }),
+		choice("Break Suggestions", "Suggest something to do during breaks, or notify too",
+			models.BreakReminderModes, models.Config.BreakReminderMode,
+			func(c *models.Config) *string { return &c.BreakReminders }),
+	}},
+	{"Work Day", []field{
+		number("Work Start Hour", "When your workday begins, 0-23", "", 0, 23,
+			func(c *models.Config) *int { return &c.WorkStartHour }),
+		number("Work End Hour", "When your workday ends, 0-23", "", 0, 23,
+			func(c *models.Config) *int { return &c.WorkEndHour }),
+		choice("Week Starts On", "First day of the weekly stats",
+			[]string{"monday", "sunday"},
+			func(c models.Config) string { return strings.ToLower(c.FirstWeekday().String()) },
+			func(c *models.Config) *string { return &c.WeekStartDay }),
+		choice("Average Days Over", "Days that per-day averages count",
+			models.AverageBases, models.Config.AverageBasis,
+			func(c *models.Config) *string { return &c.AverageDays }),
+		number("Idle Reminder", "Nudge after this long without a session, 0 for never", "minutes", 0, 600,
+			func(c *models.Config) *int { return &c.IdleReminder }),
+	}},
+	{"Display", []field{
+		choice("Progress Bar", "How the timer's progress is drawn",
+			models.ProgressStyles, models.Config.Progress,
+			func(c *models.Config) *string { return &c.ProgressStyle }),
+		choice("Clock Style", "How the big countdown is drawn; smaller ones stand in when it doesn't fit",
+			models.ClockFonts, models.Config.Font,
+			func(c *models.Config) *string { return &c.ClockFont }),
+		toggle("Zen Dim", "Draw the zen mode countdown in dim grey",
+			func(c *models.Config) *bool { return &c.ZenDim }),
+		toggle("Focus Buddy", "Show a co-working buddy's countdown",
+			func(c *models.Config) *bool { return &c.Buddy }),
+		toggle("Taskbar Progress", "Show the timer on the terminal's taskbar icon",
+			func(c *models.Config) *bool { return &c.TaskbarProgress }),
+	}},
+	{"Notifications", []field{
+		toggle("Notifications", "Show desktop notifications",
+			func(c *models.Config) *bool { return &c.Notifications }),
+		toggle("Sounds", "Play tag sounds, the terminal bell and speech",
+			func(c *models.Config) *bool { return &c.Sounds }),
+		alert("Milestone Flash", "Flash the countdown at milestones", models.AlertFlash),
+		alert("Milestone Bell", "Ring the terminal bell at milestones", models.AlertBell),
+		alert("Milestone Notify", "Send a desktop notification at milestones", models.AlertNotify),
+		number("Quiet From", "Hour notifications and sounds stop, 0-23", "", 0, 23,
+			func(c *models.Config) *int { return &c.QuietStart }),
+		number("Quiet Until", "Hour they start again; the same as Quiet From for none", "", 0, 23,
+			func(c *models.Config) *int { return &c.QuietEnd }),
+	}},
+	{"Pauses", []field{
+		number("Pause Budget", "Paused minutes before a session is abandoned, 0 for no limit", "minutes", 0, 600,
+			func(c *models.Config) *int { return &c.PauseBudget }),
+		number("Pause Expiry", "Hours paused before a session is abandoned, 0 for never", "hours", 0, 720,
+			func(c *models.Config) *int { return &c.PauseExpiry }),
+		choice("Stale Sessions", "What happens on startup to a session left running",
+			models.StalePolicies, models.Config.StalePolicy,
+			func(c *models.Config) *string { return &c.StaleSessions }),
+		{
+			label: "Stale Grace",
+			hint:  "Minutes past its planned end before a session is stale",
+			kind:  numberField,
+			min:   1,
+			max:   1440,
+			unit:  "minutes",
+			get: func(c models.Config) string {
+				if c.StaleGrace <= 0 {
+					return strconv.Itoa(models.DefaultStaleGrace)
+				}
+				return strconv.Itoa(c.StaleGrace)
+			},
+			set: func(c *models.Config, text string) { c.StaleGrace, _ = strconv.Atoi(text) },
+		},
+	}},
+	{"System", []field{
+		{
+			label:   "Music",
+			hint:    "Play the music player during sessions or during breaks",
+			kind:    selectField,
+			options: []string{"off", models.MusicSessions, models.MusicBreaks},
+			get: func(c models.Config) string {
+				if _, ok := c.MusicPlays(true); !ok {
+					return "off"
+				}
+				return c.Music
+			},
+			set: func(c *models.Config, text string) {
+				if text == "off" {
+					text = ""
+				}
+				c.Music = text
+			},
+		},
+		toggle("Capture Environment", "Record host, tmux, battery and git on start",
+			func(c *models.Config) *bool { return &c.CaptureEnvironment }),
+		toggle("Fsync Writes", "Flush completions and config saves to disk",
+			func(c *models.Config) *bool { return &c.FsyncCriticalWrites }),
+	}},
+}
+
+func onOff(b bool) string {
+	if b {
+		return "on"
+	}
+	return "off"
+}
@@ -2,7 +2,9 @@ package settings
 
 import (
 	"fmt"
+	"slices"
 	"strconv"
+	"strings"
 	"unicode"
 
 	"github.com/charmbracelet/bubbles/key"
@@ -11,13 +13,25 @@ import (
 	"github.com/charmbracelet/lipgloss"
 
 	"github.com/adibhanna/focussessions/internal/models"
-	"github.com/adibhanna/focussessions/internal/storage"
+	"github.com/adibhanna/focussessions/internal/ui/layout"
+	"github.com/adibhanna/focussessions/internal/ui/theme"
 )
 
+// Store is the storage the settings read and save, satisfied by
+// *storage.Storage. Tests pass their own to check what is saved and how
+// failures show.
+type Store interface {
+	GetConfig() (models.Config, error)
+	SaveConfig(config models.Config) error
+	ResetAllData() error
+}
+
 type Model struct {
-	storage      *storage.Storage
+	storage      Store
 	config       models.Config
-	inputs       []textinput.Model
+	fields       []field           // Every field of sections, in order
+	inputs       []textinput.Model // What is typed in each number field
+	values       []string          // The value of each toggle and select
 	focusIndex   int
 	saved        bool
 	reset        bool
@@ -27,66 +41,56 @@ type Model struct {
 	height       int
 }
 
-func New(storage *storage.Storage) (Model, error) {
+func New(storage Store) (Model, error) {
 	config, err := storage.GetConfig()
 	if err != nil {
 		return Model{}, err
 	}
 
-	inputs := make([]textinput.Model, 4)
+	var fields []field
+	for _, section := range sections {
+		fields = append(fields, section.fields...)
+	}
 
-	// Validation function to allow only numeric input
-	numericValidation := func(text string) error {
-		if text == "" {
-			return nil // Allow empty input temporarily
+	inputs := make([]textinput.Model, len(fields))
+	for i, f := range fields {
+		if f.kind != numberField {
+			continue
 		}
-		for _, char := range text {
-			if !unicode.IsDigit(char) {
-				return fmt.Errorf("only numbers allowed")
-			}
+		inputs[i] = textinput.New()
+		inputs[i].Prompt = ""
+		inputs[i].CharLimit = len(strconv.Itoa(f.max))
+	}
+
+	m := Model{
+		storage: storage,
+		config:  config,
+		fields:  fields,
+		inputs:  inputs,
+		values:  make([]string, len(fields)),
+	}
+	m.load()
+	m.updateFocus()
+	return m, nil
+}
+
+// load fills the form in from the config.
+func (m *Model) load() {
+	for i, f := range m.fields {
+		if f.kind == numberField {
+			m.inputs[i].SetValue(f.get(m.config))
+		} else {
+			m.values[i] = f.get(m.config)
 		}
-		return nil
 	}
+}
 
-	// Session Duration
-	inputs[0] = textinput.New()
-	inputs[0].Placeholder = "60"
-	inputs[0].SetValue(strconv.Itoa(config.SessionDuration))
-	inputs[0].Focus()
-	inputs[0].CharLimit = 3
-	inputs[0].Width = 20
-	inputs[0].Validate = numericValidation
-
-	// Daily Session Goal
-	inputs[1] = textinput.New()
-	inputs[1].Placeholder = "8"
-	inputs[1].SetValue(strconv.Itoa(config.DailySessionGoal))
-	inputs[1].CharLimit = 2
-	inputs[1].Width = 20
-	inputs[1].Validate = numericValidation
-
-	// Work Start Hour
-	inputs[2] = textinput.New()
-	inputs[2].Placeholder = "8"
-	inputs[2].SetValue(strconv.Itoa(config.WorkStartHour))
-	inputs[2].CharLimit = 2
-	inputs[2].Width = 20
-	inputs[2].Validate = numericValidation
-
-	// Work End Hour
-	inputs[3] = textinput.New()
-	inputs[3].Placeholder = "16"
-	inputs[3].SetValue(strconv.Itoa(config.WorkEndHour))
-	inputs[3].CharLimit = 2
-	inputs[3].Width = 20
-	inputs[3].Validate = numericValidation
-
-	return Model{
-		storage:    storage,
-		config:     config,
-		inputs:     inputs,
-		focusIndex: 0,
-	}, nil
+// value returns the current text of field i.
+func (m Model) value(i int) string {
+	if m.fields[i].kind == numberField {
+		return m.inputs[i].Value()
+	}
+	return m.values[i]
 }
 
 func (m Model) Init() tea.Cmd {
@@ -103,18 +107,22 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	case tea.KeyMsg:
 		switch {
 		case key.Matches(msg, keys.Tab), key.Matches(msg, keys.Down):
-			m.focusIndex++
-			if m.focusIndex > len(m.inputs)-1 {
-				m.focusIndex = 0
-			}
-			return m.updateFocus(), nil
+			m.focusIndex = (m.focusIndex + 1) % len(m.fields)
+			m.updateFocus()
+			return m, nil
 
 		case key.Matches(msg, keys.ShiftTab), key.Matches(msg, keys.Up):
-			m.focusIndex--
-			if m.focusIndex < 0 {
-				m.focusIndex = len(m.inputs) - 1
-			}
-			return m.updateFocus(), nil
+			m.focusIndex = (m.focusIndex + len(m.fields) - 1) % len(m.fields)
+			m.updateFocus()
+			return m, nil
+
+		case key.Matches(msg, keys.Next) && m.fields[m.focusIndex].kind != numberField:
+			m.change(1)
+			return m, nil
+
+		case key.Matches(msg, keys.Previous) && m.fields[m.focusIndex].kind != numberField:
+			m.change(-1)
+			return m, nil
 
 		case key.Matches(msg, keys.Save):
 			if err := m.saveConfig(); err == nil {
@@ -147,83 +155,92 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		}
 	}
 
-	cmd := m.updateInputs(msg)
+	cmd := m.updateInput(msg)
 	return m, cmd
 }
 
-func (m *Model) updateFocus() tea.Model {
+func (m *Model) updateFocus() {
 	for i := range m.inputs {
+		if m.fields[i].kind != numberField {
+			continue
+		}
 		if i == m.focusIndex {
 			m.inputs[i].Focus()
 		} else {
 			m.inputs[i].Blur()
 		}
 	}
-	return m
 }
 
-func (m *Model) updateInputs(msg tea.Msg) tea.Cmd {
-	cmds := make([]tea.Cmd, len(m.inputs))
-	for i := range m.inputs {
-		oldValue := m.inputs[i].Value()
-		m.inputs[i], cmds[i] = m.inputs[i].Update(msg)
-		// Clear error message when user starts typing
-		if m.inputs[i].Value() != oldValue {
-			m.errorMsg = ""
-		}
+// change flips the focused toggle, or moves the focused select by step
+// through its options.
+func (m *Model) change(step int) {
+	f := m.fields[m.focusIndex]
+	switch f.kind {
+	case toggleField:
+		value, _ := parseOnOff(m.values[m.focusIndex])
+		m.values[m.focusIndex] = onOff(!value)
+	case selectField:
+		i := slices.Index(f.options, m.values[m.focusIndex])
+		m.values[m.focusIndex] = f.options[(max(i, 0)+step+len(f.options))%len(f.options)]
 	}
-	return tea.Batch(cmds...)
+	m.errorMsg = ""
 }
 
-func (m *Model) saveConfig() error {
-	// Validate session duration (1-180 minutes)
-	durationStr := m.inputs[0].Value()
-	if durationStr == "" {
-		return fmt.Errorf("session duration is required")
+// updateInput passes msg to the focused number field, leaving out any
+// key that isn't a digit.
+func (m *Model) updateInput(msg tea.Msg) tea.Cmd {
+	if m.fields[m.focusIndex].kind != numberField {
+		return nil
 	}
-	duration, err := strconv.Atoi(durationStr)
-	if err != nil || duration < 1 || duration > 180 {
-		return fmt.Errorf("session duration must be between 1-180 minutes")
+	if msg, ok := msg.(tea.KeyMsg); ok && msg.Type == tea.KeyRunes {
+		for _, char := range msg.Runes {
+			if !unicode.IsDigit(char) {
+				return nil
+			}
+		}
 	}
 
-	// Validate daily goal (1-24 sessions)
-	goalStr := m.inputs[1].Value()
-	if goalStr == "" {
-		return fmt.Errorf("daily session goal is required")
-	}
-	goal, err := strconv.Atoi(goalStr)
-	if err != nil || goal < 1 || goal > 24 {
-		return fmt.Errorf("daily goal must be between 1-24 sessions")
+	input := &m.inputs[m.focusIndex]
+	oldValue := input.Value()
+	var cmd tea.Cmd
+	*input, cmd = input.Update(msg)
+	// Clear error message when user starts typing
+	if input.Value() != oldValue {
+		m.errorMsg = ""
 	}
+	return cmd
+}
 
-	// Validate start hour (0-23)
-	startHourStr := m.inputs[2].Value()
-	if startHourStr == "" {
-		return fmt.Errorf("work start hour is required")
-	}
-	startHour, err := strconv.Atoi(startHourStr)
-	if err != nil || startHour < 0 || startHour > 23 {
-		return fmt.Errorf("start hour must be between 0-23")
+func (m *Model) saveConfig() error {
+	config := m.config
+	for i, f := range m.fields {
+		value := m.value(i)
+		if f.kind == numberField {
+			if value == "" {
+				return fmt.Errorf("%s is required", strings.ToLower(f.label))
+			}
+			n, err := strconv.Atoi(value)
+			if err != nil || n < f.min || n > f.max {
+				if f.unit == "" {
+					return fmt.Errorf("%s must be between %d-%d", strings.ToLower(f.label), f.min, f.max)
+				}
+				return fmt.Errorf("%s must be between %d-%d %s", strings.ToLower(f.label), f.min, f.max, f.unit)
+			}
+		}
+		f.set(&config, value)
 	}
 
-	// Validate end hour (0-23, must be greater than start hour)
-	endHourStr := m.inputs[3].Value()
-	if endHourStr == "" {
-		return fmt.Errorf("work end hour is required")
-	}
-	endHour, err := strconv.Atoi(endHourStr)
-	if err != nil || endHour < 0 || endHour > 23 {
-		return fmt.Errorf("end hour must be between 0-23")
-	}
-	if endHour <= startHour {
+	if config.WorkEndHour <= config.WorkStartHour {
 		return fmt.Errorf("end hour must be greater than start hour")
 	}
 
-	m.config.SessionDuration = duration
-	m.config.DailySessionGoal = goal
-	m.config.WorkStartHour = startHour
-	m.config.WorkEndHour = endHour
+	// Custom lengths no longer follow the chosen method
+	if method, ok := models.MethodByName(config.Method); !ok || !method.Matches(config) {
+		config.Method = ""
+	}
 
+	m.config = config
 	return m.storage.SaveConfig(m.config)
 }
 
@@ -235,16 +252,21 @@ func (m *Model) resetAllData() error {
 
 	// Reset to default config
 	m.config = models.DefaultConfig()
-
-	// Update input fields
-	m.inputs[0].SetValue(strconv.Itoa(m.config.SessionDuration))
-	m.inputs[1].SetValue(strconv.Itoa(m.config.DailySessionGoal))
-	m.inputs[2].SetValue(strconv.Itoa(m.config.WorkStartHour))
-	m.inputs[3].SetValue(strconv.Itoa(m.config.WorkEndHour))
+	m.load()
 
 	return nil
 }
 
+func parseOnOff(value string) (bool, bool) {
+	switch strings.ToLower(value) {
+	case "on":
+		return true, true
+	case "off":
+		return false, true
+	}
+	return false, false
+}
+
 func (m Model) View() string {
 	if m.width == 0 {
 		return "Loading..."
@@ -254,53 +276,27 @@ func (m Model) View() string {
 		Width(m.width).
 		Height(m.height).
 		Align(lipgloss.Center, lipgloss.Center).
-		Padding(4)
+		Padding(1, 2)
 
 	titleStyle := lipgloss.NewStyle().
 		Bold(true).
-		Foreground(lipgloss.Color("#FF7CCB")).
-		MarginBottom(3).
+		Foreground(theme.Accent).
+		MarginBottom(1).
 		Align(lipgloss.Center)
 
-	formStyle := lipgloss.NewStyle().
-		Align(lipgloss.Left).
-		MarginTop(2).
-		MarginBottom(2)
-
-	labelStyle := lipgloss.NewStyle().
-		Foreground(lipgloss.Color("#FDFF8C")).
-		MarginBottom(1)
-
-	inputStyle := lipgloss.NewStyle().
-		MarginBottom(2)
-
 	successStyle := lipgloss.NewStyle().
-		Foreground(lipgloss.Color("#4CAF50")).
+		Foreground(theme.Success).
 		Bold(true).
-		MarginTop(2)
+		MarginTop(1)
 
 	title := titleStyle.Render("⚙️  Settings")
 
-	labels := []string{
-		"Session Duration (minutes):",
-		"Daily Session Goal:",
-		"Work Start Hour (24h format):",
-		"Work End Hour (24h format):",
-	}
-
-	var form string
-	for i, label := range labels {
-		form += labelStyle.Render(label) + "\n"
-		form += inputStyle.Render(m.inputs[i].View()) + "\n"
-	}
-
-	help := m.renderHelp()
-
 	content := lipgloss.JoinVertical(
 		lipgloss.Center,
 		title,
-		formStyle.Render(form),
-		help,
+		m.renderForm(),
+		m.renderHint(),
+		m.renderHelp(),
 	)
 
 	if m.saved {
@@ -313,33 +309,138 @@ func (m Model) View() string {
 
 	if m.confirmReset {
 		warningStyle := lipgloss.NewStyle().
-			Foreground(lipgloss.Color("#FF6B6B")).
+			Foreground(theme.Danger).
 			Bold(true).
-			MarginTop(2)
-		content += "\n" + warningStyle.Render("⚠️  WARNING: This will delete ALL sessions and reset settings!")
+			MarginTop(1)
+		content += "\n" + warningStyle.Render(layout.Widest(layout.Inner(m.width, 2),
+			"⚠️  WARNING: This will delete ALL sessions and reset settings!",
+			"⚠️  Deletes ALL sessions and settings!",
+		))
 	}
 
 	if m.errorMsg != "" {
 		errorStyle := lipgloss.NewStyle().
-			Foreground(lipgloss.Color("#FF6B6B")).
+			Foreground(theme.Danger).
 			Bold(true).
-			MarginTop(2)
+			MarginTop(1).
+			Width(layout.Inner(m.width, 2))
 		content += "\n" + errorStyle.Render("❌ "+m.errorMsg)
 	}
 
 	return containerStyle.Render(content)
 }
 
+// renderForm lists the fields under their section headings, scrolled to
+// keep the focused field in view when they don't all fit.
+func (m Model) renderForm() string {
+	headingStyle := lipgloss.NewStyle().
+		Bold(true).
+		Foreground(theme.Info)
+
+	labelStyle := lipgloss.NewStyle().
+		Foreground(theme.Highlight)
+
+	focusedStyle := lipgloss.NewStyle().
+		Foreground(theme.Accent).
+		Bold(true)
+
+	valueStyle := lipgloss.NewStyle().
+		Foreground(theme.Body)
+
+	labelWidth := 0
+	for _, f := range m.fields {
+		labelWidth = max(labelWidth, lipgloss.Width(f.label))
+	}
+
+	var rows []string
+	cursor, i := 0, 0
+	for s, section := range sections {
+		if s > 0 {
+			rows = append(rows, "")
+		}
+		rows = append(rows, headingStyle.Render(section.title))
+		for range section.fields {
+			marker, style := "  ", labelStyle
+			if i == m.focusIndex {
+				marker, style = "▸ ", focusedStyle
+				cursor = len(rows)
+			}
+			label := style.Render(marker + fmt.Sprintf("%-*s", labelWidth, m.fields[i].label))
+			rows = append(rows, label+"  "+valueStyle.Render(m.renderValue(i)))
+			i++
+		}
+	}
+
+	// Leave room for the title, hint and help around the form, and for a
+	// message under them
+	visible := m.height - 8
+	if m.saved || m.reset || m.confirmReset || m.errorMsg != "" {
+		visible -= 2
+	}
+	first, last := 0, len(rows)
+	if visible = max(visible, 3); last > visible {
+		first = min(max(cursor-visible/2, 0), last-visible)
+		last = first + visible
+	}
+
+	return lipgloss.NewStyle().
+		Align(lipgloss.Left).
+		Render(lipgloss.JoinVertical(lipgloss.Left, rows[first:last]...))
+}
+
+// renderValue shows field i the way it is changed: the number being typed
+// with its unit, a checkbox, or the chosen option between arrows.
+func (m Model) renderValue(i int) string {
+	f := m.fields[i]
+	switch f.kind {
+	case toggleField:
+		if on, _ := parseOnOff(m.values[i]); on {
+			return "[x] on"
+		}
+		return "[ ] off"
+	case selectField:
+		if i == m.focusIndex {
+			return "< " + m.values[i] + " >"
+		}
+		return m.values[i]
+	}
+	value := m.inputs[i].Value()
+	if i == m.focusIndex {
+		value = m.inputs[i].View()
+	}
+	if f.unit == "" {
+		return value
+	}
+	return value + " " + f.unit
+}
+
+// renderHint describes the focused field, cut short on narrow terminals.
+func (m Model) renderHint() string {
+	return lipgloss.NewStyle().
+		Foreground(theme.Muted).
+		Italic(true).
+		MarginTop(1).
+		MaxWidth(layout.Inner(m.width, 2)).
+		Render(m.fields[m.focusIndex].hint)
+}
+
 func (m Model) renderHelp() string {
 	helpStyle := lipgloss.NewStyle().
-		Foreground(lipgloss.Color("#666")).
-		MarginTop(2)
+		Foreground(theme.Faint).
+		MarginTop(1)
 
 	if m.confirmReset {
-		return helpStyle.Render("⚠️  Press 'r' again to confirm RESET (deletes all data) • b: cancel")
+		return helpStyle.Render(layout.Widest(layout.Inner(m.width, 2),
+			"⚠️  Press 'r' again to confirm RESET (deletes all data) • b: cancel",
+			"r: confirm reset • b: cancel",
+		))
 	}
 
-	return helpStyle.Render("tab/↓: next field • shift+tab/↑: previous • s: save • r: reset all data • b: back • q: quit")
+	return helpStyle.Render(layout.Widest(layout.Inner(m.width, 2),
+		"↑/↓: move • ←/→/space: change • s: save • r: reset all data • b: back • q: quit",
+		"↑/↓: move • ←/→: change • s: save • r: reset • b: back",
+		"←/→: change • s: save • b: back",
+	))
 }
 
 type keyMap struct {
@@ -347,6 +448,8 @@ type keyMap struct {
 	ShiftTab key.Binding
 	Up       key.Binding
 	Down     key.Binding
+	Previous key.Binding
+	Next     key.Binding
 	Save     key.Binding
 	Reset    key.Binding
 	Back     key.Binding
@@ -370,6 +473,14 @@ var keys = keyMap{
 		key.WithKeys("down", "j"),
 		key.WithHelp("↓/j", "next field"),
 	),
+	Previous: key.NewBinding(
+		key.WithKeys("left", "h"),
+		key.WithHelp("←/h", "previous option"),
+	),
+	Next: key.NewBinding(
+		key.WithKeys("right", "l", " ", "enter"),
+		key.WithHelp("→/l/space", "next option"),
+	),
 	Save: key.NewBinding(
 		key.WithKeys("s"),
 		key.WithHelp("s", "save"),
@@ -0,0 +1,217 @@
+package settings
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/adibhanna/focussessions/internal/models"
+	"github.com/adibhanna/focussessions/internal/storage"
+	"github.com/adibhanna/focussessions/internal/ui/golden"
+)
+
+func TestView(t *testing.T) {
+	for _, size := range golden.Sizes {
+		name := fmt.Sprintf("settings-%dx%d", size.Width, size.Height)
+		t.Run(name, func(t *testing.T) {
+			t.Setenv("HOME", t.TempDir())
+			store, err := storage.New()
+			if err != nil {
+				t.Fatal(err)
+			}
+			m, err := New(store)
+			if err != nil {
+				t.Fatal(err)
+			}
+			next, _ := m.Update(tea.WindowSizeMsg{Width: size.Width, Height: size.Height})
+
+			got := next.View()
+			golden.Assert(t, name, got)
+			golden.AssertFits(t, got, size.Width)
+		})
+	}
+}
+
+// newModel returns the settings of a fresh data directory at 80x24.
+func newModel(t *testing.T) (Model, *storage.Storage) {
+	t.Helper()
+	t.Setenv("HOME", t.TempDir())
+	store, err := storage.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	m, err := New(store)
+	if err != nil {
+		t.Fatal(err)
+	}
+	next, _ := m.Update(tea.WindowSizeMsg{Width: 80, Height: 24})
+	return next.(Model), store
+}
+
+func press(m Model, keys ...tea.KeyMsg) Model {
+	for _, k := range keys {
+		next, _ := m.Update(k)
+		m = next.(Model)
+	}
+	return m
+}
+
+var (
+	down  = tea.KeyMsg{Type: tea.KeyDown}
+	right = tea.KeyMsg{Type: tea.KeyRight}
+	space = tea.KeyMsg{Type: tea.KeySpace, Runes: []rune{' '}}
+)
+
+func runes(s string) tea.KeyMsg {
+	return tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(s)}
+}
+
+// focus moves down to the field labelled label.
+func focus(t *testing.T, m Model, label string) Model {
+	t.Helper()
+	for range m.fields {
+		if m.fields[m.focusIndex].label == label {
+			return m
+		}
+		m = press(m, down)
+	}
+	t.Fatalf("no field %q", label)
+	return m
+}
+
+func TestScrolledView(t *testing.T) {
+	m, _ := newModel(t)
+	m = focus(t, m, "Music")
+
+	got := m.View()
+	golden.Assert(t, "settings-scrolled", got)
+	golden.AssertFits(t, got, 80)
+}
+
+func TestSaveTypedFields(t *testing.T) {
+	m, store := newModel(t)
+
+	m = focus(t, m, "Session Duration")
+	m = press(m, tea.KeyMsg{Type: tea.KeyBackspace}, tea.KeyMsg{Type: tea.KeyBackspace}, runes("4"), runes("x"), runes("5"))
+	m = focus(t, m, "Strict Sessions")
+	m = press(m, space)
+	m = focus(t, m, "Week Starts On")
+	m = press(m, right)
+	m = focus(t, m, "Music")
+	m = press(m, right, right)
+	m = press(m, runes("s"))
+	if m.errorMsg != "" {
+		t.Fatalf("save failed: %s", m.errorMsg)
+	}
+
+	config, err := store.GetConfig()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if config.SessionDuration != 45 || !config.Strict || config.WeekStartDay != "sunday" || config.Music != "breaks" {
+		t.Errorf("saved %d minutes, strict %v, week start %q, music %q; want 45, true, sunday, breaks",
+			config.SessionDuration, config.Strict, config.WeekStartDay, config.Music)
+	}
+}
+
+func TestSaveRejectsOutOfRange(t *testing.T) {
+	m, _ := newModel(t)
+
+	m = focus(t, m, "Work End Hour")
+	m = press(m, tea.KeyMsg{Type: tea.KeyBackspace}, tea.KeyMsg{Type: tea.KeyBackspace}, runes("6"))
+	m = press(m, runes("s"))
+	if want := "end hour must be greater than start hour"; m.errorMsg != want {
+		t.Errorf("error %q, want %q", m.errorMsg, want)
+	}
+
+	m = focus(t, m, "Break Duration")
+	m = press(m, tea.KeyMsg{Type: tea.KeyBackspace}, tea.KeyMsg{Type: tea.KeyBackspace}, runes("0"))
+	m = press(m, runes("s"))
+	if want := "break duration must be between 1-60 minutes"; m.errorMsg != want {
+		t.Errorf("error %q, want %q", m.errorMsg, want)
+	}
+}
+
+func TestSaveNotificationPreferences(t *testing.T) {
+	m, store := newModel(t)
+
+	m = focus(t, m, "Sounds")
+	m = press(m, space)
+	m = focus(t, m, "Milestone Flash")
+	m = press(m, space)
+	m = focus(t, m, "Quiet From")
+	m = press(m, tea.KeyMsg{Type: tea.KeyBackspace}, runes("2"), runes("2"))
+	m = focus(t, m, "Quiet Until")
+	m = press(m, tea.KeyMsg{Type: tea.KeyBackspace}, runes("7"))
+	m = press(m, runes("s"))
+	if m.errorMsg != "" {
+		t.Fatalf("save failed: %s", m.errorMsg)
+	}
+
+	config, err := store.GetConfig()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if config.Sounds || !config.Notifications {
+		t.Errorf("saved sounds %v, notifications %v; want false, true", config.Sounds, config.Notifications)
+	}
+	for _, kind := range models.AlertKinds {
+		if config.MilestoneAlert(kind) {
+			t.Errorf("milestones still announced with %s (alerts %q)", kind, config.MilestoneAlerts)
+		}
+	}
+	if config.QuietStart != 22 || config.QuietEnd != 7 {
+		t.Errorf("saved quiet hours %d-%d, want 22-7", config.QuietStart, config.QuietEnd)
+	}
+}
+
+// memoryStore keeps the config in memory, and fails to save it with
+// saveErr when set.
+type memoryStore struct {
+	config  models.Config
+	saves   int
+	saveErr error
+}
+
+func (s *memoryStore) GetConfig() (models.Config, error) { return s.config, nil }
+
+func (s *memoryStore) SaveConfig(config models.Config) error {
+	if s.saveErr != nil {
+		return s.saveErr
+	}
+	s.config = config
+	s.saves++
+	return nil
+}
+
+func (s *memoryStore) ResetAllData() error {
+	s.config = models.DefaultConfig()
+	return nil
+}
+
+func TestSaveFailure(t *testing.T) {
+	store := &memoryStore{config: models.DefaultConfig(), saveErr: errors.New("disk full")}
+	m, err := New(store)
+	if err != nil {
+		t.Fatal(err)
+	}
+	next, _ := m.Update(tea.WindowSizeMsg{Width: 80, Height: 24})
+	m = focus(t, next.(Model), "Strict Sessions")
+	m = press(m, space, runes("s"))
+
+	if m.saved || m.errorMsg != "disk full" {
+		t.Errorf("saved %v with error %q, want the error shown", m.saved, m.errorMsg)
+	}
+	got := m.View()
+	golden.Assert(t, "settings-savefailed", got)
+	golden.AssertFits(t, got, 80)
+
+	// Saving again once the disk has room keeps what was typed
+	store.saveErr = nil
+	m = press(m, runes("s"))
+	if store.saves != 1 || !store.config.Strict {
+		t.Errorf("saved %d times, strict %v; want once, true", store.saves, store.config.Strict)
+	}
+}
@@ -2,8 +2,6 @@ package stats
 
 import (
 	"fmt"
-	"os"
-	"path/filepath"
 	"time"
 
 	"github.com/charmbracelet/bubbles/key"
@@ -12,6 +10,8 @@ import (
 
 	"github.com/adibhanna/focussessions/internal/models"
 	"github.com/adibhanna/focussessions/internal/storage"
+	"github.com/adibhanna/focussessions/internal/ui/exportwizard"
+	"github.com/adibhanna/focussessions/internal/ui/theme"
 )
 
 type ViewType int
@@ -32,6 +32,8 @@ type Model struct {
 	yearStats     models.YearStats
 	width         int
 	height        int
+	exportWizard  exportwizard.Model
+	exporting     bool
 	exportMessage string
 	showMessage   bool
 }
@@ -49,8 +51,8 @@ func New(viewType ViewType, storage *storage.Storage) (Model, error) {
 	case DayView:
 		m.dayStats, err = storage.GetDayStats(now.Format("2006-01-02"))
 	case WeekView:
-		_, week := now.ISOWeek()
-		m.weekStats, err = storage.GetWeekStats(now.Year(), week)
+		weekYear, week := storage.WeekOf(now)
+		m.weekStats, err = storage.GetWeekStats(weekYear, week)
 	case MonthView:
 		m.monthStats, err = storage.GetMonthStats(now.Year(), int(now.Month()))
 	case YearView:
@@ -69,6 +71,12 @@ func (m Model) Init() tea.Cmd {
 }
 
 func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	if m.exporting {
+		if _, ok := msg.(tea.WindowSizeMsg); !ok {
+			return m.updateExportWizard(msg)
+		}
+	}
+
 	switch msg := msg.(type) {
 	case tea.WindowSizeMsg:
 		m.width = msg.Width
@@ -80,17 +88,11 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		case key.Matches(msg, keys.Back), key.Matches(msg, keys.Quit), key.Matches(msg, keys.Home):
 			return m, tea.Quit
 		case key.Matches(msg, keys.Export):
-			return m, m.exportStats()
+			m.exporting = true
+			m.exportWizard = exportwizard.New(m.storage)
+			return m, m.exportWizard.Init()
 		}
 
-	case exportResultMsg:
-		m.exportMessage = msg.message
-		m.showMessage = true
-		// Clear message after 3 seconds
-		return m, tea.Tick(time.Second*3, func(t time.Time) tea.Msg {
-			return clearMessageMsg{}
-		})
-
 	case clearMessageMsg:
 		m.showMessage = false
 		m.exportMessage = ""
@@ -102,11 +104,34 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 
 type clearMessageMsg struct{}
 
+func (m Model) updateExportWizard(msg tea.Msg) (tea.Model, tea.Cmd) {
+	wizard, cmd := m.exportWizard.Update(msg)
+	m.exportWizard = wizard.(exportwizard.Model)
+	if !m.exportWizard.Done() {
+		return m, cmd
+	}
+
+	m.exporting = false
+	if m.exportWizard.Cancelled() {
+		return m, nil
+	}
+	m.exportMessage = m.exportWizard.Result()
+	m.showMessage = true
+	// Clear message after 3 seconds
+	return m, tea.Tick(time.Second*3, func(t time.Time) tea.Msg {
+		return clearMessageMsg{}
+	})
+}
+
 func (m Model) View() string {
 	if m.width == 0 {
 		return "Loading..."
 	}
 
+	if m.exporting {
+		return lipgloss.NewStyle().Padding(2).Render(m.exportWizard.View())
+	}
+
 	containerStyle := lipgloss.NewStyle().
 		Width(m.width).
 		Height(m.height).
@@ -139,15 +164,15 @@ func (m Model) View() string {
 func (m Model) renderDayView() string {
 	titleStyle := lipgloss.NewStyle().
 		Bold(true).
-		Foreground(lipgloss.Color("#FF7CCB")).
+		Foreground(theme.Accent).
 		MarginBottom(2)
 
 	statsStyle := lipgloss.NewStyle().
-		Foreground(lipgloss.Color("#FDFF8C")).
+		Foreground(theme.Highlight).
 		MarginBottom(1)
 
 	sessionStyle := lipgloss.NewStyle().
-		Foreground(lipgloss.Color("#888")).
+		Foreground(theme.Muted).
 		PaddingLeft(2)
 
 	date, _ := time.Parse("2006-01-02", m.dayStats.Date)
@@ -235,15 +260,15 @@ func (m Model) renderDayView() string {
 func (m Model) renderWeekView() string {
 	titleStyle := lipgloss.NewStyle().
 		Bold(true).
-		Foreground(lipgloss.Color("#FF7CCB")).
+		Foreground(theme.Accent).
 		MarginBottom(2)
 
 	statsStyle := lipgloss.NewStyle().
-		Foreground(lipgloss.Color("#FDFF8C")).
+		Foreground(theme.Highlight).
 		MarginBottom(1)
 
 	dayStyle := lipgloss.NewStyle().
-		Foreground(lipgloss.Color("#888")).
+		Foreground(theme.Muted).
 		PaddingLeft(2)
 
 	title := titleStyle.Render(fmt.Sprintf("📅 Weekly Stats - Week %d, %d", m.weekStats.Week, m.weekStats.Year))
@@ -311,7 +336,7 @@ func (m Model) renderWeekView() string {
 
 func (m Model) renderWeekChart() string {
 	chartStyle := lipgloss.NewStyle().
-		Foreground(lipgloss.Color("#666")).
+		Foreground(theme.Faint).
 		MarginTop(1).
 		MarginBottom(1)
 
@@ -335,7 +360,10 @@ func (m Model) renderWeekChart() string {
 		dayMap[date.Format("Mon")] = day.SessionsCount
 	}
 
-	days := []string{"Mon", "Tue", "Wed", "Thu", "Fri", "Sat", "Sun"}
+	var days []string
+	for _, weekday := range models.Weekdays(m.storage.FirstWeekday()) {
+		days = append(days, weekday.String()[:3])
+	}
 
 	for row := barHeight; row > 0; row-- {
 		for _, day := range days {
@@ -360,15 +388,15 @@ func (m Model) renderWeekChart() string {
 func (m Model) renderMonthView() string {
 	titleStyle := lipgloss.NewStyle().
 		Bold(true).
-		Foreground(lipgloss.Color("#FF7CCB")).
+		Foreground(theme.Accent).
 		MarginBottom(2)
 
 	statsStyle := lipgloss.NewStyle().
-		Foreground(lipgloss.Color("#FDFF8C")).
+		Foreground(theme.Highlight).
 		MarginBottom(1)
 
 	weekStyle := lipgloss.NewStyle().
-		Foreground(lipgloss.Color("#888")).
+		Foreground(theme.Muted).
 		PaddingLeft(2)
 
 	monthTime, _ := time.Parse("2006-01", m.monthStats.Month)
@@ -440,15 +468,15 @@ func (m Model) renderMonthView() string {
 func (m Model) renderYearView() string {
 	titleStyle := lipgloss.NewStyle().
 		Bold(true).
-		Foreground(lipgloss.Color("#FF7CCB")).
+		Foreground(theme.Accent).
 		MarginBottom(2)
 
 	statsStyle := lipgloss.NewStyle().
-		Foreground(lipgloss.Color("#FDFF8C")).
+		Foreground(theme.Highlight).
 		MarginBottom(1)
 
 	monthStyle := lipgloss.NewStyle().
-		Foreground(lipgloss.Color("#888")).
+		Foreground(theme.Muted).
 		PaddingLeft(2)
 
 	title := titleStyle.Render(fmt.Sprintf("📊 Yearly Stats - %d", m.yearStats.Year))
@@ -521,14 +549,14 @@ func (m Model) renderYearView() string {
 
 func (m Model) renderHelp() string {
 	helpStyle := lipgloss.NewStyle().
-		Foreground(lipgloss.Color("#666")).
+		Foreground(theme.Faint).
 		MarginTop(2)
 
 	help := "Press 'e' to export • 'h' for home • 'b' to go back • 'q' to quit"
 
 	if m.showMessage && m.exportMessage != "" {
 		messageStyle := lipgloss.NewStyle().
-			Foreground(lipgloss.Color("#00FF00")).
+			Foreground(theme.Notice).
 			Bold(true)
 		help = messageStyle.Render(m.exportMessage) + "\n" + help
 	}
@@ -536,42 +564,6 @@ func (m Model) renderHelp() string {
 	return helpStyle.Render(help)
 }
 
-func (m Model) exportStats() tea.Cmd {
-	return func() tea.Msg {
-		report, err := m.storage.ExportAllStats()
-		if err != nil {
-			return exportResultMsg{success: false, message: fmt.Sprintf("Export failed: %v", err)}
-		}
-
-		// Save to file
-		homeDir, err := os.UserHomeDir()
-		if err != nil {
-			return exportResultMsg{success: false, message: fmt.Sprintf("Failed to get home directory: %v", err)}
-		}
-
-		timestamp := time.Now().Format("2006-01-02-150405")
-		filename := fmt.Sprintf("focussessions-stats-%s.txt", timestamp)
-		filePath := filepath.Join(homeDir, "Downloads", filename)
-
-		err = os.WriteFile(filePath, []byte(report), 0644)
-		if err != nil {
-			// Try alternative location if Downloads doesn't exist
-			filePath = filepath.Join(homeDir, filename)
-			err = os.WriteFile(filePath, []byte(report), 0644)
-			if err != nil {
-				return exportResultMsg{success: false, message: fmt.Sprintf("Failed to save file: %v", err)}
-			}
-		}
-
-		return exportResultMsg{success: true, message: fmt.Sprintf("✅ Exported to %s", filePath)}
-	}
-}
-
-type exportResultMsg struct {
-	success bool
-	message string
-}
-
 type keyMap struct {
 	Back   key.Binding
 	Quit   key.Binding
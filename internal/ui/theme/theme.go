@@ -0,0 +1,69 @@
+// Package theme holds the colors of the views by role. Each has a shade
+// for dark terminals and one for light terminals, where the pastels of the
+// dark palette are hard to read; Detect picks between them, and Plain drops
+// colors altogether for NO_COLOR and --no-color.
+package theme
+
+import (
+	"github.com/charmbracelet/bubbles/progress"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/muesli/termenv"
+)
+
+var (
+	Primary   = lipgloss.AdaptiveColor{Dark: "#7D56F4", Light: "#5A3FD1"} // Borders and the timer's badge
+	Accent    = lipgloss.AdaptiveColor{Dark: "#FF7CCB", Light: "#C2185B"} // Titles and selections
+	Highlight = lipgloss.AdaptiveColor{Dark: "#FDFF8C", Light: "#8C6D00"} // Values and headings
+	Text      = lipgloss.AdaptiveColor{Dark: "#FAFAFA", Light: "#1A1A1A"} // Prominent text
+	Body      = lipgloss.AdaptiveColor{Dark: "#CCCCCC", Light: "#333333"} // Running text
+	Muted     = lipgloss.AdaptiveColor{Dark: "#888", Light: "#5C5C5C"}    // Secondary text
+	Faint     = lipgloss.AdaptiveColor{Dark: "#666", Light: "#808080"}    // Help and hints
+	Dim       = lipgloss.AdaptiveColor{Dark: "#555", Light: "#999999"}    // What isn't there yet
+	Dimmer    = lipgloss.AdaptiveColor{Dark: "#444", Light: "#B3B3B3"}    // Barely there, e.g. the dimmed zen countdown
+	Success   = lipgloss.AdaptiveColor{Dark: "#4CAF50", Light: "#2E7D32"} // Goals met and things done
+	Notice    = lipgloss.AdaptiveColor{Dark: "#00FF00", Light: "#1B7F1B"} // Confirmations such as a finished export
+	Info      = lipgloss.AdaptiveColor{Dark: "#00BFFF", Light: "#0277BD"} // Section headings and labels
+	Calm      = lipgloss.AdaptiveColor{Dark: "#7FDBCA", Light: "#00796B"} // Breaks
+	Gold      = lipgloss.AdaptiveColor{Dark: "#FFD700", Light: "#9A7400"} // Rewards
+	Danger    = lipgloss.AdaptiveColor{Dark: "#FF6B6B", Light: "#C62828"} // Warnings
+	Error     = lipgloss.AdaptiveColor{Dark: "#FF5F87", Light: "#B0003A"} // Input errors
+
+	// Text drawn on a color of the theme
+	OnPrimary   = lipgloss.AdaptiveColor{Dark: "#FAFAFA", Light: "#FAFAFA"}
+	OnHighlight = lipgloss.AdaptiveColor{Dark: "#1A1A1A", Light: "#FAFAFA"}
+)
+
+// Detect picks the palette for the terminal's background. It asks the
+// terminal, so it must run before a program starts reading the keyboard,
+// which would swallow the answer.
+func Detect() {
+	lipgloss.SetHasDarkBackground(lipgloss.HasDarkBackground())
+}
+
+// Plain drops colors and every other styling, leaving plain text.
+func Plain() {
+	lipgloss.SetColorProfile(termenv.Ascii)
+}
+
+// NoColor reports whether the environment asks for no colors, through
+// NO_COLOR (https://no-color.org) or CLICOLOR=0.
+func NoColor() bool {
+	return termenv.EnvNoColor()
+}
+
+// Progress returns the options of a progress bar filling with a gradient
+// from Accent to Highlight, and without colors when they're dropped.
+func Progress() []progress.Option {
+	return []progress.Option{
+		progress.WithScaledGradient(hex(Accent), hex(Highlight)),
+		progress.WithColorProfile(lipgloss.ColorProfile()),
+	}
+}
+
+// hex returns color as a hex string for the terminal's background.
+func hex(color lipgloss.AdaptiveColor) string {
+	if lipgloss.HasDarkBackground() {
+		return color.Dark
+	}
+	return color.Light
+}
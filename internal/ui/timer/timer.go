@@ -12,6 +12,7 @@ import (
 
 	"github.com/adibhanna/focussessions/internal/models"
 	"github.com/adibhanna/focussessions/internal/storage"
+	"github.com/adibhanna/focussessions/internal/ui/theme"
 )
 
 type tickMsg time.Time
@@ -33,7 +34,7 @@ type Model struct {
 }
 
 func New(duration int, storage *storage.Storage) Model {
-	prog := progress.New(progress.WithScaledGradient("#FF7CCB", "#FDFF8C"))
+	prog := progress.New(theme.Progress()...)
 	prog.Width = 60
 
 	return Model{
@@ -48,7 +49,7 @@ func New(duration int, storage *storage.Storage) Model {
 }
 
 func NewFromSession(session *models.Session, storage *storage.Storage) Model {
-	prog := progress.New(progress.WithScaledGradient("#FF7CCB", "#FDFF8C"))
+	prog := progress.New(theme.Progress()...)
 	prog.Width = 60
 
 	return Model{
@@ -179,7 +180,7 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			// Save progress periodically (every 10 seconds)
 			if m.elapsed%10 == 0 && m.currentSession != nil {
 				m.currentSession.ElapsedSeconds = m.elapsed
-				m.storage.SaveSession(*m.currentSession)
+				m.storage.SaveSessionProgress(*m.currentSession)
 			}
 
 			if m.elapsed >= m.duration {
@@ -249,13 +250,13 @@ func (m Model) View() string {
 
 	timerStyle := lipgloss.NewStyle().
 		Bold(true).
-		Foreground(lipgloss.Color("#FAFAFA")).
-		Background(lipgloss.Color("#7D56F4")).
+		Foreground(theme.OnPrimary).
+		Background(theme.Primary).
 		Padding(2, 4).
 		MarginBottom(2)
 
 	statusStyle := lipgloss.NewStyle().
-		Foreground(lipgloss.Color("#888")).
+		Foreground(theme.Muted).
 		MarginBottom(2)
 
 	progressBar := m.progress.ViewAs(percent)
@@ -274,7 +275,7 @@ func (m Model) View() string {
 func (m Model) renderCompletionCelebration() string {
 	celebrationStyle := lipgloss.NewStyle().
 		Bold(true).
-		Foreground(lipgloss.Color("#FFD700")).
+		Foreground(theme.Gold).
 		Align(lipgloss.Center)
 
 	// Create celebration ASCII art
@@ -303,7 +304,7 @@ func (m Model) renderCompletionCelebration() string {
 	}
 
 	helpStyle := lipgloss.NewStyle().
-		Foreground(lipgloss.Color("#666")).
+		Foreground(theme.Faint).
 		MarginTop(2)
 	help := helpStyle.Render("Press 'b' to go back • 'q' to quit")
 
@@ -318,7 +319,7 @@ func (m Model) renderCompletionCelebration() string {
 
 func helpView(running bool) string {
 	helpStyle := lipgloss.NewStyle().
-		Foreground(lipgloss.Color("#666")).
+		Foreground(theme.Faint).
 		MarginTop(2)
 
 	var helpText string